@@ -1,11 +1,19 @@
 package main
 
 import (
+	"github.com/levelfourab/windshift-server/internal/aggregates"
 	"github.com/levelfourab/windshift-server/internal/api"
+	aggregatesv1alpha1 "github.com/levelfourab/windshift-server/internal/api/aggregates/v1alpha1"
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/api/events/v1alpha1"
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/api/locks/v1alpha1"
+	schedulesv1alpha1 "github.com/levelfourab/windshift-server/internal/api/schedules/v1alpha1"
+	schemasv1alpha1 "github.com/levelfourab/windshift-server/internal/api/schemas/v1alpha1"
 	statev1alpha1 "github.com/levelfourab/windshift-server/internal/api/state/v1alpha1"
 	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/locks"
 	"github.com/levelfourab/windshift-server/internal/nats"
+	"github.com/levelfourab/windshift-server/internal/schedules"
+	"github.com/levelfourab/windshift-server/internal/schemas"
 	"github.com/levelfourab/windshift-server/internal/state"
 
 	"github.com/levelfourab/sprout-go"
@@ -18,8 +26,16 @@ func main() {
 		nats.Module,
 		events.Module,
 		state.Module,
+		locks.Module,
+		schemas.Module,
+		schedules.Module,
+		aggregates.Module,
 		api.Module,
 		eventsv1alpha1.Module,
 		statev1alpha1.Module,
+		locksv1alpha1.Module,
+		schemasv1alpha1.Module,
+		schedulesv1alpha1.Module,
+		aggregatesv1alpha1.Module,
 	).Run()
 }
@@ -0,0 +1,13 @@
+package aggregates_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAggregates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Aggregates Suite")
+}
@@ -0,0 +1,24 @@
+package aggregates
+
+import "github.com/cockroachdb/errors"
+
+// ErrVersionMismatch is used when appending to an aggregate whose version is
+// not the expected version, because another writer has appended events.
+var ErrVersionMismatch = errors.New("version mismatch")
+
+type validationError struct {
+	err string
+}
+
+func (e *validationError) Error() string {
+	return e.err
+}
+
+func newValidationError(err string) error {
+	return &validationError{err: err}
+}
+
+func IsValidationError(err error) bool {
+	_, ok := err.(*validationError)
+	return ok
+}
@@ -0,0 +1,254 @@
+package aggregates
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// readPageSize is the number of events read at a time when loading an
+// aggregate.
+const readPageSize = 1000
+
+// Manager loads and appends to aggregates, which are subjects whose events
+// make up the state of something such as an order. The version of an
+// aggregate is the id of its latest event, and appending uses the expected
+// last sequence per subject of JetStream for optimistic concurrency.
+type Manager struct {
+	logger *zap.Logger
+	tracer trace.Tracer
+
+	events *events.Manager
+	state  *state.Manager
+}
+
+// Aggregate is an aggregate loaded from its events.
+type Aggregate struct {
+	// Subject is the subject of the aggregate.
+	Subject string
+	// Version is the id of the latest event of the aggregate, or the version
+	// of the snapshot if no events have been appended after it. Zero if the
+	// aggregate has no events.
+	Version uint64
+	// Snapshot is the latest snapshot of the aggregate, if snapshots were
+	// requested and one exists.
+	Snapshot *Snapshot
+	// Events are the events of the aggregate in the order they were
+	// appended, starting after the snapshot if there is one.
+	Events []*events.StoredEvent
+}
+
+// Snapshot is the state of an aggregate at a version.
+type Snapshot struct {
+	// Version is the version of the aggregate the snapshot was taken at.
+	Version uint64
+	// Data is the state of the aggregate.
+	Data *anypb.Any
+}
+
+// LoadConfig is the configuration for loading an aggregate.
+type LoadConfig struct {
+	// Subject is the subject of the aggregate.
+	Subject string
+	// SnapshotStore is the state store snapshots are saved in. Optional, if
+	// empty snapshots are not used.
+	SnapshotStore string
+}
+
+// AppendConfig is the configuration for appending events to an aggregate.
+type AppendConfig struct {
+	// Subject is the subject of the aggregate.
+	Subject string
+	// ExpectedVersion is the version the aggregate is expected to be at, zero
+	// if it is expected to have no events.
+	ExpectedVersion uint64
+	// Event is the event to append.
+	Event *Event
+}
+
+// Event is an event to append to an aggregate.
+type Event struct {
+	// Data of the event.
+	Data *anypb.Any
+	// PublishedTime is the time the event occurred. If nil, the current time
+	// is used.
+	PublishedTime *time.Time
+	// IdempotencyKey is the idempotency key of the event. Optional.
+	IdempotencyKey string
+	// Headers are custom headers to store with the event. Optional.
+	Headers map[string]string
+}
+
+// AppendResult is the result of appending to an aggregate.
+type AppendResult struct {
+	// Version is the version of the aggregate after the event was appended,
+	// which is the id of the appended event.
+	Version uint64
+}
+
+// NewManager creates a new aggregate manager.
+func NewManager(
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	events *events.Manager,
+	state *state.Manager,
+) (*Manager, error) {
+	return &Manager{
+		logger: logger,
+		tracer: tracer,
+		events: events,
+		state:  state,
+	}, nil
+}
+
+// LoadAggregate loads the events of an aggregate and its current version. If
+// a snapshot store is configured and has a snapshot of the aggregate, only
+// the events after the snapshot are loaded.
+func (m *Manager) LoadAggregate(ctx context.Context, config *LoadConfig) (*Aggregate, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.aggregates.LoadAggregate",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("windshift.aggregate.subject", config.Subject),
+		),
+	)
+	defer span.End()
+
+	if !events.IsValidSubject(config.Subject, false) {
+		span.SetStatus(codes.Error, "invalid subject")
+		return nil, newValidationError("invalid subject: " + config.Subject)
+	}
+
+	stream, err := m.events.StreamForSubject(ctx, config.Subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to find stream")
+		return nil, err
+	}
+
+	aggregate := &Aggregate{
+		Subject: config.Subject,
+		Events:  make([]*events.StoredEvent, 0),
+	}
+
+	if config.SnapshotStore != "" {
+		aggregate.Snapshot, _, err = m.getSnapshot(ctx, config.SnapshotStore, config.Subject)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to get snapshot")
+			return nil, err
+		}
+
+		if aggregate.Snapshot != nil {
+			aggregate.Version = aggregate.Snapshot.Version
+		}
+	}
+
+	for {
+		list, err := m.events.ReadEvents(ctx, &events.ReadEventsConfig{
+			Stream:  stream,
+			Subject: config.Subject,
+			StartID: aggregate.Version + 1,
+			Limit:   readPageSize,
+		})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to read events")
+			return nil, err
+		}
+
+		aggregate.Events = append(aggregate.Events, list.Events...)
+		if len(list.Events) > 0 {
+			aggregate.Version = list.Events[len(list.Events)-1].ID
+		}
+
+		if !list.HasMore {
+			break
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int64("windshift.aggregate.version", int64(aggregate.Version)),
+		attribute.Int("windshift.aggregate.events", len(aggregate.Events)),
+	)
+	span.SetStatus(codes.Ok, "")
+	return aggregate, nil
+}
+
+// AppendToAggregate appends an event to an aggregate if it is at the
+// expected version. If another writer has appended events ErrVersionMismatch
+// is returned.
+//
+// JetStream can not store several messages atomically, so a single event is
+// appended per call. The event is published expecting the expected version as
+// the latest event of the subject.
+func (m *Manager) AppendToAggregate(ctx context.Context, config *AppendConfig) (*AppendResult, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.aggregates.AppendToAggregate",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("windshift.aggregate.subject", config.Subject),
+			attribute.Int64("windshift.aggregate.expected_version", int64(config.ExpectedVersion)),
+		),
+	)
+	defer span.End()
+
+	err := validateAppendConfig(config)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid config")
+		return nil, err
+	}
+
+	event := config.Event
+	expected := config.ExpectedVersion
+	published, err := m.events.Publish(ctx, &events.PublishConfig{
+		Subject:            config.Subject,
+		Data:               event.Data,
+		ExpectedSubjectSeq: &expected,
+		PublishedTime:      event.PublishedTime,
+		IdempotencyKey:     event.IdempotencyKey,
+		Headers:            event.Headers,
+	})
+	if errors.Is(err, events.ErrWrongSequence) {
+		span.SetStatus(codes.Error, "version mismatch")
+		return nil, errors.WithStack(ErrVersionMismatch)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to append event")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int64("windshift.aggregate.version", int64(published.ID)))
+	span.SetStatus(codes.Ok, "")
+	return &AppendResult{
+		Version: published.ID,
+	}, nil
+}
+
+// validateAppendConfig checks that events can be appended to an aggregate.
+func validateAppendConfig(config *AppendConfig) error {
+	if !events.IsValidSubject(config.Subject, false) {
+		return newValidationError("invalid subject: " + config.Subject)
+	}
+
+	if config.Event == nil {
+		return newValidationError("an event is required")
+	}
+
+	if config.Event.Data == nil {
+		return newValidationError("data is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package aggregates_test
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/aggregates"
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Aggregates", func() {
+	var manager *aggregates.Manager
+	var eventsManager *events.Manager
+	var stateManager *state.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, eventsManager, stateManager = createManagers()
+
+		_, err := eventsManager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "orders",
+			Subjects: []string{"orders.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = stateManager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "snapshots",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	appendValue := func(ctx context.Context, subject string, version uint64, value string) *aggregates.AppendResult {
+		GinkgoHelper()
+
+		result, err := manager.AppendToAggregate(ctx, &aggregates.AppendConfig{
+			Subject:         subject,
+			ExpectedVersion: version,
+			Event:           &aggregates.Event{Data: Data(wrapperspb.String(value))},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	load := func(ctx context.Context, subject string, snapshotStore string) *aggregates.Aggregate {
+		GinkgoHelper()
+
+		aggregate, err := manager.LoadAggregate(ctx, &aggregates.LoadConfig{
+			Subject:       subject,
+			SnapshotStore: snapshotStore,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return aggregate
+	}
+
+	values := func(aggregate *aggregates.Aggregate) []string {
+		GinkgoHelper()
+
+		res := make([]string, len(aggregate.Events))
+		for i, event := range aggregate.Events {
+			var value wrapperspb.StringValue
+			Expect(event.Data.UnmarshalTo(&value)).To(Succeed())
+			res[i] = value.Value
+		}
+		return res
+	}
+
+	It("loads an aggregate without events", func(ctx context.Context) {
+		aggregate := load(ctx, "orders.1", "")
+		Expect(aggregate.Version).To(BeZero())
+		Expect(aggregate.Events).To(BeEmpty())
+		Expect(aggregate.Snapshot).To(BeNil())
+	})
+
+	It("appends and loads events in order", func(ctx context.Context) {
+		first := appendValue(ctx, "orders.1", 0, "created")
+		result := appendValue(ctx, "orders.1", first.Version, "paid")
+		Expect(result.Version).To(BeNumerically(">", first.Version))
+
+		aggregate := load(ctx, "orders.1", "")
+		Expect(aggregate.Version).To(Equal(result.Version))
+		Expect(values(aggregate)).To(Equal([]string{"created", "paid"}))
+	})
+
+	It("only loads the events of the aggregate", func(ctx context.Context) {
+		appendValue(ctx, "orders.1", 0, "created")
+		other := appendValue(ctx, "orders.2", 0, "created")
+		result := appendValue(ctx, "orders.2", other.Version, "shipped")
+
+		aggregate := load(ctx, "orders.2", "")
+		Expect(aggregate.Version).To(Equal(result.Version))
+		Expect(values(aggregate)).To(Equal([]string{"created", "shipped"}))
+	})
+
+	It("fails if the version does not match", func(ctx context.Context) {
+		result := appendValue(ctx, "orders.1", 0, "created")
+		appendValue(ctx, "orders.1", result.Version, "paid")
+
+		_, err := manager.AppendToAggregate(ctx, &aggregates.AppendConfig{
+			Subject:         "orders.1",
+			ExpectedVersion: result.Version,
+			Event:           &aggregates.Event{Data: Data(wrapperspb.String("cancelled"))},
+		})
+		Expect(err).To(MatchError(aggregates.ErrVersionMismatch))
+
+		aggregate := load(ctx, "orders.1", "")
+		Expect(values(aggregate)).To(Equal([]string{"created", "paid"}))
+	})
+
+	It("fails if the aggregate is expected to be new but has events", func(ctx context.Context) {
+		appendValue(ctx, "orders.1", 0, "created")
+
+		_, err := manager.AppendToAggregate(ctx, &aggregates.AppendConfig{
+			Subject: "orders.1",
+			Event:   &aggregates.Event{Data: Data(wrapperspb.String("created"))},
+		})
+		Expect(err).To(MatchError(aggregates.ErrVersionMismatch))
+	})
+
+	It("requires an event to append", func(ctx context.Context) {
+		_, err := manager.AppendToAggregate(ctx, &aggregates.AppendConfig{
+			Subject: "orders.1",
+		})
+		Expect(aggregates.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("requires data for the event", func(ctx context.Context) {
+		_, err := manager.AppendToAggregate(ctx, &aggregates.AppendConfig{
+			Subject: "orders.1",
+			Event:   &aggregates.Event{},
+		})
+		Expect(aggregates.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("does not allow wildcards in subjects", func(ctx context.Context) {
+		_, err := manager.LoadAggregate(ctx, &aggregates.LoadConfig{
+			Subject: "orders.*",
+		})
+		Expect(aggregates.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("fails for subjects not bound to a stream", func(ctx context.Context) {
+		_, err := manager.LoadAggregate(ctx, &aggregates.LoadConfig{
+			Subject: "unbound.1",
+		})
+		Expect(err).To(MatchError(events.ErrUnboundSubject))
+	})
+
+	Describe("Snapshots", func() {
+		It("loads events after the snapshot", func(ctx context.Context) {
+			created := appendValue(ctx, "orders.1", 0, "created")
+			first := appendValue(ctx, "orders.1", created.Version, "paid")
+
+			saved, err := manager.SaveSnapshot(ctx, &aggregates.SnapshotConfig{
+				Store:   "snapshots",
+				Subject: "orders.1",
+				Version: first.Version,
+				Data:    Data(wrapperspb.String("created,paid")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved).To(BeTrue())
+
+			second := appendValue(ctx, "orders.1", first.Version, "shipped")
+
+			aggregate := load(ctx, "orders.1", "snapshots")
+			Expect(aggregate.Version).To(Equal(second.Version))
+			Expect(aggregate.Snapshot).ToNot(BeNil())
+			Expect(aggregate.Snapshot.Version).To(Equal(first.Version))
+			Expect(values(aggregate)).To(Equal([]string{"shipped"}))
+
+			var value wrapperspb.StringValue
+			Expect(aggregate.Snapshot.Data.UnmarshalTo(&value)).To(Succeed())
+			Expect(value.Value).To(Equal("created,paid"))
+		})
+
+		It("uses the version of the snapshot if there are no later events", func(ctx context.Context) {
+			result := appendValue(ctx, "orders.1", 0, "created")
+
+			_, err := manager.SaveSnapshot(ctx, &aggregates.SnapshotConfig{
+				Store:   "snapshots",
+				Subject: "orders.1",
+				Version: result.Version,
+				Data:    Data(wrapperspb.String("created")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			aggregate := load(ctx, "orders.1", "snapshots")
+			Expect(aggregate.Version).To(Equal(result.Version))
+			Expect(aggregate.Events).To(BeEmpty())
+		})
+
+		It("loads all events if there is no snapshot", func(ctx context.Context) {
+			appendValue(ctx, "orders.1", 0, "created")
+
+			aggregate := load(ctx, "orders.1", "snapshots")
+			Expect(aggregate.Snapshot).To(BeNil())
+			Expect(values(aggregate)).To(Equal([]string{"created"}))
+		})
+
+		It("does not replace a snapshot with an older one", func(ctx context.Context) {
+			first := appendValue(ctx, "orders.1", 0, "created")
+			second := appendValue(ctx, "orders.1", first.Version, "paid")
+
+			saved, err := manager.SaveSnapshot(ctx, &aggregates.SnapshotConfig{
+				Store:   "snapshots",
+				Subject: "orders.1",
+				Version: second.Version,
+				Data:    Data(wrapperspb.String("created,paid")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved).To(BeTrue())
+
+			saved, err = manager.SaveSnapshot(ctx, &aggregates.SnapshotConfig{
+				Store:   "snapshots",
+				Subject: "orders.1",
+				Version: first.Version,
+				Data:    Data(wrapperspb.String("created")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved).To(BeFalse())
+
+			aggregate := load(ctx, "orders.1", "snapshots")
+			Expect(aggregate.Snapshot.Version).To(Equal(second.Version))
+		})
+
+		It("fails if the value is not a snapshot", func(ctx context.Context) {
+			_, err := stateManager.Set(ctx, "snapshots", "orders.1", Data(wrapperspb.String("test")))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.LoadAggregate(ctx, &aggregates.LoadConfig{
+				Subject:       "orders.1",
+				SnapshotStore: "snapshots",
+			})
+			Expect(aggregates.IsValidationError(err)).To(BeTrue())
+		})
+	})
+})
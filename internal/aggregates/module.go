@@ -0,0 +1,15 @@
+package aggregates
+
+import (
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+)
+
+// Module for FX that provides event sourcing of aggregates on top of
+// streams, with snapshots stored in state stores.
+var Module = fx.Module(
+	"aggregates",
+	fx.Provide(sprout.Logger("aggregates"), fx.Private),
+	fx.Provide(sprout.ServiceTracer(), fx.Private),
+	fx.Provide(NewManager),
+)
@@ -0,0 +1,131 @@
+package aggregates
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	storagev1 "github.com/levelfourab/windshift-server/internal/proto/windshift/aggregates/storage/v1"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// maxSnapshotAttempts limits how many times saving a snapshot is retried
+// when another writer changes the snapshot at the same time.
+const maxSnapshotAttempts = 10
+
+// SnapshotConfig is the configuration for saving a snapshot.
+type SnapshotConfig struct {
+	// Store is the state store to save the snapshot in.
+	Store string
+	// Subject is the subject of the aggregate.
+	Subject string
+	// Version is the version of the aggregate the snapshot was taken at.
+	Version uint64
+	// Data is the state of the aggregate.
+	Data *anypb.Any
+}
+
+// SaveSnapshot saves a snapshot of an aggregate in a state store, using the
+// subject of the aggregate as the key. Snapshots are only replaced by
+// snapshots of later versions, returns false if the stored snapshot is of
+// the same or a later version.
+func (m *Manager) SaveSnapshot(ctx context.Context, config *SnapshotConfig) (bool, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.aggregates.SaveSnapshot",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("windshift.aggregate.subject", config.Subject),
+			attribute.String("windshift.aggregate.snapshot_store", config.Store),
+			attribute.Int64("windshift.aggregate.version", int64(config.Version)),
+		),
+	)
+	defer span.End()
+
+	if !events.IsValidSubject(config.Subject, false) {
+		span.SetStatus(codes.Error, "invalid subject")
+		return false, newValidationError("invalid subject: " + config.Subject)
+	}
+
+	if config.Version == 0 {
+		span.SetStatus(codes.Error, "invalid version")
+		return false, newValidationError("version must be greater than 0")
+	}
+
+	if config.Data == nil {
+		span.SetStatus(codes.Error, "missing data")
+		return false, newValidationError("data is required")
+	}
+
+	value, err := anypb.New(&storagev1.Snapshot{
+		Version: config.Version,
+		Data:    config.Data,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to encode snapshot")
+		return false, errors.Wrap(err, "could not encode snapshot")
+	}
+
+	for attempt := 0; attempt < maxSnapshotAttempts; attempt++ {
+		existing, revision, err := m.getSnapshot(ctx, config.Store, config.Subject)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to get snapshot")
+			return false, err
+		}
+
+		if existing != nil && existing.Version >= config.Version {
+			span.SetStatus(codes.Ok, "")
+			return false, nil
+		}
+
+		if existing == nil {
+			_, err = m.state.Create(ctx, config.Store, config.Subject, value)
+		} else {
+			_, err = m.state.Update(ctx, config.Store, config.Subject, value, revision)
+		}
+
+		if errors.Is(err, state.ErrKeyAlreadyExists) || errors.Is(err, state.ErrRevisionMismatch) {
+			// Saved by another writer, check its version again
+			continue
+		} else if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to save snapshot")
+			return false, err
+		}
+
+		span.SetStatus(codes.Ok, "")
+		return true, nil
+	}
+
+	span.SetStatus(codes.Error, "snapshot changed too many times")
+	return false, errors.New("could not save snapshot, it was changed by other writers")
+}
+
+// getSnapshot returns the snapshot of an aggregate and its revision in the
+// state store, or nil if there is no snapshot.
+func (m *Manager) getSnapshot(ctx context.Context, store string, subject string) (*Snapshot, uint64, error) {
+	entry, err := m.state.Get(ctx, store, subject)
+	if errors.Is(err, state.ErrKeyNotFound) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	var snapshot storagev1.Snapshot
+	err = entry.Value.UnmarshalTo(&snapshot)
+	if err != nil {
+		return nil, 0, newValidationError("value stored for " + subject + " is not a snapshot")
+	}
+
+	return &Snapshot{
+		Version: snapshot.Version,
+		Data:    snapshot.Data,
+	}, entry.Revision, nil
+}
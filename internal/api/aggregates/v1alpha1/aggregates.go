@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/aggregates"
+	"github.com/levelfourab/windshift-server/internal/events"
+	aggregatesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/aggregates/v1alpha1"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *AggregateServiceServer) LoadAggregate(ctx context.Context, req *aggregatesv1alpha1.LoadAggregateRequest) (*aggregatesv1alpha1.LoadAggregateResponse, error) {
+	aggregate, err := s.aggregates.LoadAggregate(ctx, &aggregates.LoadConfig{
+		Subject:       req.Subject,
+		SnapshotStore: req.GetSnapshotStore(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &aggregatesv1alpha1.LoadAggregateResponse{
+		Version: aggregate.Version,
+		Events:  make([]*eventsv1alpha1.Event, len(aggregate.Events)),
+	}
+
+	if aggregate.Snapshot != nil {
+		res.Snapshot = &aggregatesv1alpha1.Snapshot{
+			Version: aggregate.Snapshot.Version,
+			Data:    aggregate.Snapshot.Data,
+		}
+	}
+
+	for i, event := range aggregate.Events {
+		res.Events[i] = toEvent(event)
+	}
+
+	return res, nil
+}
+
+func (s *AggregateServiceServer) AppendToAggregate(ctx context.Context, req *aggregatesv1alpha1.AppendToAggregateRequest) (*aggregatesv1alpha1.AppendToAggregateResponse, error) {
+	config := &aggregates.AppendConfig{
+		Subject:         req.Subject,
+		ExpectedVersion: req.ExpectedVersion,
+	}
+
+	if req.Event != nil {
+		var publishedTime *time.Time
+		if req.Event.Timestamp != nil {
+			t := req.Event.Timestamp.AsTime()
+			publishedTime = &t
+		}
+
+		config.Event = &aggregates.Event{
+			Data:           req.Event.Data,
+			PublishedTime:  publishedTime,
+			IdempotencyKey: req.Event.GetIdempotencyKey(),
+			Headers:        req.Event.Headers,
+		}
+	}
+
+	result, err := s.aggregates.AppendToAggregate(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &aggregatesv1alpha1.AppendToAggregateResponse{
+		Version: result.Version,
+	}, nil
+}
+
+func (s *AggregateServiceServer) SaveSnapshot(ctx context.Context, req *aggregatesv1alpha1.SaveSnapshotRequest) (*aggregatesv1alpha1.SaveSnapshotResponse, error) {
+	saved, err := s.aggregates.SaveSnapshot(ctx, &aggregates.SnapshotConfig{
+		Store:   req.Store,
+		Subject: req.Subject,
+		Version: req.Version,
+		Data:    req.Data,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &aggregatesv1alpha1.SaveSnapshotResponse{
+		Saved: saved,
+	}, nil
+}
+
+func toEvent(event *events.StoredEvent) *eventsv1alpha1.Event {
+	return &eventsv1alpha1.Event{
+		Id:      event.ID,
+		Subject: event.Subject,
+		Headers: &eventsv1alpha1.Headers{
+			Timestamp:      timestamppb.New(event.Headers.PublishedAt),
+			IdempotencyKey: event.Headers.IdempotencyKey,
+			TraceParent:    event.Headers.TraceParent,
+			TraceState:     event.Headers.TraceState,
+			Custom:         event.Headers.Custom,
+		},
+		Data: event.Data,
+	}
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if errors.Is(err, aggregates.ErrVersionMismatch) {
+		return status.Error(codes.Aborted, err.Error())
+	} else if errors.Is(err, events.ErrUnboundSubject) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if aggregates.IsValidationError(err) ||
+		events.IsValidationError(err) ||
+		errors.Is(err, events.ErrInvalidData) ||
+		state.IsValidationError(err) ||
+		errors.Is(err, state.ErrInvalidValue) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
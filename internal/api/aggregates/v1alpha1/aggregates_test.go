@@ -0,0 +1,118 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	aggregatesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/aggregates/v1alpha1"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Aggregates", func() {
+	var service aggregatesv1alpha1.AggregateServiceClient
+	var stateService statev1alpha1.StateServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		var eventsService eventsv1alpha1.EventsServiceClient
+		service, eventsService, stateService = GetClient()
+
+		_, err := eventsService.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "orders",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"orders.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = stateService.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+			Store: "snapshots",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	appendValue := func(ctx context.Context, version uint64, value string) *aggregatesv1alpha1.AppendToAggregateResponse {
+		GinkgoHelper()
+
+		res, err := service.AppendToAggregate(ctx, &aggregatesv1alpha1.AppendToAggregateRequest{
+			Subject:         "orders.1",
+			ExpectedVersion: version,
+			Event:           &aggregatesv1alpha1.AggregateEvent{Data: Data(wrapperspb.String(value))},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res
+	}
+
+	It("can append to and load an aggregate", func(ctx context.Context) {
+		first := appendValue(ctx, 0, "created")
+		appended := appendValue(ctx, first.Version, "paid")
+
+		res, err := service.LoadAggregate(ctx, &aggregatesv1alpha1.LoadAggregateRequest{
+			Subject: "orders.1",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Version).To(Equal(appended.Version))
+		Expect(res.Snapshot).To(BeNil())
+		Expect(res.Events).To(HaveLen(2))
+		Expect(res.Events[0].Id).To(Equal(first.Version))
+		Expect(res.Events[1].Subject).To(Equal("orders.1"))
+	})
+
+	It("returns aborted if the version does not match", func(ctx context.Context) {
+		appendValue(ctx, 0, "created")
+
+		_, err := service.AppendToAggregate(ctx, &aggregatesv1alpha1.AppendToAggregateRequest{
+			Subject: "orders.1",
+			Event:   &aggregatesv1alpha1.AggregateEvent{Data: Data(wrapperspb.String("created"))},
+		})
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+	})
+
+	It("returns invalid argument without an event", func(ctx context.Context) {
+		_, err := service.AppendToAggregate(ctx, &aggregatesv1alpha1.AppendToAggregateRequest{
+			Subject: "orders.1",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("returns failed precondition for unbound subjects", func(ctx context.Context) {
+		_, err := service.LoadAggregate(ctx, &aggregatesv1alpha1.LoadAggregateRequest{
+			Subject: "unbound.1",
+		})
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+	})
+
+	It("can load from a snapshot", func(ctx context.Context) {
+		first := appendValue(ctx, 0, "created")
+
+		saved, err := service.SaveSnapshot(ctx, &aggregatesv1alpha1.SaveSnapshotRequest{
+			Store:   "snapshots",
+			Subject: "orders.1",
+			Version: first.Version,
+			Data:    Data(wrapperspb.String("created")),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(saved.Saved).To(BeTrue())
+
+		second := appendValue(ctx, first.Version, "paid")
+
+		res, err := service.LoadAggregate(ctx, &aggregatesv1alpha1.LoadAggregateRequest{
+			Subject:       "orders.1",
+			SnapshotStore: proto.String("snapshots"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Version).To(Equal(second.Version))
+		Expect(res.Snapshot).ToNot(BeNil())
+		Expect(res.Snapshot.Version).To(Equal(first.Version))
+		Expect(res.Events).To(HaveLen(1))
+		Expect(res.Events[0].Id).To(Equal(second.Version))
+	})
+})
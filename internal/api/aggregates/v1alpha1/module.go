@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	"github.com/levelfourab/windshift-server/internal/aggregates"
+	aggregatesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/aggregates/v1alpha1"
+
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var Module = fx.Module(
+	"grpc.v1alpha1",
+	fx.Provide(sprout.Logger("grpc.aggregates.v1alpha1"), fx.Private),
+	fx.Provide(newAggregateServiceServer),
+	fx.Invoke(register),
+)
+
+type AggregateServiceServer struct {
+	aggregatesv1alpha1.UnimplementedAggregateServiceServer
+
+	logger *zap.Logger
+
+	aggregates *aggregates.Manager
+}
+
+func newAggregateServiceServer(
+	logger *zap.Logger,
+	aggregates *aggregates.Manager,
+) *AggregateServiceServer {
+	return &AggregateServiceServer{
+		logger: logger,
+
+		aggregates: aggregates,
+	}
+}
+
+func register(server *grpc.Server, aggregates *AggregateServiceServer) {
+	aggregatesv1alpha1.RegisterAggregateServiceServer(server, aggregates)
+}
@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (e *EventsServiceServer) AckEvents(ctx context.Context, req *eventsv1alpha1.AckEventsRequest) (*eventsv1alpha1.AckEventsResponse, error) {
+	processed, invalid, temporaryErrors := e.processAckTokens(ctx, req.Tokens, e.events.AckEvent)
+	return &eventsv1alpha1.AckEventsResponse{
+		Tokens:                processed,
+		InvalidTokens:         invalid,
+		TemporaryFailedTokens: temporaryErrors,
+	}, nil
+}
+
+func (e *EventsServiceServer) RejectEvents(ctx context.Context, req *eventsv1alpha1.RejectEventsRequest) (*eventsv1alpha1.RejectEventsResponse, error) {
+	config := &events.RejectConfig{}
+	if req.Permanently != nil {
+		config.Permanently = *req.Permanently
+	}
+
+	if req.Delay != nil {
+		if config.Permanently {
+			return nil, status.Error(codes.InvalidArgument, "permanently and delay can not be combined")
+		}
+
+		config.Delay = req.Delay.AsDuration()
+	}
+
+	if req.Reason != nil && *req.Reason != "" {
+		config.Failure = &events.Failure{
+			Reason:  *req.Reason,
+			Details: req.Details,
+		}
+
+		if req.Code != nil {
+			config.Failure.Code = *req.Code
+		}
+
+		err := events.ValidateFailure(config.Failure)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	processed, invalid, temporaryErrors := e.processAckTokens(ctx, req.Tokens, func(ctx context.Context, token string) error {
+		return e.events.RejectEvent(ctx, token, config)
+	})
+	return &eventsv1alpha1.RejectEventsResponse{
+		Tokens:                processed,
+		InvalidTokens:         invalid,
+		TemporaryFailedTokens: temporaryErrors,
+	}, nil
+}
+
+func (e *EventsServiceServer) PingEvents(ctx context.Context, req *eventsv1alpha1.PingEventsRequest) (*eventsv1alpha1.PingEventsResponse, error) {
+	processed, invalid, temporaryErrors := e.processAckTokens(ctx, req.Tokens, e.events.PingEvent)
+	return &eventsv1alpha1.PingEventsResponse{
+		Tokens:                processed,
+		InvalidTokens:         invalid,
+		TemporaryFailedTokens: temporaryErrors,
+	}, nil
+}
+
+// processAckTokens runs an operation for every ack token and groups the
+// tokens by outcome.
+func (e *EventsServiceServer) processAckTokens(
+	ctx context.Context,
+	tokens []string,
+	op func(context.Context, string) error,
+) ([]string, []string, []string) {
+	processed := make([]string, 0, len(tokens))
+	invalid := make([]string, 0, len(tokens))
+	temporaryErrors := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		err := op(ctx, token)
+		if errors.Is(err, events.ErrInvalidAckToken) {
+			invalid = append(invalid, token)
+		} else if err != nil {
+			e.logger.Warn("Could not process ack token", zap.Error(err))
+			temporaryErrors = append(temporaryErrors, token)
+		} else {
+			processed = append(processed, token)
+		}
+	}
+
+	return processed, invalid, temporaryErrors
+}
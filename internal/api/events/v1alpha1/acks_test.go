@@ -0,0 +1,145 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Ack tokens", func() {
+	var service eventsv1alpha1.EventsServiceClient
+	var consumerID string
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:            "events",
+			Name:              &consumerName,
+			Subjects:          []string{"events.>"},
+			ProcessingTimeout: durationpb.New(time.Second),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		consumerID = res.Id
+	})
+
+	publish := func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	// receive subscribes to the consumer, receives a single event and then
+	// closes the stream.
+	receive := func(ctx context.Context) *eventsv1alpha1.Event {
+		streamCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		client, err := service.Events(streamCtx)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = client.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Subscribe_{
+				Subscribe: &eventsv1alpha1.EventsRequest_Subscribe{
+					Stream:   "events",
+					Consumer: consumerID,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+
+		in, err := client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		event := in.GetEvent()
+		Expect(event).ToNot(BeNil())
+		Expect(event.AckToken).ToNot(BeEmpty())
+		return event
+	}
+
+	It("can acknowledge event after the stream is closed", NodeTimeout(10*time.Second), func(ctx context.Context) {
+		publish(ctx)
+		event := receive(ctx)
+
+		res, err := service.AckEvents(ctx, &eventsv1alpha1.AckEventsRequest{
+			Tokens: []string{event.AckToken, "invalid"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Tokens).To(Equal([]string{event.AckToken}))
+		Expect(res.InvalidTokens).To(Equal([]string{"invalid"}))
+
+		time.Sleep(1500 * time.Millisecond)
+		consumer, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+			Stream: "events",
+			Id:     consumerID,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumer.Consumer.State.AckPending).To(BeZero())
+		Expect(consumer.Consumer.State.Pending).To(BeZero())
+	})
+
+	It("can reject and ping events after the stream is closed", NodeTimeout(10*time.Second), func(ctx context.Context) {
+		publish(ctx)
+		event := receive(ctx)
+
+		ping, err := service.PingEvents(ctx, &eventsv1alpha1.PingEventsRequest{
+			Tokens: []string{event.AckToken},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ping.Tokens).To(Equal([]string{event.AckToken}))
+
+		reason := "failed"
+		reject, err := service.RejectEvents(ctx, &eventsv1alpha1.RejectEventsRequest{
+			Tokens: []string{event.AckToken},
+			Reason: &reason,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reject.Tokens).To(Equal([]string{event.AckToken}))
+
+		redelivered := receive(ctx)
+		Expect(redelivered.Id).To(Equal(event.Id))
+		Expect(redelivered.DeliveryAttempt).To(Equal(uint64(2)))
+	})
+
+	It("rejecting permanently with a delay fails", func(ctx context.Context) {
+		permanently := true
+		_, err := service.RejectEvents(ctx, &eventsv1alpha1.RejectEventsRequest{
+			Tokens:      []string{"token"},
+			Permanently: &permanently,
+			Delay:       durationpb.New(time.Second),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("rejecting with a multi-line reason fails", func(ctx context.Context) {
+		_, err := service.RejectEvents(ctx, &eventsv1alpha1.RejectEventsRequest{
+			Tokens: []string{"token"},
+			Reason: proto.String("failed\n\tat main.go:10"),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,654 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel/propagation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// cloudEventsSpecVersion is the only supported version of CloudEvents.
+	cloudEventsSpecVersion = "1.0"
+	// cloudEventsSourceHeader is the custom header the source of a CloudEvent
+	// is stored in.
+	cloudEventsSourceHeader = "source"
+	// cloudEventsHeaderPrefix is the prefix of attributes in binary mode.
+	cloudEventsHeaderPrefix = "ce-"
+
+	contentTypeProtobuf = "application/protobuf"
+	contentTypeJSON     = "application/json"
+	typeURLPrefix       = "type.googleapis.com/"
+)
+
+// cloudEvent is a CloudEvent independent of the mode and format used to
+// send it.
+type cloudEvent struct {
+	specVersion     string
+	id              string
+	source          string
+	eventType       string
+	subject         string
+	time            *time.Time
+	dataContentType string
+	dataSchema      string
+	extensions      map[string]string
+
+	// data is the data of the event, used unless protoData is set.
+	data []byte
+	// protoData is set if the data is a Protobuf message in the structured
+	// Protobuf format.
+	protoData *anypb.Any
+}
+
+func (e *EventsServiceServer) PublishCloudEvent(ctx context.Context, req *eventsv1alpha1.PublishCloudEventRequest) (*eventsv1alpha1.PublishCloudEventResponse, error) {
+	var event *cloudEvent
+	var err error
+	switch r := req.Event.(type) {
+	case *eventsv1alpha1.PublishCloudEventRequest_Structured:
+		event, err = parseStructuredCloudEvent(r.Structured)
+	case *eventsv1alpha1.PublishCloudEventRequest_StructuredJson:
+		event, err = parseStructuredJSONCloudEvent(r.StructuredJson)
+	case *eventsv1alpha1.PublishCloudEventRequest_Binary:
+		event, err = parseBinaryCloudEvent(r.Binary)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "no event specified")
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid CloudEvent: "+err.Error())
+	}
+
+	config, err := e.toCloudEventPublishConfig(ctx, event, req)
+	if err != nil {
+		return nil, toPublishStatusError(err)
+	}
+
+	// Continue the trace the event is part of
+	ctx = e.w3cPropagator.Extract(ctx, propagation.MapCarrier{
+		"traceparent": event.extensions["traceparent"],
+		"tracestate":  event.extensions["tracestate"],
+	})
+
+	ack, err := e.events.Publish(ctx, config)
+	if err != nil {
+		return nil, toPublishStatusError(err)
+	}
+
+	return &eventsv1alpha1.PublishCloudEventResponse{
+		Id: ack.ID,
+	}, nil
+}
+
+// toCloudEventPublishConfig converts a CloudEvent into the configuration
+// used to publish it.
+func (e *EventsServiceServer) toCloudEventPublishConfig(
+	ctx context.Context,
+	event *cloudEvent,
+	req *eventsv1alpha1.PublishCloudEventRequest,
+) (*events.PublishConfig, error) {
+	subject := event.subject
+	if req.Subject != nil {
+		subject = *req.Subject
+	}
+
+	if subject == "" {
+		return nil, errors.Wrap(events.ErrInvalidData, "subject must be set in the request or the event")
+	}
+
+	data, err := e.cloudEventData(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(event.extensions)+1)
+	for name, value := range event.extensions {
+		if name == "traceparent" || name == "tracestate" {
+			// Used for tracing instead
+			continue
+		}
+
+		headers[name] = value
+	}
+	headers[cloudEventsSourceHeader] = event.source
+
+	publishedTime := time.Now()
+	if event.time != nil {
+		publishedTime = *event.time
+	}
+
+	return &events.PublishConfig{
+		Subject:            subject,
+		Data:               data,
+		PublishedTime:      &publishedTime,
+		IdempotencyKey:     event.id,
+		ExpectedSubjectSeq: req.ExpectedLastId,
+		Headers:            headers,
+	}, nil
+}
+
+// cloudEventData converts the data of a CloudEvent into a Protobuf message.
+// The type of the message is taken from dataschema if set, otherwise from
+// the type of the event.
+func (e *EventsServiceServer) cloudEventData(ctx context.Context, event *cloudEvent) (*anypb.Any, error) {
+	if event.protoData != nil {
+		return event.protoData, nil
+	}
+
+	name := event.eventType
+	if event.dataSchema != "" {
+		name = event.dataSchema[strings.LastIndexByte(event.dataSchema, '/')+1:]
+	}
+
+	switch {
+	case isProtobufContentType(event.dataContentType):
+		return &anypb.Any{
+			TypeUrl: typeURLPrefix + name,
+			Value:   event.data,
+		}, nil
+	case isJSONContentType(event.dataContentType):
+		data, err := e.schemas.DecodeMessageJSON(ctx, name, event.data)
+		if schemas.IsValidationError(err) {
+			return nil, errors.Wrapf(events.ErrInvalidData, "%s", err)
+		} else if err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	}
+
+	return nil, errors.Wrapf(events.ErrInvalidData, "unsupported content type %s", event.dataContentType)
+}
+
+// setCloudEvent replaces the data of an event being delivered with a
+// CloudEvent in the requested mode.
+func (e *EventsServiceServer) setCloudEvent(ctx context.Context, event *eventsv1alpha1.Event, options *deliveryOptions) {
+	ce := e.newCloudEvent(ctx, event, options)
+	event.Data = nil
+	event.JsonData = nil
+
+	switch options.cloudEvents {
+	case eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED:
+		event.CloudEvent = &eventsv1alpha1.Event_StructuredCloudEvent{
+			StructuredCloudEvent: ce.toStructured(),
+		}
+	case eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED_JSON:
+		event.CloudEvent = &eventsv1alpha1.Event_StructuredJsonCloudEvent{
+			StructuredJsonCloudEvent: ce.toStructuredJSON(),
+		}
+	case eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_BINARY:
+		event.CloudEvent = &eventsv1alpha1.Event_BinaryCloudEvent{
+			BinaryCloudEvent: ce.toBinary(),
+		}
+	}
+}
+
+// newCloudEvent creates a CloudEvent from an event being delivered. The
+// event must have its tracing headers set.
+func (e *EventsServiceServer) newCloudEvent(ctx context.Context, event *eventsv1alpha1.Event, options *deliveryOptions) *cloudEvent {
+	ce := &cloudEvent{
+		specVersion: cloudEventsSpecVersion,
+		id:          strconv.FormatUint(event.Id, 10),
+		source:      "/windshift/streams/" + options.stream,
+		eventType:   string(event.Data.MessageName()),
+		subject:     event.Subject,
+		dataSchema:  event.Data.TypeUrl,
+		extensions:  make(map[string]string),
+	}
+
+	headers := event.Headers
+	if headers.IdempotencyKey != nil {
+		ce.id = *headers.IdempotencyKey
+	}
+
+	if headers.Timestamp != nil {
+		t := headers.Timestamp.AsTime()
+		ce.time = &t
+	}
+
+	for name, value := range headers.Custom {
+		if name == cloudEventsSourceHeader {
+			ce.source = value
+		} else if isValidExtensionName(name) {
+			ce.extensions[name] = value
+		}
+	}
+
+	if headers.TraceParent != nil {
+		ce.extensions["traceparent"] = *headers.TraceParent
+	}
+
+	if headers.TraceState != nil {
+		ce.extensions["tracestate"] = *headers.TraceState
+	}
+
+	if options.encoding == eventsv1alpha1.Encoding_ENCODING_JSON {
+		data, err := e.schemas.EncodeMessageJSON(ctx, event.Data)
+		if err == nil {
+			ce.dataContentType = contentTypeJSON
+			ce.data = data
+			return ce
+		}
+
+		e.logger.Debug(
+			"Could not encode event data as JSON",
+			zap.Uint64("id", event.Id),
+			zap.String("type", event.Data.TypeUrl),
+			zap.Error(err),
+		)
+	}
+
+	ce.dataContentType = contentTypeProtobuf
+	ce.data = event.Data.Value
+	ce.protoData = event.Data
+	return ce
+}
+
+// toStructured converts the event into the structured Protobuf format.
+func (c *cloudEvent) toStructured() *eventsv1alpha1.CloudEvent {
+	attributes := make(map[string]*eventsv1alpha1.CloudEvent_CloudEventAttributeValue, len(c.extensions)+4)
+	stringAttribute := func(name string, value string) {
+		if value != "" {
+			attributes[name] = &eventsv1alpha1.CloudEvent_CloudEventAttributeValue{
+				Attr: &eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeString{CeString: value},
+			}
+		}
+	}
+
+	stringAttribute("subject", c.subject)
+	stringAttribute("datacontenttype", c.dataContentType)
+	if c.dataSchema != "" {
+		attributes["dataschema"] = &eventsv1alpha1.CloudEvent_CloudEventAttributeValue{
+			Attr: &eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeUri{CeUri: c.dataSchema},
+		}
+	}
+	if c.time != nil {
+		attributes["time"] = &eventsv1alpha1.CloudEvent_CloudEventAttributeValue{
+			Attr: &eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(*c.time)},
+		}
+	}
+	for name, value := range c.extensions {
+		stringAttribute(name, value)
+	}
+
+	res := &eventsv1alpha1.CloudEvent{
+		Id:          c.id,
+		Source:      c.source,
+		SpecVersion: c.specVersion,
+		Type:        c.eventType,
+		Attributes:  attributes,
+	}
+
+	if c.protoData != nil {
+		res.Data = &eventsv1alpha1.CloudEvent_ProtoData{ProtoData: c.protoData}
+	} else {
+		res.Data = &eventsv1alpha1.CloudEvent_TextData{TextData: string(c.data)}
+	}
+
+	return res
+}
+
+// toStructuredJSON converts the event into the structured JSON format.
+func (c *cloudEvent) toStructuredJSON() string {
+	res := make(map[string]any, len(c.extensions)+8)
+	for name, value := range c.extensions {
+		res[name] = value
+	}
+
+	for name, value := range c.attributes() {
+		res[name] = value
+	}
+
+	if isJSONContentType(c.dataContentType) {
+		res["data"] = json.RawMessage(c.data)
+	} else {
+		res["data_base64"] = base64.StdEncoding.EncodeToString(c.data)
+	}
+
+	// Only strings and valid JSON are included, so this can not fail
+	data, _ := json.Marshal(res)
+	return string(data)
+}
+
+// toBinary converts the event into binary mode.
+func (c *cloudEvent) toBinary() *eventsv1alpha1.BinaryCloudEvent {
+	headers := make(map[string]string, len(c.extensions)+8)
+	for name, value := range c.extensions {
+		headers[cloudEventsHeaderPrefix+name] = value
+	}
+
+	for name, value := range c.attributes() {
+		if name == "datacontenttype" {
+			headers["content-type"] = value
+			continue
+		}
+
+		headers[cloudEventsHeaderPrefix+name] = value
+	}
+
+	return &eventsv1alpha1.BinaryCloudEvent{
+		Headers: headers,
+		Data:    c.data,
+	}
+}
+
+// attributes returns the context attributes that are set as strings.
+func (c *cloudEvent) attributes() map[string]string {
+	res := map[string]string{
+		"specversion": c.specVersion,
+		"id":          c.id,
+		"source":      c.source,
+		"type":        c.eventType,
+	}
+
+	if c.subject != "" {
+		res["subject"] = c.subject
+	}
+
+	if c.time != nil {
+		res["time"] = c.time.Format(time.RFC3339Nano)
+	}
+
+	if c.dataContentType != "" {
+		res["datacontenttype"] = c.dataContentType
+	}
+
+	if c.dataSchema != "" {
+		res["dataschema"] = c.dataSchema
+	}
+
+	return res
+}
+
+// setAttribute sets an attribute from its string representation.
+func (c *cloudEvent) setAttribute(name string, value string) error {
+	switch name {
+	case "specversion":
+		c.specVersion = value
+	case "id":
+		c.id = value
+	case "source":
+		c.source = value
+	case "type":
+		c.eventType = value
+	case "subject":
+		c.subject = value
+	case "time":
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return errors.Newf("invalid time: %s", value)
+		}
+		c.time = &t
+	case "datacontenttype":
+		c.dataContentType = value
+	case "dataschema":
+		c.dataSchema = value
+	default:
+		if !isValidExtensionName(name) {
+			return errors.Newf("invalid attribute name: %s", name)
+		}
+
+		c.extensions[name] = value
+	}
+
+	return nil
+}
+
+// validate checks that the required attributes are set.
+func (c *cloudEvent) validate() error {
+	if c.specVersion != cloudEventsSpecVersion {
+		return errors.Newf("unsupported specversion %q, only %s is supported", c.specVersion, cloudEventsSpecVersion)
+	}
+
+	if c.id == "" {
+		return errors.New("id must be set")
+	}
+
+	if c.source == "" {
+		return errors.New("source must be set")
+	}
+
+	if c.eventType == "" {
+		return errors.New("type must be set")
+	}
+
+	return nil
+}
+
+// parseStructuredCloudEvent parses an event in the structured Protobuf
+// format.
+func parseStructuredCloudEvent(event *eventsv1alpha1.CloudEvent) (*cloudEvent, error) {
+	res := &cloudEvent{
+		specVersion: event.SpecVersion,
+		id:          event.Id,
+		source:      event.Source,
+		eventType:   event.Type,
+		extensions:  make(map[string]string),
+	}
+
+	for name, value := range event.Attributes {
+		err := res.setAttribute(name, attributeString(value))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch data := event.Data.(type) {
+	case *eventsv1alpha1.CloudEvent_BinaryData:
+		res.data = data.BinaryData
+	case *eventsv1alpha1.CloudEvent_TextData:
+		res.data = []byte(data.TextData)
+	case *eventsv1alpha1.CloudEvent_ProtoData:
+		res.protoData = data.ProtoData
+	}
+
+	return res, res.validate()
+}
+
+// parseStructuredJSONCloudEvent parses an event in the structured JSON
+// format.
+func parseStructuredJSONCloudEvent(event string) (*cloudEvent, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal([]byte(event), &fields)
+	if err != nil {
+		return nil, errors.New("event is not a JSON object")
+	}
+
+	res := &cloudEvent{
+		extensions: make(map[string]string),
+	}
+
+	for name, value := range fields {
+		if name == "data" || name == "data_base64" {
+			continue
+		}
+
+		str, err2 := jsonAttributeString(value)
+		if err2 != nil {
+			return nil, errors.Newf("invalid value of attribute %s", name)
+		}
+
+		if str == nil {
+			// Attributes set to null are treated as not set
+			continue
+		}
+
+		err2 = res.setAttribute(name, *str)
+		if err2 != nil {
+			return nil, err2
+		}
+	}
+
+	if data, ok := fields["data_base64"]; ok {
+		var encoded string
+		err = json.Unmarshal(data, &encoded)
+		if err == nil {
+			res.data, err = base64.StdEncoding.DecodeString(encoded)
+		}
+
+		if err != nil {
+			return nil, errors.New("data_base64 must be a base64 encoded string")
+		}
+	} else if data, ok := fields["data"]; ok {
+		if res.dataContentType == "" {
+			// JSON is the default content type in the JSON format
+			res.dataContentType = contentTypeJSON
+		}
+
+		if isJSONContentType(res.dataContentType) {
+			res.data = data
+		} else {
+			var text string
+			err = json.Unmarshal(data, &text)
+			if err != nil {
+				return nil, errors.New("data must be a string if it is not JSON")
+			}
+
+			res.data = []byte(text)
+		}
+	}
+
+	return res, res.validate()
+}
+
+// parseBinaryCloudEvent parses an event in binary mode.
+func parseBinaryCloudEvent(event *eventsv1alpha1.BinaryCloudEvent) (*cloudEvent, error) {
+	res := &cloudEvent{
+		extensions: make(map[string]string),
+		data:       event.Data,
+	}
+
+	for name, value := range event.Headers {
+		name = strings.ToLower(name)
+		if name == "content-type" {
+			res.dataContentType = value
+			continue
+		}
+
+		if !strings.HasPrefix(name, cloudEventsHeaderPrefix) {
+			// Other headers are not part of the event
+			continue
+		}
+
+		err := res.setAttribute(name[len(cloudEventsHeaderPrefix):], value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, res.validate()
+}
+
+// attributeString returns the string representation of an attribute value
+// in the structured Protobuf format.
+func attributeString(value *eventsv1alpha1.CloudEvent_CloudEventAttributeValue) string {
+	switch v := value.GetAttr().(type) {
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeBoolean:
+		return strconv.FormatBool(v.CeBoolean)
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeInteger:
+		return strconv.FormatInt(int64(v.CeInteger), 10)
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeString:
+		return v.CeString
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeBytes:
+		return base64.StdEncoding.EncodeToString(v.CeBytes)
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeUri:
+		return v.CeUri
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeUriRef:
+		return v.CeUriRef
+	case *eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeTimestamp:
+		return v.CeTimestamp.AsTime().Format(time.RFC3339Nano)
+	}
+
+	return ""
+}
+
+// jsonAttributeString returns the string representation of an attribute
+// value in the structured JSON format. Returns nil if the value is null.
+func jsonAttributeString(value json.RawMessage) (*string, error) {
+	var v any
+	decoder := json.NewDecoder(strings.NewReader(string(value)))
+	decoder.UseNumber()
+	err := decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+
+	var res string
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		res = v
+	case bool:
+		res = strconv.FormatBool(v)
+	case json.Number:
+		res = v.String()
+	default:
+		return nil, errors.New("attributes must be strings, numbers or booleans")
+	}
+
+	return &res, nil
+}
+
+// isValidExtensionName checks if a name can be used for an extension
+// attribute. Names may only contain `a` to `z` and `0` to `9`, and can not be
+// the name of a context attribute.
+func isValidExtensionName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	switch name {
+	case "specversion", "id", "source", "type", "subject", "time",
+		"datacontenttype", "dataschema", "data", "data_base64":
+		return false
+	}
+
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isProtobufContentType checks if a content type is used for Protobuf data.
+// Data without a content type is treated as Protobuf.
+func isProtobufContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == contentTypeProtobuf || mediaType == "application/x-protobuf"
+}
+
+// isJSONContentType checks if a content type is used for JSON data.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == contentTypeJSON || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
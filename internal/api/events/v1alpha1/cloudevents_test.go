@@ -0,0 +1,243 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("CloudEvents", func() {
+	var service eventsv1alpha1.EventsServiceClient
+	var consumerID string
+
+	fetch := func(ctx context.Context, mode eventsv1alpha1.CloudEventsMode, encoding eventsv1alpha1.Encoding) []*eventsv1alpha1.Event {
+		GinkgoHelper()
+
+		res, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+			Stream:      "events",
+			Consumer:    consumerID,
+			MaxWait:     durationpb.New(100 * time.Millisecond),
+			Encoding:    &encoding,
+			CloudEvents: &mode,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Events
+	}
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		consumerID = res.Id
+	})
+
+	Describe("Publishing", func() {
+		It("can publish a structured event", func(ctx context.Context) {
+			_, err := service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Event: &eventsv1alpha1.PublishCloudEventRequest_Structured{
+					Structured: &eventsv1alpha1.CloudEvent{
+						Id:          "event-1",
+						Source:      "/tests",
+						SpecVersion: "1.0",
+						Type:        "windshift.test.v1.StringValue",
+						Attributes: map[string]*eventsv1alpha1.CloudEvent_CloudEventAttributeValue{
+							"subject": {
+								Attr: &eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeString{CeString: "events.test"},
+							},
+							"tenant": {
+								Attr: &eventsv1alpha1.CloudEvent_CloudEventAttributeValue_CeString{CeString: "acme"},
+							},
+						},
+						Data: &eventsv1alpha1.CloudEvent_ProtoData{
+							ProtoData: Data(&testv1.StringValue{Value: "test"}),
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Subject).To(Equal("events.test"))
+			Expect(events[0].Headers.GetIdempotencyKey()).To(Equal("event-1"))
+			Expect(events[0].Headers.Custom).To(HaveKeyWithValue("source", "/tests"))
+			Expect(events[0].Headers.Custom).To(HaveKeyWithValue("tenant", "acme"))
+			Expect(proto.Equal(events[0].Data, Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+		})
+
+		It("can publish a structured JSON event", func(ctx context.Context) {
+			_, err := service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Event: &eventsv1alpha1.PublishCloudEventRequest_StructuredJson{
+					StructuredJson: `{
+						"specversion": "1.0",
+						"id": "event-1",
+						"source": "/tests",
+						"type": "windshift.test.v1.StringValue",
+						"subject": "events.test",
+						"time": "2024-01-02T03:04:05Z",
+						"data": {"value": "test"}
+					}`,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Headers.Timestamp.AsTime()).To(Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+			Expect(proto.Equal(events[0].Data, Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+		})
+
+		It("can publish a binary event", func(ctx context.Context) {
+			data, err := proto.Marshal(&testv1.StringValue{Value: "test"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Subject: proto.String("events.test"),
+				Event: &eventsv1alpha1.PublishCloudEventRequest_Binary{
+					Binary: &eventsv1alpha1.BinaryCloudEvent{
+						Headers: map[string]string{
+							"ce-specversion": "1.0",
+							"ce-id":          "event-1",
+							"ce-source":      "/tests",
+							"ce-type":        "test.value",
+							"ce-dataschema":  "type.googleapis.com/windshift.test.v1.StringValue",
+							"Content-Type":   "application/protobuf",
+						},
+						Data: data,
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+			Expect(events).To(HaveLen(1))
+			Expect(proto.Equal(events[0].Data, Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+		})
+
+		It("the id is used to deduplicate events", func(ctx context.Context) {
+			req := &eventsv1alpha1.PublishCloudEventRequest{
+				Subject: proto.String("events.test"),
+				Event: &eventsv1alpha1.PublishCloudEventRequest_StructuredJson{
+					StructuredJson: `{"specversion": "1.0", "id": "event-1", "source": "/tests", "type": "windshift.test.v1.StringValue", "data": {"value": "test"}}`,
+				},
+			}
+
+			res1, err := service.PublishCloudEvent(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			res2, err := service.PublishCloudEvent(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res2.Id).To(Equal(res1.Id))
+		})
+
+		It("publishing an unsupported spec version fails", func(ctx context.Context) {
+			_, err := service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Subject: proto.String("events.test"),
+				Event: &eventsv1alpha1.PublishCloudEventRequest_StructuredJson{
+					StructuredJson: `{"specversion": "0.3", "id": "event-1", "source": "/tests", "type": "windshift.test.v1.StringValue", "data": {"value": "test"}}`,
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("publishing without a subject fails", func(ctx context.Context) {
+			_, err := service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Event: &eventsv1alpha1.PublishCloudEventRequest_StructuredJson{
+					StructuredJson: `{"specversion": "1.0", "id": "event-1", "source": "/tests", "type": "windshift.test.v1.StringValue", "data": {"value": "test"}}`,
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("publishing JSON data of an unknown type fails", func(ctx context.Context) {
+			_, err := service.PublishCloudEvent(ctx, &eventsv1alpha1.PublishCloudEventRequest{
+				Subject: proto.String("events.test"),
+				Event: &eventsv1alpha1.PublishCloudEventRequest_StructuredJson{
+					StructuredJson: `{"specversion": "1.0", "id": "event-1", "source": "/tests", "type": "orders.v1.Unknown", "data": {}}`,
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Delivering", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject:        "events.test",
+				Data:           Data(&testv1.StringValue{Value: "test"}),
+				IdempotencyKey: proto.String("event-1"),
+				Headers: map[string]string{
+					"tenant": "acme",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can receive structured events", func(ctx context.Context) {
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Data).To(BeNil())
+
+			ce := events[0].GetStructuredCloudEvent()
+			Expect(ce).ToNot(BeNil())
+			Expect(ce.Id).To(Equal("event-1"))
+			Expect(ce.Source).To(Equal("/windshift/streams/events"))
+			Expect(ce.SpecVersion).To(Equal("1.0"))
+			Expect(ce.Type).To(Equal("windshift.test.v1.StringValue"))
+			Expect(ce.Attributes["subject"].GetCeString()).To(Equal("events.test"))
+			Expect(ce.Attributes["tenant"].GetCeString()).To(Equal("acme"))
+			Expect(ce.Attributes["time"].GetCeTimestamp()).ToNot(BeNil())
+			Expect(proto.Equal(ce.GetProtoData(), Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+		})
+
+		It("can receive structured JSON events", func(ctx context.Context) {
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED_JSON, eventsv1alpha1.Encoding_ENCODING_JSON)
+			Expect(events).To(HaveLen(1))
+
+			ce := events[0].GetStructuredJsonCloudEvent()
+			Expect(ce).To(ContainSubstring(`"specversion":"1.0"`))
+			Expect(ce).To(ContainSubstring(`"datacontenttype":"application/json"`))
+			Expect(ce).To(ContainSubstring(`"data":{"value":"test"}`))
+		})
+
+		It("can receive binary events", func(ctx context.Context) {
+			events := fetch(ctx, eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_BINARY, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+			Expect(events).To(HaveLen(1))
+
+			ce := events[0].GetBinaryCloudEvent()
+			Expect(ce.Headers).To(HaveKeyWithValue("ce-id", "event-1"))
+			Expect(ce.Headers).To(HaveKeyWithValue("ce-type", "windshift.test.v1.StringValue"))
+			Expect(ce.Headers).To(HaveKeyWithValue("ce-tenant", "acme"))
+			Expect(ce.Headers).To(HaveKeyWithValue("content-type", "application/protobuf"))
+
+			var value testv1.StringValue
+			Expect(proto.Unmarshal(ce.Data, &value)).To(Succeed())
+			Expect(value.Value).To(Equal("test"))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"github.com/levelfourab/windshift-server/internal/events"
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
@@ -9,6 +10,8 @@ import (
 	"github.com/cockroachdb/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (e *EventsServiceServer) EnsureConsumer(ctx context.Context, req *eventsv1alpha1.EnsureConsumerRequest) (*eventsv1alpha1.EnsureConsumerResponse, error) {
@@ -29,6 +32,23 @@ func (e *EventsServiceServer) EnsureConsumer(ctx context.Context, req *eventsv1a
 		config.From = toStreamPointer(req.From)
 	}
 
+	if req.DeadLetterSubject != nil {
+		config.DeadLetterSubject = *req.DeadLetterSubject
+	}
+
+	if req.MaxDeliveryAttempts != nil {
+		config.MaxDeliveryAttempts = uint(*req.MaxDeliveryAttempts)
+	}
+
+	if req.RedeliveryBackoff != nil {
+		backoff, err := toBackoff(req.RedeliveryBackoff, config.MaxDeliveryAttempts)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Backoff = backoff
+	}
+
 	consumer, err := e.events.EnsureConsumer(ctx, config)
 	if errors.Is(err, context.Canceled) {
 		return nil, status.Error(codes.Canceled, "context canceled")
@@ -44,3 +64,134 @@ func (e *EventsServiceServer) EnsureConsumer(ctx context.Context, req *eventsv1a
 		Id: consumer.ID,
 	}, nil
 }
+
+func (e *EventsServiceServer) GetConsumer(ctx context.Context, req *eventsv1alpha1.GetConsumerRequest) (*eventsv1alpha1.GetConsumerResponse, error) {
+	consumer, err := e.events.GetConsumer(ctx, req.Stream, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &eventsv1alpha1.GetConsumerResponse{
+		Consumer: toConsumerInfo(consumer),
+	}, nil
+}
+
+func (e *EventsServiceServer) ListConsumers(ctx context.Context, req *eventsv1alpha1.ListConsumersRequest) (*eventsv1alpha1.ListConsumersResponse, error) {
+	config := &events.ListConsumersConfig{
+		Stream: req.Stream,
+		Limit:  defaultPageSize,
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		config.After = *req.PageToken
+	}
+
+	list, err := e.events.ListConsumers(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &eventsv1alpha1.ListConsumersResponse{
+		Consumers: make([]*eventsv1alpha1.ConsumerInfo, len(list.Consumers)),
+	}
+	for i, consumer := range list.Consumers {
+		res.Consumers[i] = toConsumerInfo(consumer)
+	}
+
+	if list.HasMore && len(list.Consumers) > 0 {
+		// The id of the last consumer is used to continue listing
+		nextPageToken := list.Consumers[len(list.Consumers)-1].ID
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+func toBackoff(b *eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff, attempts uint) ([]time.Duration, error) {
+	switch policy := b.Policy.(type) {
+	case *eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Explicit:
+		delays := make([]time.Duration, len(policy.Explicit.Delays))
+		for i, delay := range policy.Explicit.Delays {
+			delays[i] = delay.AsDuration()
+		}
+
+		return delays, nil
+	case *eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Exponential_:
+		exponential := policy.Exponential
+		if exponential.InitialDelay == nil || exponential.InitialDelay.AsDuration() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "initial delay of exponential backoff must be greater than 0")
+		}
+
+		multiplier := 2.0
+		if exponential.Multiplier != nil {
+			if *exponential.Multiplier < 1 {
+				return nil, status.Error(codes.InvalidArgument, "multiplier of exponential backoff must be at least 1")
+			}
+
+			multiplier = *exponential.Multiplier
+		}
+
+		var maxDelay time.Duration
+		if exponential.MaxDelay != nil {
+			maxDelay = exponential.MaxDelay.AsDuration()
+		}
+
+		return events.ExponentialBackoff(exponential.InitialDelay.AsDuration(), maxDelay, multiplier, attempts), nil
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "a backoff policy must be specified")
+}
+
+func toConsumerInfo(consumer *events.Consumer) *eventsv1alpha1.ConsumerInfo {
+	res := &eventsv1alpha1.ConsumerInfo{
+		Stream:              consumer.Config.Stream,
+		Id:                  consumer.ID,
+		Durable:             consumer.Durable,
+		Created:             timestamppb.New(consumer.Created),
+		Subjects:            consumer.Config.Subjects,
+		From:                fromStreamPointer(consumer.Config.From),
+		ProcessingTimeout:   durationpb.New(consumer.Config.Timeout),
+		MaxDeliveryAttempts: uint32(consumer.Config.MaxDeliveryAttempts),
+		State: &eventsv1alpha1.ConsumerInfo_State{
+			Pending:         consumer.State.Pending,
+			AckPending:      uint64(consumer.State.AckPending),
+			Redelivered:     uint64(consumer.State.Redelivered),
+			LastDeliveredId: consumer.State.LastDeliveredID,
+			AckFloorId:      consumer.State.AckFloorID,
+		},
+	}
+
+	if res.From == nil {
+		// No pointer means that the consumer only receives new events
+		res.From = &eventsv1alpha1.StreamPointer{
+			Pointer: &eventsv1alpha1.StreamPointer_End{
+				End: true,
+			},
+		}
+	}
+
+	if consumer.Config.DeadLetterSubject != "" {
+		res.DeadLetterSubject = &consumer.Config.DeadLetterSubject
+	}
+
+	if len(consumer.Config.Backoff) > 0 {
+		res.RedeliveryBackoff = make([]*durationpb.Duration, len(consumer.Config.Backoff))
+		for i, delay := range consumer.Config.Backoff {
+			res.RedeliveryBackoff[i] = durationpb.New(delay)
+		}
+	}
+
+	if !consumer.State.LastDeliveredTime.IsZero() {
+		res.State.LastDeliveredTimestamp = timestamppb.New(consumer.State.LastDeliveredTime)
+	}
+
+	return res
+}
@@ -2,11 +2,15 @@ package v1alpha1_test
 
 import (
 	"context"
+	"time"
 
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var _ = Describe("Consumers", func() {
@@ -63,4 +67,144 @@ var _ = Describe("Consumers", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Describe("Information", func() {
+		It("can get a consumer", func(ctx context.Context) {
+			subID := "test-sub"
+			_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+				Stream:   "test",
+				Name:     &subID,
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+				Stream: "test",
+				Id:     subID,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Consumer.Id).To(Equal(subID))
+			Expect(res.Consumer.Stream).To(Equal("test"))
+			Expect(res.Consumer.Durable).To(BeTrue())
+			Expect(res.Consumer.Subjects).To(Equal([]string{"test"}))
+			Expect(res.Consumer.ProcessingTimeout.AsDuration()).To(Equal(30 * time.Second))
+			Expect(res.Consumer.From.GetEnd()).To(BeTrue())
+			Expect(res.Consumer.State.Pending).To(Equal(uint64(0)))
+		})
+
+		It("getting a missing consumer returns not found", func(ctx context.Context) {
+			_, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+				Stream: "test",
+				Id:     "missing",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("can list consumers", func(ctx context.Context) {
+			for _, name := range []string{"b", "a"} {
+				subID := name
+				_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+					Stream:   "test",
+					Name:     &subID,
+					Subjects: []string{"test"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			pageSize := uint32(1)
+			page1, err := service.ListConsumers(ctx, &eventsv1alpha1.ListConsumersRequest{
+				Stream:   "test",
+				PageSize: &pageSize,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page1.Consumers).To(HaveLen(1))
+			Expect(page1.Consumers[0].Id).To(Equal("a"))
+			Expect(page1.NextPageToken).ToNot(BeNil())
+
+			page2, err := service.ListConsumers(ctx, &eventsv1alpha1.ListConsumersRequest{
+				Stream:    "test",
+				PageSize:  &pageSize,
+				PageToken: page1.NextPageToken,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page2.Consumers).To(HaveLen(1))
+			Expect(page2.Consumers[0].Id).To(Equal("b"))
+			Expect(page2.NextPageToken).To(BeNil())
+		})
+	})
+
+	Describe("Redelivery", func() {
+		It("can set explicit backoff", func(ctx context.Context) {
+			subID := "test-sub"
+			attempts := uint32(3)
+			_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+				Stream:              "test",
+				Name:                &subID,
+				Subjects:            []string{"test"},
+				ProcessingTimeout:   durationpb.New(time.Second),
+				MaxDeliveryAttempts: &attempts,
+				RedeliveryBackoff: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff{
+					Policy: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Explicit{
+						Explicit: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Delays{
+							Delays: []*durationpb.Duration{
+								durationpb.New(time.Second),
+								durationpb.New(5 * time.Second),
+							},
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+				Stream: "test",
+				Id:     subID,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Consumer.MaxDeliveryAttempts).To(Equal(uint32(3)))
+			Expect(res.Consumer.RedeliveryBackoff).To(HaveLen(2))
+			Expect(res.Consumer.RedeliveryBackoff[1].AsDuration()).To(Equal(5 * time.Second))
+		})
+
+		It("can set exponential backoff", func(ctx context.Context) {
+			subID := "test-sub"
+			attempts := uint32(4)
+			_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+				Stream:              "test",
+				Name:                &subID,
+				Subjects:            []string{"test"},
+				ProcessingTimeout:   durationpb.New(time.Second),
+				MaxDeliveryAttempts: &attempts,
+				RedeliveryBackoff: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff{
+					Policy: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Exponential_{
+						Exponential: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Exponential{
+							InitialDelay: durationpb.New(time.Second),
+						},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+				Stream: "test",
+				Id:     subID,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Consumer.RedeliveryBackoff).To(HaveLen(3))
+			Expect(res.Consumer.RedeliveryBackoff[2].AsDuration()).To(Equal(4 * time.Second))
+		})
+
+		It("exponential backoff without initial delay fails", func(ctx context.Context) {
+			_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+				Stream:   "test",
+				Subjects: []string{"test"},
+				RedeliveryBackoff: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff{
+					Policy: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Exponential_{
+						Exponential: &eventsv1alpha1.EnsureConsumerRequest_RedeliveryBackoff_Exponential{},
+					},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
 })
@@ -0,0 +1,122 @@
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e *EventsServiceServer) ListDeadLetters(ctx context.Context, req *eventsv1alpha1.ListDeadLettersRequest) (*eventsv1alpha1.ListDeadLettersResponse, error) {
+	config := &events.ListDeadLettersConfig{
+		Stream:   req.Stream,
+		Consumer: req.Consumer,
+		Limit:    defaultPageSize,
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		after, err := strconv.ParseUint(*req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+
+		config.After = after
+	}
+
+	list, err := e.events.ListDeadLetters(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &eventsv1alpha1.ListDeadLettersResponse{
+		DeadLetters: make([]*eventsv1alpha1.DeadLetter, len(list.DeadLetters)),
+	}
+	for i, deadLetter := range list.DeadLetters {
+		res.DeadLetters[i] = toDeadLetter(deadLetter)
+	}
+
+	if list.HasMore && len(list.DeadLetters) > 0 {
+		// The id of the last dead letter is used to continue listing
+		nextPageToken := strconv.FormatUint(list.DeadLetters[len(list.DeadLetters)-1].ID, 10)
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+func (e *EventsServiceServer) RequeueDeadLetters(ctx context.Context, req *eventsv1alpha1.RequeueDeadLettersRequest) (*eventsv1alpha1.RequeueDeadLettersResponse, error) {
+	processedIDs := make([]uint64, 0, len(req.Ids))
+	invalidIDs := make([]uint64, 0, len(req.Ids))
+	temporaryErrors := make([]uint64, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		_, err := e.events.RequeueDeadLetter(ctx, req.Stream, req.Consumer, id)
+		if errors.Is(err, events.ErrDeadLetterNotFound) {
+			invalidIDs = append(invalidIDs, id)
+		} else if errors.Is(err, events.ErrStreamNotFound) ||
+			errors.Is(err, events.ErrConsumerNotFound) ||
+			events.IsValidationError(err) {
+			// The request itself is invalid, so no need to continue
+			return nil, toStatusError(err)
+		} else if err != nil {
+			e.logger.Warn("Could not requeue dead letter", zap.Uint64("id", id), zap.Error(err))
+			temporaryErrors = append(temporaryErrors, id)
+		} else {
+			processedIDs = append(processedIDs, id)
+		}
+	}
+
+	return &eventsv1alpha1.RequeueDeadLettersResponse{
+		Ids:                processedIDs,
+		InvalidIds:         invalidIDs,
+		TemporaryFailedIds: temporaryErrors,
+	}, nil
+}
+
+func toDeadLetter(deadLetter *events.DeadLetter) *eventsv1alpha1.DeadLetter {
+	reason := eventsv1alpha1.DeadLetter_REASON_UNSPECIFIED
+	switch deadLetter.Reason {
+	case events.DeadLetterReasonMaxDeliveries:
+		reason = eventsv1alpha1.DeadLetter_REASON_MAX_DELIVERIES
+	case events.DeadLetterReasonRejected:
+		reason = eventsv1alpha1.DeadLetter_REASON_REJECTED
+	}
+
+	res := &eventsv1alpha1.DeadLetter{
+		Id:               deadLetter.ID,
+		Subject:          deadLetter.Subject,
+		Stream:           deadLetter.Stream,
+		Consumer:         deadLetter.Consumer,
+		EventId:          deadLetter.StreamSeq,
+		DeliveryAttempts: deadLetter.Deliveries,
+		Reason:           reason,
+		Timestamp:        timestamppb.New(deadLetter.Timestamp),
+		Headers: &eventsv1alpha1.Headers{
+			Timestamp:   timestamppb.New(deadLetter.Headers.PublishedAt),
+			TraceParent: deadLetter.Headers.TraceParent,
+			TraceState:  deadLetter.Headers.TraceState,
+			Custom:      deadLetter.Headers.Custom,
+		},
+		Data: deadLetter.Data,
+	}
+
+	if deadLetter.Failure != nil {
+		res.Failure = toFailure(deadLetter.Failure)
+	}
+
+	return res
+}
@@ -0,0 +1,139 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Dead letters", func() {
+	var service eventsv1alpha1.EventsServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		for _, name := range []string{"events", "dead-letters"} {
+			_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+				Name: name,
+				Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+					Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+						Subjects: []string{name + ".>"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("listing dead letters without a dead-letter subject fails", func(ctx context.Context) {
+		consumerName := "test"
+		_, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.ListDeadLetters(ctx, &eventsv1alpha1.ListDeadLettersRequest{
+			Stream:   "events",
+			Consumer: consumerName,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("rejected events can be listed and requeued", NodeTimeout(10*time.Second), func(ctx context.Context) {
+		consumerName := "test"
+		deadLetterSubject := "dead-letters.test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:            "events",
+			Name:              &consumerName,
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: &deadLetterSubject,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumer, err := service.GetConsumer(ctx, &eventsv1alpha1.GetConsumerRequest{
+			Stream: "events",
+			Id:     res.Id,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumer.Consumer.GetDeadLetterSubject()).To(Equal(deadLetterSubject))
+
+		_, err = service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		client, err := service.Events(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer client.CloseSend() //nolint:errcheck
+
+		err = client.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Subscribe_{
+				Subscribe: &eventsv1alpha1.EventsRequest_Subscribe{
+					Stream:   "events",
+					Consumer: res.Id,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+
+		in, err := client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		event := in.GetEvent()
+		Expect(event).ToNot(BeNil())
+
+		permanently := true
+		err = client.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Reject_{
+				Reject: &eventsv1alpha1.EventsRequest_Reject{
+					Ids:         []uint64{event.Id},
+					Permanently: &permanently,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+
+		var deadLetters []*eventsv1alpha1.DeadLetter
+		Eventually(func(g Gomega) {
+			list, err := service.ListDeadLetters(ctx, &eventsv1alpha1.ListDeadLettersRequest{
+				Stream:   "events",
+				Consumer: res.Id,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(list.DeadLetters).To(HaveLen(1))
+			deadLetters = list.DeadLetters
+		}).WithContext(ctx).Should(Succeed())
+
+		Expect(deadLetters[0].Subject).To(Equal("events.test"))
+		Expect(deadLetters[0].Reason).To(Equal(eventsv1alpha1.DeadLetter_REASON_REJECTED))
+
+		requeue, err := service.RequeueDeadLetters(ctx, &eventsv1alpha1.RequeueDeadLettersRequest{
+			Stream:   "events",
+			Consumer: res.Id,
+			Ids:      []uint64{deadLetters[0].Id, 1000},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requeue.Ids).To(Equal([]uint64{deadLetters[0].Id}))
+		Expect(requeue.InvalidIds).To(Equal([]uint64{1000}))
+
+		in, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(in.GetEvent()).ToNot(BeNil())
+		Expect(in.GetEvent().Subject).To(Equal("events.test"))
+	})
+})
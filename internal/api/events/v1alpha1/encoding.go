@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// decodeData returns the data of an event being published, decoding it from
+// JSON if needed.
+func (e *EventsServiceServer) decodeData(ctx context.Context, req *eventsv1alpha1.PublishEventRequest) (*anypb.Any, error) {
+	if req.JsonData == nil {
+		return req.Data, nil
+	}
+
+	if req.Data != nil {
+		return nil, errors.Wrap(events.ErrInvalidData, "data and json_data can not both be set")
+	}
+
+	data, err := e.schemas.DecodeJSON(ctx, *req.JsonData)
+	if schemas.IsValidationError(err) {
+		return nil, errors.Wrapf(events.ErrInvalidData, "%s", err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// encodeData sets the data of an event using the requested encoding. If the
+// data can not be encoded as JSON it is kept as Protobuf.
+func (e *EventsServiceServer) encodeData(ctx context.Context, event *eventsv1alpha1.Event, encoding eventsv1alpha1.Encoding) {
+	if encoding != eventsv1alpha1.Encoding_ENCODING_JSON || event.Data == nil {
+		return
+	}
+
+	data, err := e.schemas.EncodeJSON(ctx, event.Data)
+	if err != nil {
+		e.logger.Debug(
+			"Could not encode event data as JSON",
+			zap.Uint64("id", event.Id),
+			zap.String("type", event.Data.TypeUrl),
+			zap.Error(err),
+		)
+		return
+	}
+
+	event.Data = nil
+	event.JsonData = &data
+}
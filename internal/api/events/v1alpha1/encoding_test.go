@@ -0,0 +1,163 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("JSON encoding", func() {
+	var service eventsv1alpha1.EventsServiceClient
+	var consumerID string
+
+	stringValueJSON := `{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "value": "test"}`
+
+	fetch := func(ctx context.Context, encoding eventsv1alpha1.Encoding) []*eventsv1alpha1.Event {
+		GinkgoHelper()
+
+		res, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+			Stream:   "events",
+			Consumer: consumerID,
+			MaxWait:  durationpb.New(100 * time.Millisecond),
+			Encoding: &encoding,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Events
+	}
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		consumerID = res.Id
+	})
+
+	It("can publish JSON and receive Protobuf", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:  "events.test",
+			JsonData: proto.String(stringValueJSON),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		events := fetch(ctx, eventsv1alpha1.Encoding_ENCODING_PROTOBUF)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].JsonData).To(BeNil())
+		Expect(proto.Equal(events[0].Data, Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+	})
+
+	It("can publish Protobuf and receive JSON", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&testv1.StringValue{Value: "test"}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		events := fetch(ctx, eventsv1alpha1.Encoding_ENCODING_JSON)
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Data).To(BeNil())
+		Expect(events[0].GetJsonData()).To(MatchJSON(stringValueJSON))
+	})
+
+	It("can receive JSON when subscribing", NodeTimeout(5*time.Second), func(ctx context.Context) {
+		stream, err := service.Events(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.CloseSend() //nolint:errcheck
+
+		encoding := eventsv1alpha1.Encoding_ENCODING_JSON
+		err = stream.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Subscribe_{
+				Subscribe: &eventsv1alpha1.EventsRequest_Subscribe{
+					Stream:   "events",
+					Consumer: consumerID,
+					Encoding: &encoding,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.GetSubscribed()).ToNot(BeNil())
+
+		_, err = service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&testv1.StringValue{Value: "test"}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err = stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.GetEvent().GetJsonData()).To(MatchJSON(stringValueJSON))
+	})
+
+	It("publishing invalid JSON fails", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:  "events.test",
+			JsonData: proto.String(`{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "unknown": 1}`),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("publishing JSON of an unknown type fails", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:  "events.test",
+			JsonData: proto.String(`{"@type": "type.googleapis.com/orders.v1.Unknown"}`),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("publishing both data and JSON fails", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:  "events.test",
+			Data:     Data(&testv1.StringValue{Value: "test"}),
+			JsonData: proto.String(stringValueJSON),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("invalid JSON in a batch only fails that event", func(ctx context.Context) {
+		res, err := service.PublishEvents(ctx, &eventsv1alpha1.PublishEventsRequest{
+			Events: []*eventsv1alpha1.PublishEventRequest{
+				{
+					Subject:  "events.test",
+					JsonData: proto.String(`not json`),
+				},
+				{
+					Subject:  "events.test",
+					JsonData: proto.String(stringValueJSON),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Results).To(HaveLen(2))
+		Expect(res.Results[0].Index).To(Equal(uint32(0)))
+		Expect(res.Results[0].GetError().GetCode()).To(Equal(eventsv1alpha1.PublishError_CODE_INVALID_ARGUMENT))
+		Expect(res.Results[1].Index).To(Equal(uint32(1)))
+		Expect(res.Results[1].GetId()).To(BeNumerically(">", 0))
+	})
+})
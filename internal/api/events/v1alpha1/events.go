@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"context"
 	"time"
 
 	"github.com/levelfourab/windshift-server/internal/events"
@@ -25,7 +26,14 @@ func (e *EventsServiceServer) Events(server eventsv1alpha1.EventsService_EventsS
 	}
 
 	var events *events.Events
+	var options *deliveryOptions
 	if sub := subscribe.GetSubscribe(); sub != nil {
+		options = &deliveryOptions{
+			stream:      sub.Stream,
+			encoding:    sub.GetEncoding(),
+			cloudEvents: sub.GetCloudEvents(),
+		}
+
 		config := e.createEventConsumeConfig(sub)
 
 		var err2 error
@@ -94,27 +102,10 @@ func (e *EventsServiceServer) Events(server eventsv1alpha1.EventsService_EventsS
 		case event := <-events.Incoming():
 			eventMap.Add(event)
 
-			// Create the common headers
-			headers := &eventsv1alpha1.Headers{
-				Timestamp:      timestamppb.New(event.Headers.PublishedAt),
-				IdempotencyKey: event.Headers.IdempotencyKey,
-			}
-
-			// Inject the span from the event context
-			e.w3cPropagator.Inject(event.Context, eventTracingHeaders{
-				headers: headers,
-			})
-
 			// Send the actual event
 			err = server.Send(&eventsv1alpha1.EventsResponse{
 				Response: &eventsv1alpha1.EventsResponse_Event{
-					Event: &eventsv1alpha1.Event{
-						Id:              event.StreamSeq,
-						Data:            event.Data,
-						Subject:         event.Subject,
-						Headers:         headers,
-						DeliveryAttempt: event.DeliveryAttempt,
-					},
+					Event: e.toEvent(ctx, event, options),
 				},
 			})
 			if err != nil {
@@ -201,6 +192,11 @@ func (e *EventsServiceServer) handleReject(
 	ids := r.Reject.Ids
 	permanently := r.Reject.Permanently
 	delay := r.Reject.Delay
+	failure := fromReject(r.Reject)
+	err := events.ValidateFailure(failure)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	processedIDs := make([]uint64, 0, len(ids))
 	invalidIDs := make([]uint64, 0, len(ids))
@@ -208,6 +204,10 @@ func (e *EventsServiceServer) handleReject(
 	for _, id := range ids {
 		event := eventMap.Get(id)
 		if event != nil {
+			if failure != nil {
+				event.SetFailure(failure)
+			}
+
 			var err error
 			if permanently != nil && *permanently {
 				err = event.RejectPermanently()
@@ -234,7 +234,7 @@ func (e *EventsServiceServer) handleReject(
 		}
 	}
 
-	err := server.Send(&eventsv1alpha1.EventsResponse{
+	err = server.Send(&eventsv1alpha1.EventsResponse{
 		Response: &eventsv1alpha1.EventsResponse_RejectConfirmation_{
 			RejectConfirmation: &eventsv1alpha1.EventsResponse_RejectConfirmation{
 				Ids:                processedIDs,
@@ -308,3 +308,52 @@ func (*EventsServiceServer) createEventConsumeConfig(sub *eventsv1alpha1.EventsR
 		MaxPendingEvents: maxPendingEvents,
 	}
 }
+
+// deliveryOptions control how events are sent to clients.
+type deliveryOptions struct {
+	// stream is the stream the events are consumed from.
+	stream string
+	// encoding is the encoding of the data of events.
+	encoding eventsv1alpha1.Encoding
+	// cloudEvents is set if events are sent as CloudEvents.
+	cloudEvents eventsv1alpha1.CloudEventsMode
+}
+
+// toEvent converts an event into its API representation, with the data in
+// the requested encoding.
+func (e *EventsServiceServer) toEvent(ctx context.Context, event *events.Event, options *deliveryOptions) *eventsv1alpha1.Event {
+	// Create the common headers
+	headers := &eventsv1alpha1.Headers{
+		Timestamp:      timestamppb.New(event.Headers.PublishedAt),
+		IdempotencyKey: event.Headers.IdempotencyKey,
+		Custom:         event.Headers.Custom,
+	}
+
+	// Inject the span from the event context
+	e.w3cPropagator.Inject(event.Context, eventTracingHeaders{
+		headers: headers,
+	})
+
+	res := &eventsv1alpha1.Event{
+		Id:              event.StreamSeq,
+		Data:            event.Data,
+		Subject:         event.Subject,
+		Headers:         headers,
+		DeliveryAttempt: event.DeliveryAttempt,
+		AckToken:        event.AckToken,
+	}
+
+	e.encodeEvent(ctx, res, options)
+	return res
+}
+
+// encodeEvent sets the data of an event in the requested encoding, or as a
+// CloudEvent if requested.
+func (e *EventsServiceServer) encodeEvent(ctx context.Context, event *eventsv1alpha1.Event, options *deliveryOptions) {
+	if options.cloudEvents != eventsv1alpha1.CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED {
+		e.setCloudEvent(ctx, event, options)
+		return
+	}
+
+	e.encodeData(ctx, event, options.encoding)
+}
@@ -0,0 +1,103 @@
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e *EventsServiceServer) ListFailures(ctx context.Context, req *eventsv1alpha1.ListFailuresRequest) (*eventsv1alpha1.ListFailuresResponse, error) {
+	config := &events.ListFailuresConfig{
+		Stream:   req.Stream,
+		Consumer: req.Consumer,
+		Limit:    defaultPageSize,
+	}
+
+	if req.EventId != nil {
+		config.StreamSeq = *req.EventId
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		after, err := strconv.ParseUint(*req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+
+		config.After = after
+	}
+
+	list, err := e.events.ListFailures(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &eventsv1alpha1.ListFailuresResponse{
+		Failures: make([]*eventsv1alpha1.FailureRecord, len(list.Failures)),
+	}
+	for i, record := range list.Failures {
+		res.Failures[i] = &eventsv1alpha1.FailureRecord{
+			Id:              record.ID,
+			Stream:          record.Stream,
+			Consumer:        record.Consumer,
+			EventId:         record.StreamSeq,
+			DeliveryAttempt: record.DeliveryAttempt,
+			Permanent:       record.Permanent,
+			Timestamp:       timestamppb.New(record.Timestamp),
+			Failure:         toFailure(record.Failure),
+		}
+	}
+
+	if list.HasMore && len(list.Failures) > 0 {
+		// The id of the last record is used to continue listing
+		nextPageToken := strconv.FormatUint(list.Failures[len(list.Failures)-1].ID, 10)
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+func toFailure(failure *events.Failure) *eventsv1alpha1.Failure {
+	res := &eventsv1alpha1.Failure{
+		Reason:  failure.Reason,
+		Details: failure.Details,
+	}
+
+	if failure.Code != "" {
+		res.Code = &failure.Code
+	}
+
+	return res
+}
+
+// fromReject returns the failure described by a reject request, or nil if
+// no reason was provided.
+func fromReject(reject *eventsv1alpha1.EventsRequest_Reject) *events.Failure {
+	if reject.Reason == nil || *reject.Reason == "" {
+		return nil
+	}
+
+	failure := &events.Failure{
+		Reason:  *reject.Reason,
+		Details: reject.Details,
+	}
+
+	if reject.Code != nil {
+		failure.Code = *reject.Code
+	}
+
+	return failure
+}
@@ -0,0 +1,115 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Failures", func() {
+	var service eventsv1alpha1.EventsServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejecting with a reason records the failure", NodeTimeout(10*time.Second), func(ctx context.Context) {
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		client, err := service.Events(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer client.CloseSend() //nolint:errcheck
+
+		err = client.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Subscribe_{
+				Subscribe: &eventsv1alpha1.EventsRequest_Subscribe{
+					Stream:   "events",
+					Consumer: res.Id,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+
+		in, err := client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		event := in.GetEvent()
+		Expect(event).ToNot(BeNil())
+
+		reason := "could not reach payment provider"
+		code := "UNAVAILABLE"
+		err = client.Send(&eventsv1alpha1.EventsRequest{
+			Request: &eventsv1alpha1.EventsRequest_Reject_{
+				Reject: &eventsv1alpha1.EventsRequest_Reject{
+					Ids:    []uint64{event.Id},
+					Reason: &reason,
+					Code:   &code,
+					Details: map[string]string{
+						"provider": "test",
+					},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		in, err = client.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(in.GetRejectConfirmation().GetIds()).To(Equal([]uint64{event.Id}))
+
+		list, err := service.ListFailures(ctx, &eventsv1alpha1.ListFailuresRequest{
+			Stream:   "events",
+			Consumer: res.Id,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Failures).To(HaveLen(1))
+		Expect(list.NextPageToken).To(BeNil())
+
+		failure := list.Failures[0]
+		Expect(failure.DeliveryAttempt).To(Equal(uint64(1)))
+		Expect(failure.Permanent).To(BeFalse())
+		Expect(failure.Failure.Reason).To(Equal(reason))
+		Expect(failure.Failure.GetCode()).To(Equal(code))
+		Expect(failure.Failure.Details).To(HaveKeyWithValue("provider", "test"))
+	})
+
+	It("listing with an invalid page token fails", func(ctx context.Context) {
+		pageToken := "invalid"
+		_, err := service.ListFailures(ctx, &eventsv1alpha1.ListFailuresRequest{
+			Stream:    "events",
+			Consumer:  "test",
+			PageToken: &pageToken,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,64 @@
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFetchMaxEvents = 10
+	maxFetchMaxEvents     = 1000
+	defaultFetchMaxWait   = 5 * time.Second
+	maxFetchMaxWait       = time.Minute
+)
+
+func (e *EventsServiceServer) FetchEvents(ctx context.Context, req *eventsv1alpha1.FetchEventsRequest) (*eventsv1alpha1.FetchEventsResponse, error) {
+	config := &events.FetchConfig{
+		Stream:    req.Stream,
+		Name:      req.Consumer,
+		MaxEvents: defaultFetchMaxEvents,
+		MaxWait:   defaultFetchMaxWait,
+	}
+
+	if req.MaxEvents != nil {
+		if *req.MaxEvents == 0 || *req.MaxEvents > maxFetchMaxEvents {
+			return nil, status.Errorf(codes.InvalidArgument, "max events must be between 1 and %d", maxFetchMaxEvents)
+		}
+
+		config.MaxEvents = uint(*req.MaxEvents)
+	}
+
+	if req.MaxWait != nil {
+		maxWait := req.MaxWait.AsDuration()
+		if maxWait <= 0 || maxWait > maxFetchMaxWait {
+			return nil, status.Errorf(codes.InvalidArgument, "max wait must be greater than 0 and at most %s", maxFetchMaxWait)
+		}
+
+		config.MaxWait = maxWait
+	}
+
+	fetched, err := e.events.Fetch(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	options := &deliveryOptions{
+		stream:      req.Stream,
+		encoding:    req.GetEncoding(),
+		cloudEvents: req.GetCloudEvents(),
+	}
+	res := &eventsv1alpha1.FetchEventsResponse{
+		Events: make([]*eventsv1alpha1.Event, len(fetched)),
+	}
+	for i, event := range fetched {
+		res.Events[i] = e.toEvent(ctx, event, options)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,102 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Fetching events", func() {
+	var service eventsv1alpha1.EventsServiceClient
+	var consumerID string
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		consumerID = res.Id
+	})
+
+	It("can drain a consumer", NodeTimeout(10*time.Second), func(ctx context.Context) {
+		for i := 0; i < 3; i++ {
+			_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		maxEvents := uint32(2)
+		received := 0
+		for {
+			res, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+				Stream:    "events",
+				Consumer:  consumerID,
+				MaxEvents: &maxEvents,
+				MaxWait:   durationpb.New(100 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			if len(res.Events) == 0 {
+				break
+			}
+
+			tokens := make([]string, len(res.Events))
+			for i, event := range res.Events {
+				Expect(event.Subject).To(Equal("events.test"))
+				tokens[i] = event.AckToken
+			}
+
+			ack, err := service.AckEvents(ctx, &eventsv1alpha1.AckEventsRequest{
+				Tokens: tokens,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ack.Tokens).To(HaveLen(len(tokens)))
+
+			received += len(res.Events)
+		}
+
+		Expect(received).To(Equal(3))
+	})
+
+	It("fetching from a missing consumer returns not found", func(ctx context.Context) {
+		_, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+			Stream:   "events",
+			Consumer: "missing",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("invalid max events fails", func(ctx context.Context) {
+		maxEvents := uint32(0)
+		_, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+			Stream:    "events",
+			Consumer:  consumerID,
+			MaxEvents: &maxEvents,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
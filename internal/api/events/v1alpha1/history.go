@@ -0,0 +1,120 @@
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e *EventsServiceServer) GetEvent(ctx context.Context, req *eventsv1alpha1.GetEventRequest) (*eventsv1alpha1.GetEventResponse, error) {
+	event, err := e.events.GetEvent(ctx, req.Stream, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	options := &deliveryOptions{
+		stream:      req.Stream,
+		encoding:    req.GetEncoding(),
+		cloudEvents: req.GetCloudEvents(),
+	}
+	return &eventsv1alpha1.GetEventResponse{
+		Event: e.toStoredEvent(ctx, event, options),
+	}, nil
+}
+
+func (e *EventsServiceServer) ReadEvents(ctx context.Context, req *eventsv1alpha1.ReadEventsRequest) (*eventsv1alpha1.ReadEventsResponse, error) {
+	config := &events.ReadEventsConfig{
+		Stream: req.Stream,
+		Limit:  defaultPageSize,
+	}
+
+	if req.Subject != nil {
+		config.Subject = *req.Subject
+	}
+
+	switch start := req.Start.(type) {
+	case *eventsv1alpha1.ReadEventsRequest_StartId:
+		config.StartID = start.StartId
+	case *eventsv1alpha1.ReadEventsRequest_StartTime:
+		config.StartTime = start.StartTime.AsTime()
+	}
+
+	switch end := req.End.(type) {
+	case *eventsv1alpha1.ReadEventsRequest_EndId:
+		config.EndID = end.EndId
+	case *eventsv1alpha1.ReadEventsRequest_EndTime:
+		config.EndTime = end.EndTime.AsTime()
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		after, err := strconv.ParseUint(*req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+
+		// Continue after the last event of the previous page
+		config.StartID = after + 1
+		config.StartTime = time.Time{}
+	}
+
+	list, err := e.events.ReadEvents(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	options := &deliveryOptions{
+		stream:      req.Stream,
+		encoding:    req.GetEncoding(),
+		cloudEvents: req.GetCloudEvents(),
+	}
+	res := &eventsv1alpha1.ReadEventsResponse{
+		Events: make([]*eventsv1alpha1.Event, len(list.Events)),
+	}
+	for i, event := range list.Events {
+		res.Events[i] = e.toStoredEvent(ctx, event, options)
+	}
+
+	if list.HasMore && len(list.Events) > 0 {
+		// The id of the last event is used to continue reading
+		nextPageToken := strconv.FormatUint(list.Events[len(list.Events)-1].ID, 10)
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+// toStoredEvent converts an event read from a stream into its API
+// representation. The event was not delivered by a consumer, so it has no
+// ack token or delivery attempt.
+func (e *EventsServiceServer) toStoredEvent(ctx context.Context, event *events.StoredEvent, options *deliveryOptions) *eventsv1alpha1.Event {
+	res := &eventsv1alpha1.Event{
+		Id:      event.ID,
+		Subject: event.Subject,
+		Headers: &eventsv1alpha1.Headers{
+			Timestamp:      timestamppb.New(event.Headers.PublishedAt),
+			IdempotencyKey: event.Headers.IdempotencyKey,
+			TraceParent:    event.Headers.TraceParent,
+			TraceState:     event.Headers.TraceState,
+			Custom:         event.Headers.Custom,
+		},
+		Data: event.Data,
+	}
+
+	e.encodeEvent(ctx, res, options)
+	return res
+}
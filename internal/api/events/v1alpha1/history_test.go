@@ -0,0 +1,149 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Reading events", func() {
+	var service eventsv1alpha1.EventsServiceClient
+
+	publish := func(ctx context.Context, subject string, value string) uint64 {
+		GinkgoHelper()
+
+		res, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: subject,
+			Data:    Data(&testv1.StringValue{Value: value}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Id
+	}
+
+	ids := func(events []*eventsv1alpha1.Event) []uint64 {
+		res := make([]uint64, len(events))
+		for i, event := range events {
+			res[i] = event.Id
+		}
+		return res
+	}
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("GetEvent", func() {
+		It("can get an event", func(ctx context.Context) {
+			id := publish(ctx, "events.test", "test")
+
+			res, err := service.GetEvent(ctx, &eventsv1alpha1.GetEventRequest{
+				Stream: "events",
+				Id:     id,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Event.Id).To(Equal(id))
+			Expect(res.Event.Subject).To(Equal("events.test"))
+			Expect(res.Event.AckToken).To(BeEmpty())
+
+			var value testv1.StringValue
+			Expect(res.Event.Data.UnmarshalTo(&value)).To(Succeed())
+			Expect(value.Value).To(Equal("test"))
+		})
+
+		It("can get an event encoded as JSON", func(ctx context.Context) {
+			id := publish(ctx, "events.test", "test")
+
+			encoding := eventsv1alpha1.Encoding_ENCODING_JSON
+			res, err := service.GetEvent(ctx, &eventsv1alpha1.GetEventRequest{
+				Stream:   "events",
+				Id:       id,
+				Encoding: &encoding,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Event.Data).To(BeNil())
+			Expect(res.Event.GetJsonData()).To(MatchJSON(`{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "value": "test"}`))
+		})
+
+		It("returns not found for missing events", func(ctx context.Context) {
+			_, err := service.GetEvent(ctx, &eventsv1alpha1.GetEventRequest{
+				Stream: "events",
+				Id:     100,
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("ReadEvents", func() {
+		It("can page through events", func(ctx context.Context) {
+			first := publish(ctx, "events.test", "1")
+			second := publish(ctx, "events.test", "2")
+			third := publish(ctx, "events.test", "3")
+
+			res, err := service.ReadEvents(ctx, &eventsv1alpha1.ReadEventsRequest{
+				Stream:   "events",
+				PageSize: proto.Uint32(2),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids(res.Events)).To(Equal([]uint64{first, second}))
+			Expect(res.NextPageToken).ToNot(BeNil())
+
+			res, err = service.ReadEvents(ctx, &eventsv1alpha1.ReadEventsRequest{
+				Stream:    "events",
+				PageSize:  proto.Uint32(2),
+				PageToken: res.NextPageToken,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids(res.Events)).To(Equal([]uint64{third}))
+			Expect(res.NextPageToken).To(BeNil())
+		})
+
+		It("can read a range filtered by subject", func(ctx context.Context) {
+			publish(ctx, "events.a", "1")
+			second := publish(ctx, "events.a", "2")
+			publish(ctx, "events.b", "3")
+			fourth := publish(ctx, "events.a", "4")
+			publish(ctx, "events.a", "5")
+
+			res, err := service.ReadEvents(ctx, &eventsv1alpha1.ReadEventsRequest{
+				Stream:  "events",
+				Subject: proto.String("events.a"),
+				Start:   &eventsv1alpha1.ReadEventsRequest_StartId{StartId: second},
+				End:     &eventsv1alpha1.ReadEventsRequest_EndId{EndId: fourth},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids(res.Events)).To(Equal([]uint64{second, fourth}))
+		})
+
+		It("returns not found for missing streams", func(ctx context.Context) {
+			_, err := service.ReadEvents(ctx, &eventsv1alpha1.ReadEventsRequest{
+				Stream: "unknown",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("rejects invalid page tokens", func(ctx context.Context) {
+			_, err := service.ReadEvents(ctx, &eventsv1alpha1.ReadEventsRequest{
+				Stream:    "events",
+				PageToken: proto.String("invalid"),
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+})
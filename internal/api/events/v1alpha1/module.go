@@ -5,6 +5,7 @@ import (
 
 	"github.com/levelfourab/windshift-server/internal/events"
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
 
 	"github.com/levelfourab/sprout-go"
 	"go.opentelemetry.io/otel/propagation"
@@ -27,6 +28,7 @@ type EventsServiceServer struct {
 	w3cPropagator propagation.TextMapPropagator
 
 	events     *events.Manager
+	schemas    *schemas.Manager
 	globalStop chan struct{}
 }
 
@@ -34,12 +36,14 @@ func newEventsServiceServer(
 	lifecycle fx.Lifecycle,
 	logger *zap.Logger,
 	events *events.Manager,
+	schemas *schemas.Manager,
 ) *EventsServiceServer {
 	server := &EventsServiceServer{
 		logger:        logger,
 		w3cPropagator: propagation.TraceContext{},
 
 		events:     events,
+		schemas:    schemas,
 		globalStop: make(chan struct{}),
 	}
 
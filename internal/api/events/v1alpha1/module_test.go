@@ -9,6 +9,7 @@ import (
 	"github.com/levelfourab/windshift-server/internal/api/events/v1alpha1"
 	"github.com/levelfourab/windshift-server/internal/events"
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
 
 	"github.com/levelfourab/sprout-go"
 	"github.com/levelfourab/sprout-go/test"
@@ -30,12 +31,14 @@ import (
 
 func GetClient() (eventsv1alpha1.EventsServiceClient, nats.JetStreamContext) {
 	t := GinkgoT()
+	t.Setenv("EVENTS_ACK_TOKEN_KEY", "test-key")
 	var conn *grpc.ClientConn
 	var nats *nats.Conn
 	fx := fxtest.New(
 		t,
 		test.Module(t),
 		events.Module,
+		schemas.Module,
 		v1alpha1.Module,
 		TestModule,
 		fx.Populate(&conn, &nats),
@@ -2,22 +2,220 @@ package v1alpha1
 
 import (
 	"context"
-	"errors"
+	"io"
 	"time"
 
 	"github.com/levelfourab/windshift-server/internal/events"
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
 
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// maxPublishBatchSize is the maximum number of events in PublishEvents.
+	maxPublishBatchSize = 1000
+	// maxPendingStreamPublishes is the maximum number of events that are
+	// waiting for confirmation in PublishStream before the server stops
+	// receiving more events.
+	maxPendingStreamPublishes = 256
+)
+
 func (e *EventsServiceServer) PublishEvent(ctx context.Context, req *eventsv1alpha1.PublishEventRequest) (*eventsv1alpha1.PublishEventResponse, error) {
-	now := time.Now()
+	var ack *events.PublishedEvent
+	config, err := e.toPublishConfig(ctx, req)
+	if err == nil {
+		ack, err = e.events.Publish(ctx, config)
+	}
+
+	if err != nil {
+		return nil, toPublishStatusError(err)
+	}
+
+	if ack.ScheduledID != 0 {
+		return &eventsv1alpha1.PublishEventResponse{
+			ScheduledId: &ack.ScheduledID,
+		}, nil
+	}
+
+	return &eventsv1alpha1.PublishEventResponse{
+		Id: ack.ID,
+	}, nil
+}
+
+func (e *EventsServiceServer) PublishEvents(ctx context.Context, req *eventsv1alpha1.PublishEventsRequest) (*eventsv1alpha1.PublishEventsResponse, error) {
+	if len(req.Events) > maxPublishBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d events can be published at once", maxPublishBatchSize)
+	}
+
+	res := &eventsv1alpha1.PublishEventsResponse{
+		Results: make([]*eventsv1alpha1.PublishResult, len(req.Events)),
+	}
+
+	// Events that can not be converted are not published, indexes keeps
+	// track of which request each of the published events came from
+	configs := make([]*events.PublishConfig, 0, len(req.Events))
+	indexes := make([]uint32, 0, len(req.Events))
+	for i, event := range req.Events {
+		config, err := e.toPublishConfig(ctx, event)
+		if err != nil {
+			res.Results[i] = e.toPublishResult(uint32(i), nil, err)
+			continue
+		}
+
+		configs = append(configs, config)
+		indexes = append(indexes, uint32(i))
+	}
+
+	results := e.events.PublishBatch(ctx, configs)
+	for i, result := range results {
+		index := indexes[i]
+		res.Results[index] = e.toPublishResult(index, result.Event, result.Err)
+	}
+
+	return res, nil
+}
+
+func (e *EventsServiceServer) PublishStream(server eventsv1alpha1.EventsService_PublishStreamServer) error {
+	ctx := server.Context()
+
+	// Events are sent to NATS as they are received and confirmations are
+	// waited for in a separate goroutine, in the order they were received
+	type pendingResult struct {
+		index   uint32
+		pending *events.PendingPublish
+		err     error
+	}
+
+	pending := make(chan *pendingResult, maxPendingStreamPublishes)
+	sendDone := make(chan error, 1)
+	go func() {
+		var sendErr error
+		for p := range pending {
+			var event *events.PublishedEvent
+			err := p.err
+			if err == nil {
+				event, err = p.pending.Wait(ctx)
+			}
+
+			if sendErr != nil {
+				// Keep draining so that the receiving side never blocks
+				continue
+			}
+
+			sendErr = server.Send(e.toPublishResult(p.index, event, err))
+		}
+
+		sendDone <- sendErr
+	}()
+
+	var index uint32
+	for {
+		req, err := server.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			close(pending)
+			<-sendDone
+			return err
+		}
+
+		var p *events.PendingPublish
+		config, err := e.toPublishConfig(ctx, req)
+		if err == nil {
+			p, err = e.events.PublishAsync(ctx, config)
+		}
+
+		pending <- &pendingResult{
+			index:   index,
+			pending: p,
+			err:     err,
+		}
+		index++
+	}
+
+	close(pending)
+	return <-sendDone
+}
+
+// toPublishResult converts the outcome of publishing an event into a result.
+func (e *EventsServiceServer) toPublishResult(index uint32, event *events.PublishedEvent, err error) *eventsv1alpha1.PublishResult {
+	if err == nil && event.ScheduledID != 0 {
+		return &eventsv1alpha1.PublishResult{
+			Index: index,
+			Result: &eventsv1alpha1.PublishResult_ScheduledId{
+				ScheduledId: event.ScheduledID,
+			},
+		}
+	} else if err == nil {
+		return &eventsv1alpha1.PublishResult{
+			Index: index,
+			Result: &eventsv1alpha1.PublishResult_Id{
+				Id: event.ID,
+			},
+		}
+	}
+
+	code := eventsv1alpha1.PublishError_CODE_INTERNAL
+	message := "internal error"
+	switch {
+	case events.IsValidationError(err), errors.Is(err, events.ErrInvalidData):
+		code = eventsv1alpha1.PublishError_CODE_INVALID_ARGUMENT
+		message = err.Error()
+	case errors.Is(err, events.ErrUnboundSubject):
+		code = eventsv1alpha1.PublishError_CODE_UNBOUND_SUBJECT
+		message = err.Error()
+	case errors.Is(err, events.ErrWrongSequence):
+		code = eventsv1alpha1.PublishError_CODE_WRONG_SEQUENCE
+		message = err.Error()
+	case errors.Is(err, events.ErrPublishTimeout),
+		errors.Is(err, context.DeadlineExceeded),
+		errors.Is(err, context.Canceled):
+		code = eventsv1alpha1.PublishError_CODE_TIMEOUT
+		message = "publish timed out"
+	default:
+		e.logger.Warn("Could not publish event", zap.Uint32("index", index), zap.Error(err))
+	}
+
+	return &eventsv1alpha1.PublishResult{
+		Index: index,
+		Result: &eventsv1alpha1.PublishResult_Error{
+			Error: &eventsv1alpha1.PublishError{
+				Code:    code,
+				Message: message,
+			},
+		},
+	}
+}
+
+// toPublishStatusError converts an error from publishing a single event into
+// a gRPC status error.
+func toPublishStatusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, events.ErrPublishTimeout) {
+		return status.Error(codes.DeadlineExceeded, "publish timed out")
+	} else if events.IsValidationError(err) || errors.Is(err, events.ErrInvalidData) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// toPublishConfig converts a request into the configuration used to publish
+// the event. Fails with events.ErrInvalidData if the data can not be decoded.
+func (e *EventsServiceServer) toPublishConfig(ctx context.Context, req *eventsv1alpha1.PublishEventRequest) (*events.PublishConfig, error) {
+	data, err := e.decodeData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &events.PublishConfig{
-		Subject:       req.Subject,
-		Data:          req.Data,
-		PublishedTime: &now,
+		Subject: req.Subject,
+		Data:    data,
+		Headers: req.Headers,
 	}
 
 	if req.Timestamp != nil {
@@ -33,18 +231,15 @@ func (e *EventsServiceServer) PublishEvent(ctx context.Context, req *eventsv1alp
 		config.ExpectedSubjectSeq = req.ExpectedLastId
 	}
 
-	ack, err := e.events.Publish(ctx, config)
-	if errors.Is(err, context.Canceled) {
-		return nil, status.Error(codes.Canceled, "context canceled")
-	} else if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, events.ErrPublishTimeout) {
-		return nil, status.Error(codes.DeadlineExceeded, "publish timed out")
-	} else if events.IsValidationError(err) {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	} else if err != nil {
-		return nil, err
+	if req.DeliverAt != nil && req.Delay != nil {
+		return nil, errors.Wrap(events.ErrInvalidData, "only one of deliver_at and delay can be set")
+	} else if req.DeliverAt != nil {
+		deliverAt := req.DeliverAt.AsTime()
+		config.DeliverAt = &deliverAt
+	} else if req.Delay != nil {
+		deliverAt := time.Now().Add(req.Delay.AsDuration())
+		config.DeliverAt = &deliverAt
 	}
 
-	return &eventsv1alpha1.PublishEventResponse{
-		Id: ack.ID,
-	}, nil
+	return config, nil
 }
@@ -2,12 +2,17 @@ package v1alpha1_test
 
 import (
 	"context"
+	"io"
+	"time"
 
 	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -155,4 +160,129 @@ var _ = Describe("Publisher", func() {
 		})
 		Expect(err).To(HaveOccurred())
 	})
+
+	Describe("Custom headers", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+				Name: "test",
+				Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+					Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+						Subjects: []string{"test"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("are delivered to consumers", func(ctx context.Context) {
+			consumerName := "test"
+			consumer, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+				Stream:   "test",
+				Name:     &consumerName,
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant": "acme",
+					"source": "billing",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+				Stream:   "test",
+				Consumer: consumer.Id,
+				MaxWait:  durationpb.New(100 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Events).To(HaveLen(1))
+			Expect(res.Events[0].Headers.Custom).To(Equal(map[string]string{
+				"tenant": "acme",
+				"source": "billing",
+			}))
+		})
+
+		It("invalid header name fails", func(ctx context.Context) {
+			_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant:id": "acme",
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Batches", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+				Name: "test",
+				Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+					Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+						Subjects: []string{"test"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can publish several events", func(ctx context.Context) {
+			res, err := service.PublishEvents(ctx, &eventsv1alpha1.PublishEventsRequest{
+				Events: []*eventsv1alpha1.PublishEventRequest{
+					{Subject: "test", Data: Data(&emptypb.Empty{})},
+					{Subject: "unbound", Data: Data(&emptypb.Empty{})},
+					{Subject: "test", Data: Data(&emptypb.Empty{})},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Results).To(HaveLen(3))
+
+			Expect(res.Results[0].Index).To(Equal(uint32(0)))
+			Expect(res.Results[0].GetId()).To(Equal(uint64(1)))
+			Expect(res.Results[1].Index).To(Equal(uint32(1)))
+			Expect(res.Results[1].GetError().GetCode()).To(Equal(eventsv1alpha1.PublishError_CODE_UNBOUND_SUBJECT))
+			Expect(res.Results[2].Index).To(Equal(uint32(2)))
+			Expect(res.Results[2].GetId()).To(Equal(uint64(2)))
+		})
+
+		It("can publish events over a stream", func(ctx context.Context) {
+			stream, err := service.PublishStream(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 10; i++ {
+				err = stream.Send(&eventsv1alpha1.PublishEventRequest{
+					Subject: "test",
+					Data:    Data(&emptypb.Empty{}),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			err = stream.Send(&eventsv1alpha1.PublishEventRequest{
+				Subject: "test.invalid.>",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.CloseSend()).To(Succeed())
+
+			for i := 0; i < 10; i++ {
+				res, err := stream.Recv()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.Index).To(Equal(uint32(i)))
+				Expect(res.GetId()).To(Equal(uint64(i + 1)))
+			}
+
+			res, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Index).To(Equal(uint32(10)))
+			Expect(res.GetError().GetCode()).To(Equal(eventsv1alpha1.PublishError_CODE_INVALID_ARGUMENT))
+
+			_, err = stream.Recv()
+			Expect(err).To(MatchError(io.EOF))
+		})
+	})
 })
@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (e *EventsServiceServer) ListScheduledEvents(ctx context.Context, req *eventsv1alpha1.ListScheduledEventsRequest) (*eventsv1alpha1.ListScheduledEventsResponse, error) {
+	config := &events.ListScheduledEventsConfig{
+		Limit: defaultPageSize,
+	}
+
+	if req.Subject != nil {
+		config.Subject = *req.Subject
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		after, err := strconv.ParseUint(*req.PageToken, 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+
+		config.After = after
+	}
+
+	list, err := e.events.ListScheduledEvents(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &eventsv1alpha1.ListScheduledEventsResponse{
+		Events: make([]*eventsv1alpha1.ScheduledEvent, len(list.Events)),
+	}
+	for i, event := range list.Events {
+		res.Events[i] = toScheduledEvent(event)
+	}
+
+	if list.HasMore && len(list.Events) > 0 {
+		// The id of the last event is used to continue listing
+		nextPageToken := strconv.FormatUint(list.Events[len(list.Events)-1].ID, 10)
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+func (e *EventsServiceServer) CancelScheduledEvent(ctx context.Context, req *eventsv1alpha1.CancelScheduledEventRequest) (*eventsv1alpha1.CancelScheduledEventResponse, error) {
+	err := e.events.CancelScheduledEvent(ctx, req.Id)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &eventsv1alpha1.CancelScheduledEventResponse{}, nil
+}
+
+func toScheduledEvent(event *events.ScheduledEvent) *eventsv1alpha1.ScheduledEvent {
+	return &eventsv1alpha1.ScheduledEvent{
+		Id:        event.ID,
+		Subject:   event.Subject,
+		DeliverAt: timestamppb.New(event.DeliverAt),
+		Headers: &eventsv1alpha1.Headers{
+			Timestamp:      timestamppb.New(event.Headers.PublishedAt),
+			IdempotencyKey: event.Headers.IdempotencyKey,
+			TraceParent:    event.Headers.TraceParent,
+			TraceState:     event.Headers.TraceState,
+			Custom:         event.Headers.Custom,
+		},
+		Data: event.Data,
+	}
+}
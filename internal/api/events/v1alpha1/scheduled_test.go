@@ -0,0 +1,136 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Scheduled events", func() {
+	var service eventsv1alpha1.EventsServiceClient
+	var consumerID string
+
+	fetch := func(ctx context.Context) []*eventsv1alpha1.Event {
+		GinkgoHelper()
+
+		res, err := service.FetchEvents(ctx, &eventsv1alpha1.FetchEventsRequest{
+			Stream:   "events",
+			Consumer: consumerID,
+			MaxWait:  durationpb.New(100 * time.Millisecond),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Events
+	}
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		consumerName := "test"
+		res, err := service.EnsureConsumer(ctx, &eventsv1alpha1.EnsureConsumerRequest{
+			Stream:   "events",
+			Name:     &consumerName,
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		consumerID = res.Id
+	})
+
+	It("can publish with a delay", NodeTimeout(5*time.Second), func(ctx context.Context) {
+		res, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject: "events.test",
+			Data:    Data(&testv1.StringValue{Value: "test"}),
+			Delay:   durationpb.New(500 * time.Millisecond),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Id).To(BeZero())
+		Expect(res.GetScheduledId()).ToNot(BeZero())
+
+		Expect(fetch(ctx)).To(BeEmpty())
+		Eventually(fetch).WithContext(ctx).Should(HaveLen(1))
+	})
+
+	It("can list and cancel scheduled events", func(ctx context.Context) {
+		deliverAt := time.Now().Add(time.Hour)
+		res, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:        "events.test",
+			Data:           Data(&testv1.StringValue{Value: "test"}),
+			IdempotencyKey: proto.String("reminder-1"),
+			DeliverAt:      timestamppb.New(deliverAt),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err := service.ListScheduledEvents(ctx, &eventsv1alpha1.ListScheduledEventsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Events).To(HaveLen(1))
+		Expect(list.Events[0].Id).To(Equal(res.GetScheduledId()))
+		Expect(list.Events[0].Subject).To(Equal("events.test"))
+		Expect(list.Events[0].DeliverAt.AsTime()).To(BeTemporally("~", deliverAt, time.Millisecond))
+		Expect(list.Events[0].Headers.GetIdempotencyKey()).To(Equal("reminder-1"))
+		Expect(list.NextPageToken).To(BeNil())
+
+		_, err = service.CancelScheduledEvent(ctx, &eventsv1alpha1.CancelScheduledEventRequest{
+			Id: res.GetScheduledId(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err = service.ListScheduledEvents(ctx, &eventsv1alpha1.ListScheduledEventsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Events).To(BeEmpty())
+	})
+
+	It("canceling a missing event returns not found", func(ctx context.Context) {
+		_, err := service.CancelScheduledEvent(ctx, &eventsv1alpha1.CancelScheduledEventRequest{
+			Id: 1000,
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("setting both deliver at and delay fails", func(ctx context.Context) {
+		_, err := service.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+			Subject:   "events.test",
+			Data:      Data(&testv1.StringValue{Value: "test"}),
+			DeliverAt: timestamppb.New(time.Now().Add(time.Hour)),
+			Delay:     durationpb.New(time.Hour),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("scheduled events in a batch return a scheduled id", func(ctx context.Context) {
+		res, err := service.PublishEvents(ctx, &eventsv1alpha1.PublishEventsRequest{
+			Events: []*eventsv1alpha1.PublishEventRequest{
+				{
+					Subject: "events.test",
+					Data:    Data(&testv1.StringValue{Value: "test"}),
+				},
+				{
+					Subject: "events.test",
+					Data:    Data(&testv1.StringValue{Value: "test"}),
+					Delay:   durationpb.New(time.Hour),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Results[0].GetId()).ToNot(BeZero())
+		Expect(res.Results[1].GetScheduledId()).ToNot(BeZero())
+	})
+})
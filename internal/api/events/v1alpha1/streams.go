@@ -9,6 +9,17 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultPageSize is the number of items returned by list calls when no
+	// page size is requested.
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of items that can be requested in a
+	// single page.
+	maxPageSize = 1000
 )
 
 func (e *EventsServiceServer) EnsureStream(ctx context.Context, req *eventsv1alpha1.EnsureStreamRequest) (*eventsv1alpha1.EnsureStreamResponse, error) {
@@ -92,7 +103,7 @@ func (e *EventsServiceServer) EnsureStream(ctx context.Context, req *eventsv1alp
 		config.MaxEventSize = &maxEventSize
 	}
 
-	_, err := e.events.EnsureStream(ctx, config)
+	stream, err := e.events.EnsureStream(ctx, config)
 	if errors.Is(err, context.Canceled) {
 		return nil, status.Error(codes.Canceled, "context canceled")
 	} else if errors.Is(err, context.DeadlineExceeded) {
@@ -103,7 +114,196 @@ func (e *EventsServiceServer) EnsureStream(ctx context.Context, req *eventsv1alp
 		return nil, err
 	}
 
-	return &eventsv1alpha1.EnsureStreamResponse{}, nil
+	return &eventsv1alpha1.EnsureStreamResponse{
+		Stream: toStreamInfo(stream),
+	}, nil
+}
+
+func (e *EventsServiceServer) GetStream(ctx context.Context, req *eventsv1alpha1.GetStreamRequest) (*eventsv1alpha1.GetStreamResponse, error) {
+	stream, err := e.events.GetStream(ctx, req.Name)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &eventsv1alpha1.GetStreamResponse{
+		Stream: toStreamInfo(stream),
+	}, nil
+}
+
+func (e *EventsServiceServer) ListStreams(ctx context.Context, req *eventsv1alpha1.ListStreamsRequest) (*eventsv1alpha1.ListStreamsResponse, error) {
+	config := &events.ListStreamsConfig{
+		Limit: defaultPageSize,
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	if req.PageToken != nil {
+		config.After = *req.PageToken
+	}
+
+	list, err := e.events.ListStreams(ctx, config)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &eventsv1alpha1.ListStreamsResponse{
+		Streams: make([]*eventsv1alpha1.StreamInfo, len(list.Streams)),
+	}
+	for i, stream := range list.Streams {
+		res.Streams[i] = toStreamInfo(stream)
+	}
+
+	if list.HasMore && len(list.Streams) > 0 {
+		// The name of the last stream is used to continue listing
+		nextPageToken := list.Streams[len(list.Streams)-1].Config.Name
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+func (e *EventsServiceServer) DeleteStream(ctx context.Context, req *eventsv1alpha1.DeleteStreamRequest) (*eventsv1alpha1.DeleteStreamResponse, error) {
+	err := e.events.DeleteStream(ctx, req.Name)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &eventsv1alpha1.DeleteStreamResponse{}, nil
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if errors.Is(err, events.ErrStreamNotFound) ||
+		errors.Is(err, events.ErrConsumerNotFound) ||
+		errors.Is(err, events.ErrDeadLetterNotFound) ||
+		errors.Is(err, events.ErrScheduledEventNotFound) ||
+		errors.Is(err, events.ErrEventNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	} else if events.IsValidationError(err) || errors.Is(err, events.ErrInvalidData) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if errors.Is(err, events.ErrUnboundSubject) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+func toStreamInfo(stream *events.Stream) *eventsv1alpha1.StreamInfo {
+	config := stream.Config
+
+	discardPolicy := eventsv1alpha1.EnsureStreamRequest_DISCARD_POLICY_OLD
+	switch config.DiscardPolicy {
+	case events.DiscardPolicyOld:
+		discardPolicy = eventsv1alpha1.EnsureStreamRequest_DISCARD_POLICY_OLD
+	case events.DiscardPolicyNew:
+		discardPolicy = eventsv1alpha1.EnsureStreamRequest_DISCARD_POLICY_NEW
+	}
+
+	retentionPolicy := &eventsv1alpha1.EnsureStreamRequest_RetentionPolicy{
+		DiscardPolicy:        &discardPolicy,
+		DiscardNewPerSubject: &config.DiscardNewPerSubject,
+	}
+
+	if config.MaxAge > 0 {
+		retentionPolicy.MaxAge = durationpb.New(config.MaxAge)
+	}
+
+	if config.MaxMsgs > 0 {
+		maxEvents := uint64(config.MaxMsgs)
+		retentionPolicy.MaxEvents = &maxEvents
+	}
+
+	if config.MaxMsgsPerSubject > 0 {
+		maxEventsPerSubject := uint64(config.MaxMsgsPerSubject)
+		retentionPolicy.MaxEventsPerSubject = &maxEventsPerSubject
+	}
+
+	if config.MaxBytes > 0 {
+		maxBytes := uint64(config.MaxBytes)
+		retentionPolicy.MaxBytes = &maxBytes
+	}
+
+	storageType := eventsv1alpha1.EnsureStreamRequest_STORAGE_TYPE_FILE
+	switch config.StorageType {
+	case events.StorageTypeFile:
+		storageType = eventsv1alpha1.EnsureStreamRequest_STORAGE_TYPE_FILE
+	case events.StorageTypeMemory:
+		storageType = eventsv1alpha1.EnsureStreamRequest_STORAGE_TYPE_MEMORY
+	}
+
+	storage := &eventsv1alpha1.EnsureStreamRequest_Storage{
+		Type: &storageType,
+	}
+
+	if config.Replicas != nil {
+		replicas := uint32(*config.Replicas)
+		storage.Replicas = &replicas
+	}
+
+	res := &eventsv1alpha1.StreamInfo{
+		Name:            config.Name,
+		Created:         timestamppb.New(stream.Created),
+		RetentionPolicy: retentionPolicy,
+		Storage:         storage,
+		State: &eventsv1alpha1.StreamInfo_State{
+			Events:    stream.State.Events,
+			Bytes:     stream.State.Bytes,
+			FirstId:   stream.State.FirstID,
+			LastId:    stream.State.LastID,
+			Consumers: uint32(stream.State.Consumers),
+		},
+	}
+
+	switch {
+	case config.Mirror != nil:
+		res.Source = &eventsv1alpha1.StreamInfo_Mirror{
+			Mirror: fromStreamSource(config.Mirror),
+		}
+	case len(config.Sources) > 0:
+		sources := make([]*eventsv1alpha1.EnsureStreamRequest_StreamSource, len(config.Sources))
+		for i, source := range config.Sources {
+			sources[i] = fromStreamSource(source)
+		}
+
+		res.Source = &eventsv1alpha1.StreamInfo_Aggregate{
+			Aggregate: &eventsv1alpha1.EnsureStreamRequest_StreamSources{
+				Sources: sources,
+			},
+		}
+	default:
+		res.Source = &eventsv1alpha1.StreamInfo_Subjects{
+			Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+				Subjects: config.Subjects,
+			},
+		}
+	}
+
+	if config.DeduplicationWindow != nil {
+		res.DeduplicationWindow = durationpb.New(*config.DeduplicationWindow)
+	}
+
+	if config.MaxEventSize != nil {
+		res.MaxEventSize = uint32(*config.MaxEventSize)
+	}
+
+	if !stream.State.FirstTime.IsZero() {
+		res.State.FirstTimestamp = timestamppb.New(stream.State.FirstTime)
+	}
+
+	if !stream.State.LastTime.IsZero() {
+		res.State.LastTimestamp = timestamppb.New(stream.State.LastTime)
+	}
+
+	return res
 }
 
 func toStreamSource(s *eventsv1alpha1.EnsureStreamRequest_StreamSource) *events.StreamSource {
@@ -140,3 +340,42 @@ func toStreamPointer(p *eventsv1alpha1.StreamPointer) *events.StreamPointer {
 		First: false,
 	}
 }
+
+func fromStreamSource(s *events.StreamSource) *eventsv1alpha1.EnsureStreamRequest_StreamSource {
+	return &eventsv1alpha1.EnsureStreamRequest_StreamSource{
+		Name:           s.Name,
+		From:           fromStreamPointer(s.From),
+		FilterSubjects: s.FilterSubjects,
+	}
+}
+
+func fromStreamPointer(p *events.StreamPointer) *eventsv1alpha1.StreamPointer {
+	switch {
+	case p == nil:
+		return nil
+	case p.ID != 0:
+		return &eventsv1alpha1.StreamPointer{
+			Pointer: &eventsv1alpha1.StreamPointer_Offset{
+				Offset: p.ID,
+			},
+		}
+	case !p.Time.IsZero():
+		return &eventsv1alpha1.StreamPointer{
+			Pointer: &eventsv1alpha1.StreamPointer_Time{
+				Time: timestamppb.New(p.Time),
+			},
+		}
+	case p.First:
+		return &eventsv1alpha1.StreamPointer{
+			Pointer: &eventsv1alpha1.StreamPointer_Start{
+				Start: true,
+			},
+		}
+	}
+
+	return &eventsv1alpha1.StreamPointer{
+		Pointer: &eventsv1alpha1.StreamPointer_End{
+			End: true,
+		},
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -210,4 +212,111 @@ var _ = Describe("Streams", func() {
 		})
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("ensuring a stream returns information about it", func(ctx context.Context) {
+		res, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "test",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"test"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Stream.Name).To(Equal("test"))
+		Expect(res.Stream.GetSubjects().Subjects).To(Equal([]string{"test"}))
+		Expect(res.Stream.State.Events).To(Equal(uint64(0)))
+	})
+
+	It("can get a stream", func(ctx context.Context) {
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "test",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"test"},
+				},
+			},
+			RetentionPolicy: &eventsv1alpha1.EnsureStreamRequest_RetentionPolicy{
+				MaxAge: durationpb.New(1 * time.Hour),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := service.GetStream(ctx, &eventsv1alpha1.GetStreamRequest{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Stream.Name).To(Equal("test"))
+		Expect(res.Stream.RetentionPolicy.MaxAge.AsDuration()).To(Equal(1 * time.Hour))
+		Expect(res.Stream.Storage.GetType()).To(Equal(eventsv1alpha1.EnsureStreamRequest_STORAGE_TYPE_FILE))
+		Expect(res.Stream.Storage.GetReplicas()).To(Equal(uint32(1)))
+	})
+
+	It("getting a missing stream returns not found", func(ctx context.Context) {
+		_, err := service.GetStream(ctx, &eventsv1alpha1.GetStreamRequest{
+			Name: "test",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("can list streams in pages", func(ctx context.Context) {
+		for _, name := range []string{"a", "b", "c"} {
+			_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+				Name: name,
+				Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+					Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+						Subjects: []string{name},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		pageSize := uint32(2)
+		page1, err := service.ListStreams(ctx, &eventsv1alpha1.ListStreamsRequest{
+			PageSize: &pageSize,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page1.Streams).To(HaveLen(2))
+		Expect(page1.NextPageToken).ToNot(BeNil())
+
+		page2, err := service.ListStreams(ctx, &eventsv1alpha1.ListStreamsRequest{
+			PageSize:  &pageSize,
+			PageToken: page1.NextPageToken,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page2.Streams).To(HaveLen(1))
+		Expect(page2.Streams[0].Name).To(Equal("c"))
+		Expect(page2.NextPageToken).To(BeNil())
+	})
+
+	It("listing streams with a too large page size fails", func(ctx context.Context) {
+		pageSize := uint32(10000)
+		_, err := service.ListStreams(ctx, &eventsv1alpha1.ListStreamsRequest{
+			PageSize: &pageSize,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("can delete a stream", func(ctx context.Context) {
+		_, err := service.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "test",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"test"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.DeleteStream(ctx, &eventsv1alpha1.DeleteStreamRequest{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.GetStream(ctx, &eventsv1alpha1.GetStreamRequest{
+			Name: "test",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
 })
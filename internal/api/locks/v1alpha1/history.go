@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	"github.com/levelfourab/windshift-server/internal/locks"
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/locks/v1alpha1"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *LocksServiceServer) Monitor(req *locksv1alpha1.MonitorRequest, server locksv1alpha1.LocksService_MonitorServer) error {
+	ctx := server.Context()
+
+	monitor, err := s.locks.Monitor(ctx, req.Name)
+	if err != nil {
+		return toStatusError(err)
+	}
+	defer monitor.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.globalStop:
+			return nil
+		case event := <-monitor.Events():
+			response := &locksv1alpha1.MonitorResponse{
+				Id:        event.ID,
+				Timestamp: timestamppb.New(event.Timestamp),
+			}
+
+			switch event.Type {
+			case locks.EventAcquired:
+				response.Event = &locksv1alpha1.MonitorResponse_Acquired{
+					Acquired: toLockAcquiredEvent(event),
+				}
+			case locks.EventExtended:
+				response.Event = &locksv1alpha1.MonitorResponse_Extended{
+					Extended: toLockExtendedEvent(event),
+				}
+			case locks.EventReleased:
+				response.Event = &locksv1alpha1.MonitorResponse_Released{
+					Released: toLockReleasedEvent(event),
+				}
+			case locks.EventExpired:
+				response.Event = &locksv1alpha1.MonitorResponse_Expired{
+					Expired: toLockExpiredEvent(event),
+				}
+			}
+
+			err = server.Send(response)
+			if err != nil {
+				return errors.Wrap(err, "could not send lock event")
+			}
+		}
+	}
+}
+
+func (s *LocksServiceServer) History(req *locksv1alpha1.HistoryRequest, server locksv1alpha1.LocksService_HistoryServer) error {
+	ctx := server.Context()
+
+	config := &locks.HistoryConfig{
+		Names: req.Name,
+	}
+
+	if req.Limit != nil {
+		config.Limit = uint(*req.Limit)
+	}
+
+	if req.After != nil {
+		config.After = req.After.AsTime()
+	}
+
+	if req.AfterId != nil {
+		config.AfterID = *req.AfterId
+	}
+
+	events, err := s.locks.History(ctx, config)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	for _, event := range events {
+		response := &locksv1alpha1.HistoryResponse{
+			Id:        event.ID,
+			Timestamp: timestamppb.New(event.Timestamp),
+		}
+
+		switch event.Type {
+		case locks.EventAcquired:
+			response.Event = &locksv1alpha1.HistoryResponse_Acquired{
+				Acquired: toLockAcquiredEvent(event),
+			}
+		case locks.EventExtended:
+			response.Event = &locksv1alpha1.HistoryResponse_Extended{
+				Extended: toLockExtendedEvent(event),
+			}
+		case locks.EventReleased:
+			response.Event = &locksv1alpha1.HistoryResponse_Released{
+				Released: toLockReleasedEvent(event),
+			}
+		case locks.EventExpired:
+			response.Event = &locksv1alpha1.HistoryResponse_Expired{
+				Expired: toLockExpiredEvent(event),
+			}
+		}
+
+		err = server.Send(response)
+		if err != nil {
+			return errors.Wrap(err, "could not send lock event")
+		}
+	}
+
+	return nil
+}
+
+func toLockAcquiredEvent(event *locks.Event) *locksv1alpha1.LockAcquiredEvent {
+	return &locksv1alpha1.LockAcquiredEvent{
+		Name:    event.Name,
+		Owner:   event.Owner,
+		Expires: timestamppb.New(event.Expires),
+	}
+}
+
+func toLockExtendedEvent(event *locks.Event) *locksv1alpha1.LockExtendedEvent {
+	return &locksv1alpha1.LockExtendedEvent{
+		Name:    event.Name,
+		Owner:   event.Owner,
+		Expires: timestamppb.New(event.Expires),
+	}
+}
+
+func toLockReleasedEvent(event *locks.Event) *locksv1alpha1.LockReleasedEvent {
+	return &locksv1alpha1.LockReleasedEvent{
+		Name:  event.Name,
+		Owner: event.Owner,
+	}
+}
+
+func toLockExpiredEvent(event *locks.Event) *locksv1alpha1.LockExpiredEvent {
+	return &locksv1alpha1.LockExpiredEvent{
+		Name:  event.Name,
+		Owner: event.Owner,
+	}
+}
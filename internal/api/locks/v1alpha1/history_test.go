@@ -0,0 +1,79 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"io"
+	"time"
+
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/locks/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("History", func() {
+	var service locksv1alpha1.LocksServiceClient
+
+	BeforeEach(func() {
+		service = GetClient()
+	})
+
+	It("returns acquired and released events", func(ctx context.Context) {
+		stream, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		owner := "worker-1"
+		err = stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Acquire_{
+				Acquire: &locksv1alpha1.LockRequest_Acquire{
+					Name:    "test",
+					Expires: timestamppb.New(time.Now().Add(time.Minute)),
+					Owner:   &owner,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetAcquired()).ToNot(BeNil())
+
+		err = stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Release_{
+				Release: &locksv1alpha1.LockRequest_Release{
+					Name:  "test",
+					Token: response.GetAcquired().Token,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err = stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetReleased()).ToNot(BeNil())
+
+		err = stream.CloseSend()
+		Expect(err).ToNot(HaveOccurred())
+
+		history, err := service.History(ctx, &locksv1alpha1.HistoryRequest{
+			Name: []string{"test"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		event, err := history.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Timestamp).ToNot(BeNil())
+		Expect(event.GetAcquired()).ToNot(BeNil())
+		Expect(event.GetAcquired().Name).To(Equal("test"))
+		Expect(event.GetAcquired().Owner).To(Equal("worker-1"))
+
+		event, err = history.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.GetReleased()).ToNot(BeNil())
+		Expect(event.GetReleased().Owner).To(Equal("worker-1"))
+
+		_, err = history.Recv()
+		Expect(err).To(Equal(io.EOF))
+	})
+})
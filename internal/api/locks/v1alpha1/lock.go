@@ -0,0 +1,288 @@
+package v1alpha1
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/locks"
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/locks/v1alpha1"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// releaseTimeout is the maximum time spent releasing locks still held when a
+// stream is closed.
+const releaseTimeout = 5 * time.Second
+
+func (s *LocksServiceServer) Lock(server locksv1alpha1.LocksService_LockServer) error {
+	ctx, cancel := context.WithCancel(server.Context())
+
+	held := newHeldLocks()
+	var wg sync.WaitGroup
+	defer func() {
+		// Stop requests that are still being processed and release all the
+		// locks held by this stream
+		cancel()
+		wg.Wait()
+		s.releaseAll(server.Context(), held)
+	}()
+
+	// Start a goroutine to read incoming messages and send them to a channel
+	messages := make(chan *locksv1alpha1.LockRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			request, err := server.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case messages <- request:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var sendMu sync.Mutex
+	handleErr := make(chan error, 1)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.globalStop:
+			return nil
+		case err := <-handleErr:
+			return err
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Canceled {
+				// The client is done sending requests, wait for the ones in
+				// progress to finish before closing the stream
+				wg.Wait()
+				return nil
+			}
+
+			return errors.Wrap(err, "could not receive request")
+		case request := <-messages:
+			// Requests are handled concurrently as acquiring a lock may wait
+			// for the lock to become available
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				response, err := s.handleLockRequest(ctx, held, request)
+				if err == nil && response != nil {
+					sendMu.Lock()
+					err = server.Send(response)
+					sendMu.Unlock()
+				}
+
+				if err != nil {
+					select {
+					case handleErr <- err:
+					default:
+					}
+				}
+			}()
+		}
+	}
+}
+
+func (s *LocksServiceServer) handleLockRequest(
+	ctx context.Context,
+	held *heldLocks,
+	request *locksv1alpha1.LockRequest,
+) (*locksv1alpha1.LockResponse, error) {
+	switch r := request.Lock.(type) {
+	case *locksv1alpha1.LockRequest_Acquire_:
+		return s.handleAcquire(ctx, held, r.Acquire)
+	case *locksv1alpha1.LockRequest_Extend_:
+		return s.handleExtend(ctx, r.Extend)
+	case *locksv1alpha1.LockRequest_Release_:
+		return s.handleRelease(ctx, held, r.Release)
+	}
+
+	return nil, status.Error(codes.InvalidArgument, "unknown lock request")
+}
+
+func (s *LocksServiceServer) handleAcquire(
+	ctx context.Context,
+	held *heldLocks,
+	r *locksv1alpha1.LockRequest_Acquire,
+) (*locksv1alpha1.LockResponse, error) {
+	config := &locks.AcquireConfig{
+		Name:    r.Name,
+		Owner:   ownerFromContext(ctx),
+		Expires: r.Expires.AsTime(),
+	}
+
+	if r.Owner != nil {
+		config.Owner = *r.Owner
+	}
+
+	if r.Timeout != nil {
+		config.Timeout = r.Timeout.AsDuration()
+	}
+
+	lock, err := s.locks.Acquire(ctx, config)
+	var heldErr *locks.HeldError
+	if errors.As(err, &heldErr) {
+		return &locksv1alpha1.LockResponse{
+			Lock: &locksv1alpha1.LockResponse_Rejected_{
+				Rejected: &locksv1alpha1.LockResponse_Rejected{
+					Name:    r.Name,
+					Expires: timestamppb.New(heldErr.Expires),
+				},
+			},
+		}, nil
+	} else if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	held.Add(lock.Name, lock.Token)
+	return &locksv1alpha1.LockResponse{
+		Lock: &locksv1alpha1.LockResponse_Acquired_{
+			Acquired: &locksv1alpha1.LockResponse_Acquired{
+				Name:  lock.Name,
+				Token: lock.Token,
+			},
+		},
+	}, nil
+}
+
+func (s *LocksServiceServer) handleExtend(
+	ctx context.Context,
+	r *locksv1alpha1.LockRequest_Extend,
+) (*locksv1alpha1.LockResponse, error) {
+	_, err := s.locks.Extend(ctx, r.Name, r.Token, r.Expires.AsTime())
+	if errors.Is(err, locks.ErrLockNotHeld) {
+		return rejected(r.Name), nil
+	} else if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &locksv1alpha1.LockResponse{
+		Lock: &locksv1alpha1.LockResponse_Extended_{
+			Extended: &locksv1alpha1.LockResponse_Extended{
+				Name: r.Name,
+			},
+		},
+	}, nil
+}
+
+func (s *LocksServiceServer) handleRelease(
+	ctx context.Context,
+	held *heldLocks,
+	r *locksv1alpha1.LockRequest_Release,
+) (*locksv1alpha1.LockResponse, error) {
+	_, err := s.locks.Release(ctx, r.Name, r.Token)
+	if errors.Is(err, locks.ErrLockNotHeld) {
+		held.Remove(r.Name, r.Token)
+		return rejected(r.Name), nil
+	} else if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	held.Remove(r.Name, r.Token)
+	return &locksv1alpha1.LockResponse{
+		Lock: &locksv1alpha1.LockResponse_Released_{
+			Released: &locksv1alpha1.LockResponse_Released{
+				Name: r.Name,
+			},
+		},
+	}, nil
+}
+
+// releaseAll releases all locks that are still held by a stream.
+func (s *LocksServiceServer) releaseAll(ctx context.Context, held *heldLocks) {
+	// The stream context is likely canceled, so release without it
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+
+	for name, token := range held.Drain() {
+		_, err := s.locks.Release(ctx, name, token)
+		if err != nil && !errors.Is(err, locks.ErrLockNotHeld) {
+			s.logger.Warn("Could not release lock", zap.String("name", name), zap.Error(err))
+		}
+	}
+}
+
+func rejected(name string) *locksv1alpha1.LockResponse {
+	return &locksv1alpha1.LockResponse{
+		Lock: &locksv1alpha1.LockResponse_Rejected_{
+			Rejected: &locksv1alpha1.LockResponse_Rejected{
+				Name: name,
+			},
+		},
+	}
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if locks.IsValidationError(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// ownerFromContext returns the address of the client as the default owner
+// of locks it acquires.
+func ownerFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return p.Addr.String()
+}
+
+// heldLocks keeps track of the locks held by a single stream so that they can
+// be released when the stream is closed.
+type heldLocks struct {
+	mu    sync.Mutex
+	locks map[string]string
+}
+
+func newHeldLocks() *heldLocks {
+	return &heldLocks{
+		locks: make(map[string]string),
+	}
+}
+
+func (h *heldLocks) Add(name string, token string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.locks[name] = token
+}
+
+func (h *heldLocks) Remove(name string, token string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.locks[name] == token {
+		delete(h.locks, name)
+	}
+}
+
+func (h *heldLocks) Drain() map[string]string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	locks := h.locks
+	h.locks = make(map[string]string)
+	return locks
+}
@@ -0,0 +1,146 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/locks/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Lock", func() {
+	var service locksv1alpha1.LocksServiceClient
+
+	BeforeEach(func() {
+		service = GetClient()
+	})
+
+	acquire := func(stream locksv1alpha1.LocksService_LockClient, name string) *locksv1alpha1.LockResponse {
+		err := stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Acquire_{
+				Acquire: &locksv1alpha1.LockRequest_Acquire{
+					Name:    name,
+					Expires: timestamppb.New(time.Now().Add(time.Minute)),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		return response
+	}
+
+	It("can acquire, extend and release a lock", func(ctx context.Context) {
+		stream, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.CloseSend() //nolint:errcheck
+
+		response := acquire(stream, "test")
+		Expect(response.GetAcquired()).ToNot(BeNil())
+		Expect(response.GetAcquired().Name).To(Equal("test"))
+		token := response.GetAcquired().Token
+		Expect(token).ToNot(BeEmpty())
+
+		err = stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Extend_{
+				Extend: &locksv1alpha1.LockRequest_Extend{
+					Name:    "test",
+					Token:   token,
+					Expires: timestamppb.New(time.Now().Add(2 * time.Minute)),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err = stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetExtended()).ToNot(BeNil())
+
+		err = stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Release_{
+				Release: &locksv1alpha1.LockRequest_Release{
+					Name:  "test",
+					Token: token,
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err = stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetReleased()).ToNot(BeNil())
+	})
+
+	It("rejects acquiring a held lock", func(ctx context.Context) {
+		stream1, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream1.CloseSend() //nolint:errcheck
+
+		response := acquire(stream1, "test")
+		Expect(response.GetAcquired()).ToNot(BeNil())
+
+		stream2, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream2.CloseSend() //nolint:errcheck
+
+		response = acquire(stream2, "test")
+		Expect(response.GetRejected()).ToNot(BeNil())
+		Expect(response.GetRejected().Expires).ToNot(BeNil())
+	})
+
+	It("rejects extending with an invalid token", func(ctx context.Context) {
+		stream, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream.CloseSend() //nolint:errcheck
+
+		err = stream.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Extend_{
+				Extend: &locksv1alpha1.LockRequest_Extend{
+					Name:    "test",
+					Token:   "invalid",
+					Expires: timestamppb.New(time.Now().Add(time.Minute)),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetRejected()).ToNot(BeNil())
+	})
+
+	It("releases locks when the stream is closed", func(ctx context.Context) {
+		stream1, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		response := acquire(stream1, "test")
+		Expect(response.GetAcquired()).ToNot(BeNil())
+
+		err = stream1.CloseSend()
+		Expect(err).ToNot(HaveOccurred())
+
+		stream2, err := service.Lock(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		defer stream2.CloseSend() //nolint:errcheck
+
+		err = stream2.Send(&locksv1alpha1.LockRequest{
+			Lock: &locksv1alpha1.LockRequest_Acquire_{
+				Acquire: &locksv1alpha1.LockRequest_Acquire{
+					Name:    "test",
+					Expires: timestamppb.New(time.Now().Add(time.Minute)),
+					Timeout: durationpb.New(5 * time.Second),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		response, err = stream2.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetAcquired()).ToNot(BeNil())
+	})
+})
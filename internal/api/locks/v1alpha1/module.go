@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/locks"
+	locksv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/locks/v1alpha1"
+
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var Module = fx.Module(
+	"grpc.v1alpha1",
+	fx.Provide(sprout.Logger("grpc.locks.v1alpha1"), fx.Private),
+	fx.Provide(newLocksServiceServer),
+	fx.Invoke(register),
+)
+
+type LocksServiceServer struct {
+	locksv1alpha1.UnimplementedLocksServiceServer
+
+	logger *zap.Logger
+
+	locks      *locks.Manager
+	globalStop chan struct{}
+}
+
+func newLocksServiceServer(
+	lifecycle fx.Lifecycle,
+	logger *zap.Logger,
+	locks *locks.Manager,
+) *LocksServiceServer {
+	server := &LocksServiceServer{
+		logger: logger,
+
+		locks:      locks,
+		globalStop: make(chan struct{}),
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			close(server.globalStop)
+			return nil
+		},
+	})
+	return server
+}
+
+func register(server *grpc.Server, locks *LocksServiceServer) {
+	locksv1alpha1.RegisterLocksServiceServer(server, locks)
+}
@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	schedulesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schedules/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schedules"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var Module = fx.Module(
+	"grpc.v1alpha1",
+	fx.Provide(sprout.Logger("grpc.schedules.v1alpha1"), fx.Private),
+	fx.Provide(newScheduleServiceServer),
+	fx.Invoke(register),
+)
+
+type ScheduleServiceServer struct {
+	schedulesv1alpha1.UnimplementedScheduleServiceServer
+
+	logger *zap.Logger
+
+	schedules *schedules.Manager
+	schemas   *schemas.Manager
+}
+
+func newScheduleServiceServer(
+	logger *zap.Logger,
+	schedules *schedules.Manager,
+	schemas *schemas.Manager,
+) *ScheduleServiceServer {
+	return &ScheduleServiceServer{
+		logger: logger,
+
+		schedules: schedules,
+		schemas:   schemas,
+	}
+}
+
+func register(server *grpc.Server, schedules *ScheduleServiceServer) {
+	schedulesv1alpha1.RegisterScheduleServiceServer(server, schedules)
+}
@@ -0,0 +1,152 @@
+package v1alpha1
+
+import (
+	"context"
+
+	schedulesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schedules/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schedules"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *ScheduleServiceServer) EnsureSchedule(ctx context.Context, req *schedulesv1alpha1.EnsureScheduleRequest) (*schedulesv1alpha1.EnsureScheduleResponse, error) {
+	data, err := s.decodeData(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	schedule, err := s.schedules.EnsureSchedule(ctx, &schedules.ScheduleConfig{
+		Name:     req.Name,
+		Cron:     req.Cron,
+		TimeZone: req.GetTimeZone(),
+		Subject:  req.Subject,
+		Data:     data,
+		Headers:  req.Headers,
+		CatchUp:  toCatchUpPolicy(req.CatchUp),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schedulesv1alpha1.EnsureScheduleResponse{
+		Schedule: toScheduleInfo(schedule),
+	}, nil
+}
+
+func (s *ScheduleServiceServer) GetSchedule(ctx context.Context, req *schedulesv1alpha1.GetScheduleRequest) (*schedulesv1alpha1.GetScheduleResponse, error) {
+	schedule, err := s.schedules.GetSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schedulesv1alpha1.GetScheduleResponse{
+		Schedule: toScheduleInfo(schedule),
+	}, nil
+}
+
+func (s *ScheduleServiceServer) ListSchedules(ctx context.Context, req *schedulesv1alpha1.ListSchedulesRequest) (*schedulesv1alpha1.ListSchedulesResponse, error) {
+	list, err := s.schedules.ListSchedules(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &schedulesv1alpha1.ListSchedulesResponse{
+		Schedules: make([]*schedulesv1alpha1.ScheduleInfo, len(list)),
+	}
+	for i, schedule := range list {
+		res.Schedules[i] = toScheduleInfo(schedule)
+	}
+
+	return res, nil
+}
+
+func (s *ScheduleServiceServer) DeleteSchedule(ctx context.Context, req *schedulesv1alpha1.DeleteScheduleRequest) (*schedulesv1alpha1.DeleteScheduleResponse, error) {
+	err := s.schedules.DeleteSchedule(ctx, req.Name)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schedulesv1alpha1.DeleteScheduleResponse{}, nil
+}
+
+// decodeData returns the data of the events a schedule publishes, decoding
+// it from JSON if needed.
+func (s *ScheduleServiceServer) decodeData(ctx context.Context, req *schedulesv1alpha1.EnsureScheduleRequest) (*anypb.Any, error) {
+	if req.JsonData == nil {
+		return req.Data, nil
+	}
+
+	if req.Data != nil {
+		return nil, status.Error(codes.InvalidArgument, "data and json_data can not both be set")
+	}
+
+	return s.schemas.DecodeJSON(ctx, *req.JsonData)
+}
+
+func toScheduleInfo(schedule *schedules.Schedule) *schedulesv1alpha1.ScheduleInfo {
+	info := &schedulesv1alpha1.ScheduleInfo{
+		Name:     schedule.Name,
+		Cron:     schedule.Cron,
+		TimeZone: schedule.TimeZone,
+		Subject:  schedule.Subject,
+		Data:     schedule.Data,
+		Headers:  schedule.Headers,
+		CatchUp:  fromCatchUpPolicy(schedule.CatchUp),
+	}
+
+	if !schedule.NextTick.IsZero() {
+		info.NextTick = timestamppb.New(schedule.NextTick)
+	}
+
+	return info
+}
+
+func toCatchUpPolicy(policy schedulesv1alpha1.CatchUpPolicy) schedules.CatchUpPolicy {
+	switch policy {
+	case schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED:
+		return ""
+	case schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_SKIP:
+		return schedules.CatchUpSkip
+	case schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_LATEST:
+		return schedules.CatchUpLatest
+	case schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_ALL:
+		return schedules.CatchUpAll
+	}
+
+	// Unknown values are passed on so they fail validation
+	return schedules.CatchUpPolicy(policy.String())
+}
+
+func fromCatchUpPolicy(policy schedules.CatchUpPolicy) schedulesv1alpha1.CatchUpPolicy {
+	switch policy {
+	case schedules.CatchUpSkip:
+		return schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_SKIP
+	case schedules.CatchUpLatest:
+		return schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_LATEST
+	case schedules.CatchUpAll:
+		return schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_ALL
+	}
+
+	return schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	} else if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if errors.Is(err, schedules.ErrScheduleNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	} else if schedules.IsValidationError(err) || schemas.IsValidationError(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,152 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	schedulesv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schedules/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Schedules", func() {
+	var service schedulesv1alpha1.ScheduleServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		var events eventsv1alpha1.EventsServiceClient
+		service, events = GetClient()
+
+		_, err := events.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+			Name: "events",
+			Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+				Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+					Subjects: []string{"events.>"},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("can create and get a schedule", func(ctx context.Context) {
+		res, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+			Name:     "nightly",
+			Cron:     "0 2 * * *",
+			TimeZone: proto.String("Europe/Stockholm"),
+			Subject:  "events.nightly",
+			Data:     Data(&testv1.StringValue{Value: "test"}),
+			Headers:  map[string]string{"team": "billing"},
+			CatchUp:  schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_LATEST,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Schedule.Name).To(Equal("nightly"))
+		Expect(res.Schedule.CatchUp).To(Equal(schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_LATEST))
+		Expect(res.Schedule.NextTick.AsTime()).To(BeTemporally(">", time.Now()))
+
+		get, err := service.GetSchedule(ctx, &schedulesv1alpha1.GetScheduleRequest{
+			Name: "nightly",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(get.Schedule.Cron).To(Equal("0 2 * * *"))
+		Expect(get.Schedule.TimeZone).To(Equal("Europe/Stockholm"))
+		Expect(get.Schedule.Subject).To(Equal("events.nightly"))
+		Expect(get.Schedule.Headers).To(HaveKeyWithValue("team", "billing"))
+
+		var data testv1.StringValue
+		Expect(get.Schedule.Data.UnmarshalTo(&data)).To(Succeed())
+		Expect(data.Value).To(Equal("test"))
+	})
+
+	It("catch-up policy defaults to skip", func(ctx context.Context) {
+		res, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+			Name:    "hourly",
+			Cron:    "@hourly",
+			Subject: "events.hourly",
+			Data:    Data(&testv1.StringValue{Value: "test"}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Schedule.CatchUp).To(Equal(schedulesv1alpha1.CatchUpPolicy_CATCH_UP_POLICY_SKIP))
+	})
+
+	It("can create a schedule with JSON data", func(ctx context.Context) {
+		res, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+			Name:     "hourly",
+			Cron:     "@hourly",
+			Subject:  "events.hourly",
+			JsonData: proto.String(`{"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "test"}`),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var data wrapperspb.StringValue
+		Expect(res.Schedule.Data.UnmarshalTo(&data)).To(Succeed())
+		Expect(data.Value).To(Equal("test"))
+	})
+
+	It("can list and delete schedules", func(ctx context.Context) {
+		for _, name := range []string{"b", "a"} {
+			_, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+				Name:    name,
+				Cron:    "@daily",
+				Subject: "events.daily",
+				Data:    Data(&testv1.StringValue{Value: "test"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		list, err := service.ListSchedules(ctx, &schedulesv1alpha1.ListSchedulesRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Schedules).To(HaveLen(2))
+		Expect(list.Schedules[0].Name).To(Equal("a"))
+
+		_, err = service.DeleteSchedule(ctx, &schedulesv1alpha1.DeleteScheduleRequest{
+			Name: "a",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err = service.ListSchedules(ctx, &schedulesv1alpha1.ListSchedulesRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Schedules).To(HaveLen(1))
+		Expect(list.Schedules[0].Name).To(Equal("b"))
+	})
+
+	It("getting a missing schedule returns not found", func(ctx context.Context) {
+		_, err := service.GetSchedule(ctx, &schedulesv1alpha1.GetScheduleRequest{
+			Name: "missing",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("deleting a missing schedule returns not found", func(ctx context.Context) {
+		_, err := service.DeleteSchedule(ctx, &schedulesv1alpha1.DeleteScheduleRequest{
+			Name: "missing",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("invalid cron expression returns invalid argument", func(ctx context.Context) {
+		_, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+			Name:    "invalid",
+			Cron:    "every day",
+			Subject: "events.test",
+			Data:    Data(&testv1.StringValue{Value: "test"}),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("setting both data and JSON data returns invalid argument", func(ctx context.Context) {
+		_, err := service.EnsureSchedule(ctx, &schedulesv1alpha1.EnsureScheduleRequest{
+			Name:     "invalid",
+			Cron:     "@hourly",
+			Subject:  "events.test",
+			Data:     Data(&testv1.StringValue{Value: "test"}),
+			JsonData: proto.String(`{"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "test"}`),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,76 @@
+package v1alpha1
+
+import (
+	"context"
+
+	schemasv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schemas/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+)
+
+func (s *SchemaServiceServer) EnsureSubjectBinding(ctx context.Context, req *schemasv1alpha1.EnsureSubjectBindingRequest) (*schemasv1alpha1.EnsureSubjectBindingResponse, error) {
+	err := s.schemas.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+		Pattern: req.Pattern,
+		Types:   req.Types,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schemasv1alpha1.EnsureSubjectBindingResponse{}, nil
+}
+
+func (s *SchemaServiceServer) DeleteSubjectBinding(ctx context.Context, req *schemasv1alpha1.DeleteSubjectBindingRequest) (*schemasv1alpha1.DeleteSubjectBindingResponse, error) {
+	err := s.schemas.DeleteSubjectBinding(ctx, req.Pattern)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schemasv1alpha1.DeleteSubjectBindingResponse{}, nil
+}
+
+func (s *SchemaServiceServer) EnsureStoreBinding(ctx context.Context, req *schemasv1alpha1.EnsureStoreBindingRequest) (*schemasv1alpha1.EnsureStoreBindingResponse, error) {
+	err := s.schemas.EnsureStoreBinding(ctx, &schemas.StoreBinding{
+		Store: req.Store,
+		Types: req.Types,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schemasv1alpha1.EnsureStoreBindingResponse{}, nil
+}
+
+func (s *SchemaServiceServer) DeleteStoreBinding(ctx context.Context, req *schemasv1alpha1.DeleteStoreBindingRequest) (*schemasv1alpha1.DeleteStoreBindingResponse, error) {
+	err := s.schemas.DeleteStoreBinding(ctx, req.Store)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schemasv1alpha1.DeleteStoreBindingResponse{}, nil
+}
+
+func (s *SchemaServiceServer) ListBindings(ctx context.Context, req *schemasv1alpha1.ListBindingsRequest) (*schemasv1alpha1.ListBindingsResponse, error) {
+	bindings, err := s.schemas.ListBindings(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &schemasv1alpha1.ListBindingsResponse{
+		Subjects: make([]*schemasv1alpha1.SubjectBinding, len(bindings.Subjects)),
+		Stores:   make([]*schemasv1alpha1.StoreBinding, len(bindings.Stores)),
+	}
+	for i, binding := range bindings.Subjects {
+		res.Subjects[i] = &schemasv1alpha1.SubjectBinding{
+			Pattern: binding.Pattern,
+			Types:   binding.Types,
+		}
+	}
+	for i, binding := range bindings.Stores {
+		res.Stores[i] = &schemasv1alpha1.StoreBinding{
+			Store: binding.Store,
+			Types: binding.Types,
+		}
+	}
+
+	return res, nil
+}
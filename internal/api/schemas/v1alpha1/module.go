@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	schemasv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schemas/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var Module = fx.Module(
+	"grpc.v1alpha1",
+	fx.Provide(sprout.Logger("grpc.schemas.v1alpha1"), fx.Private),
+	fx.Provide(newSchemaServiceServer),
+	fx.Invoke(register),
+)
+
+type SchemaServiceServer struct {
+	schemasv1alpha1.UnimplementedSchemaServiceServer
+
+	logger *zap.Logger
+
+	schemas *schemas.Manager
+}
+
+func newSchemaServiceServer(
+	logger *zap.Logger,
+	schemas *schemas.Manager,
+) *SchemaServiceServer {
+	return &SchemaServiceServer{
+		logger: logger,
+
+		schemas: schemas,
+	}
+}
+
+func register(server *grpc.Server, schemas *SchemaServiceServer) {
+	schemasv1alpha1.RegisterSchemaServiceServer(server, schemas)
+}
@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	"context"
+
+	schemasv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schemas/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SchemaServiceServer) RegisterSchemas(ctx context.Context, req *schemasv1alpha1.RegisterSchemasRequest) (*schemasv1alpha1.RegisterSchemasResponse, error) {
+	registered, err := s.schemas.RegisterSchemas(ctx, req.Files, toCompatibility(req.Compatibility))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &schemasv1alpha1.RegisterSchemasResponse{
+		Schemas: make([]*schemasv1alpha1.SchemaInfo, len(registered)),
+	}
+	for i, schema := range registered {
+		res.Schemas[i] = toSchemaInfo(schema)
+	}
+
+	return res, nil
+}
+
+func (s *SchemaServiceServer) GetSchema(ctx context.Context, req *schemasv1alpha1.GetSchemaRequest) (*schemasv1alpha1.GetSchemaResponse, error) {
+	schema, err := s.schemas.GetSchema(ctx, req.Type)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &schemasv1alpha1.GetSchemaResponse{
+		Schema: toSchemaInfo(schema),
+		Files:  schema.Files,
+	}, nil
+}
+
+func (s *SchemaServiceServer) ListSchemas(ctx context.Context, req *schemasv1alpha1.ListSchemasRequest) (*schemasv1alpha1.ListSchemasResponse, error) {
+	list, err := s.schemas.ListSchemas(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &schemasv1alpha1.ListSchemasResponse{
+		Schemas: make([]*schemasv1alpha1.SchemaInfo, len(list)),
+	}
+	for i, schema := range list {
+		res.Schemas[i] = toSchemaInfo(schema)
+	}
+
+	return res, nil
+}
+
+func toSchemaInfo(schema *schemas.Schema) *schemasv1alpha1.SchemaInfo {
+	return &schemasv1alpha1.SchemaInfo{
+		Type:      schema.Name,
+		Revision:  schema.Revision,
+		Timestamp: timestamppb.New(schema.Timestamp),
+	}
+}
+
+func toCompatibility(compatibility schemasv1alpha1.Compatibility) schemas.Compatibility {
+	switch compatibility {
+	case schemasv1alpha1.Compatibility_COMPATIBILITY_BACKWARD:
+		return schemas.CompatibilityBackward
+	case schemasv1alpha1.Compatibility_COMPATIBILITY_FORWARD:
+		return schemas.CompatibilityForward
+	case schemasv1alpha1.Compatibility_COMPATIBILITY_FULL:
+		return schemas.CompatibilityFull
+	case schemasv1alpha1.Compatibility_COMPATIBILITY_NONE:
+		return schemas.CompatibilityNone
+	}
+
+	return schemas.CompatibilityDefault
+}
+
+func toStatusError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if errors.Is(err, schemas.ErrSchemaNotFound) ||
+		errors.Is(err, schemas.ErrBindingNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	} else if errors.Is(err, schemas.ErrIncompatibleSchema) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if schemas.IsValidationError(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
@@ -0,0 +1,185 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	schemasv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/schemas/v1alpha1"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Schemas", func() {
+	var service schemasv1alpha1.SchemaServiceClient
+	var eventsService eventsv1alpha1.EventsServiceClient
+
+	files := func() *descriptorpb.FileDescriptorSet {
+		return &descriptorpb.FileDescriptorSet{
+			File: []*descriptorpb.FileDescriptorProto{
+				protodesc.ToFileDescriptorProto(testv1.File_windshift_test_v1_events_proto),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		service, eventsService = GetClient()
+	})
+
+	It("can register and get a schema", func(ctx context.Context) {
+		res, err := service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+			Files: files(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Schemas).To(HaveLen(1))
+		Expect(res.Schemas[0].Type).To(Equal("windshift.test.v1.StringValue"))
+
+		schema, err := service.GetSchema(ctx, &schemasv1alpha1.GetSchemaRequest{
+			Type: "windshift.test.v1.StringValue",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schema.Schema.Revision).To(Equal(res.Schemas[0].Revision))
+		Expect(schema.Files.File).To(HaveLen(1))
+
+		list, err := service.ListSchemas(ctx, &schemasv1alpha1.ListSchemasRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Schemas).To(HaveLen(1))
+	})
+
+	It("getting an unknown schema returns not found", func(ctx context.Context) {
+		_, err := service.GetSchema(ctx, &schemasv1alpha1.GetSchemaRequest{
+			Type: "windshift.test.v1.Unknown",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("registering invalid files fails", func(ctx context.Context) {
+		set := files()
+		set.File[0].Dependency = []string{"missing.proto"}
+
+		_, err := service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+			Files: set,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("registering an incompatible version fails", func(ctx context.Context) {
+		_, err := service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+			Files: files(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		set := files()
+		set.File[0].MessageType[0].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+
+		_, err = service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+			Files: set,
+		})
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		Expect(status.Convert(err).Message()).To(ContainSubstring(`changed type from string to int64`))
+
+		_, err = service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+			Files:         set,
+			Compatibility: schemasv1alpha1.Compatibility_COMPATIBILITY_NONE,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Bindings", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := service.RegisterSchemas(ctx, &schemasv1alpha1.RegisterSchemasRequest{
+				Files: files(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = eventsService.EnsureStream(ctx, &eventsv1alpha1.EnsureStreamRequest{
+				Name: "orders",
+				Source: &eventsv1alpha1.EnsureStreamRequest_Subjects_{
+					Subjects: &eventsv1alpha1.EnsureStreamRequest_Subjects{
+						Subjects: []string{"orders.>"},
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.EnsureSubjectBinding(ctx, &schemasv1alpha1.EnsureSubjectBindingRequest{
+				Pattern: "orders.>",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("publishing bound type works", func(ctx context.Context) {
+			_, err := eventsService.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "orders.created",
+				Data:    Data(&testv1.StringValue{Value: "abc"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("publishing other type fails", func(ctx context.Context) {
+			_, err := eventsService.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "orders.created",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("publishing other type in a batch fails", func(ctx context.Context) {
+			res, err := eventsService.PublishEvents(ctx, &eventsv1alpha1.PublishEventsRequest{
+				Events: []*eventsv1alpha1.PublishEventRequest{
+					{
+						Subject: "orders.created",
+						Data:    Data(&testv1.StringValue{Value: "abc"}),
+					},
+					{
+						Subject: "orders.created",
+						Data:    Data(&emptypb.Empty{}),
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Results[0].GetId()).ToNot(BeZero())
+			Expect(res.Results[1].GetError().GetCode()).To(Equal(eventsv1alpha1.PublishError_CODE_INVALID_ARGUMENT))
+		})
+
+		It("can list and delete bindings", func(ctx context.Context) {
+			list, err := service.ListBindings(ctx, &schemasv1alpha1.ListBindingsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Subjects).To(HaveLen(1))
+			Expect(list.Subjects[0].Pattern).To(Equal("orders.>"))
+
+			_, err = service.DeleteSubjectBinding(ctx, &schemasv1alpha1.DeleteSubjectBindingRequest{
+				Pattern: "orders.>",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = eventsService.PublishEvent(ctx, &eventsv1alpha1.PublishEventRequest{
+				Subject: "orders.created",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deleting a missing binding returns not found", func(ctx context.Context) {
+			_, err := service.DeleteStoreBinding(ctx, &schemasv1alpha1.DeleteStoreBindingRequest{
+				Store: "orders",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+
+		It("binding unknown type returns not found", func(ctx context.Context) {
+			_, err := service.EnsureStoreBinding(ctx, &schemasv1alpha1.EnsureStoreBindingRequest{
+				Store: "orders",
+				Types: []string{"windshift.test.v1.Unknown"},
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	"context"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *StateServiceServer) GetHistory(ctx context.Context, req *statev1alpha1.GetHistoryRequest) (*statev1alpha1.GetHistoryResponse, error) {
+	history, err := s.state.GetHistory(ctx, req.Store, req.Key)
+	if errors.Is(err, state.ErrKeyNotFound) {
+		// The key has never been set, return an empty history
+		return &statev1alpha1.GetHistoryResponse{}, nil
+	} else if errors.Is(err, context.Canceled) {
+		return nil, status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.Error(codes.DeadlineExceeded, "timed out")
+	} else if state.IsValidationError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &statev1alpha1.GetHistoryResponse{
+		Entries: make([]*statev1alpha1.GetHistoryResponse_Entry, len(history)),
+	}
+	for i, entry := range history {
+		res.Entries[i] = &statev1alpha1.GetHistoryResponse_Entry{
+			Revision:    entry.Revision,
+			LastUpdated: timestamppb.New(entry.Timestamp),
+			Deleted:     entry.Deleted,
+			Value:       entry.Value,
+		}
+
+		if req.GetEncoding() == statev1alpha1.Encoding_ENCODING_JSON {
+			res.Entries[i].JsonValue = s.encodeJSON(ctx, entry.Value)
+			if res.Entries[i].JsonValue != nil {
+				res.Entries[i].Value = nil
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,168 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("History", func() {
+	var service statev1alpha1.StateServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+			Store:   "test",
+			History: proto.Uint32(5),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	set := func(ctx context.Context, key string, value string) uint64 {
+		GinkgoHelper()
+
+		res, err := service.Set(ctx, &statev1alpha1.SetRequest{
+			Store: "test",
+			Key:   key,
+			Value: Data(wrapperspb.String(value)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Revision
+	}
+
+	valueOf := func(value *anypb.Any) string {
+		GinkgoHelper()
+
+		var s wrapperspb.StringValue
+		Expect(value.UnmarshalTo(&s)).To(Succeed())
+		return s.Value
+	}
+
+	Describe("GetHistory", func() {
+		It("returns revisions and delete markers oldest first", func(ctx context.Context) {
+			first := set(ctx, "key", "v1")
+			second := set(ctx, "key", "v2")
+			_, err := service.Delete(ctx, &statev1alpha1.DeleteRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			res, err := service.GetHistory(ctx, &statev1alpha1.GetHistoryRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Entries).To(HaveLen(3))
+			Expect(res.Entries[0].Revision).To(Equal(first))
+			Expect(valueOf(res.Entries[0].Value)).To(Equal("v1"))
+			Expect(res.Entries[1].Revision).To(Equal(second))
+			Expect(valueOf(res.Entries[1].Value)).To(Equal("v2"))
+			Expect(res.Entries[2].Deleted).To(BeTrue())
+			Expect(res.Entries[2].Value).To(BeNil())
+			Expect(res.Entries[2].LastUpdated).ToNot(BeNil())
+		})
+
+		It("returns no entries for keys without history", func(ctx context.Context) {
+			res, err := service.GetHistory(ctx, &statev1alpha1.GetHistoryRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Entries).To(BeEmpty())
+		})
+
+		It("can return values as JSON", func(ctx context.Context) {
+			set(ctx, "key", "v1")
+
+			res, err := service.GetHistory(ctx, &statev1alpha1.GetHistoryRequest{
+				Store:    "test",
+				Key:      "key",
+				Encoding: statev1alpha1.Encoding_ENCODING_JSON.Enum(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Entries).To(HaveLen(1))
+			Expect(res.Entries[0].Value).To(BeNil())
+			Expect(res.Entries[0].GetJsonValue()).To(MatchJSON(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"v1"}`))
+		})
+
+		It("fails with an invalid key", func(ctx context.Context) {
+			_, err := service.GetHistory(ctx, &statev1alpha1.GetHistoryRequest{
+				Store: "test",
+				Key:   "key.*",
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Get at a point in time", func() {
+		It("can get the value at a revision", func(ctx context.Context) {
+			first := set(ctx, "key", "v1")
+			set(ctx, "key", "v2")
+
+			res, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+				At:    &statev1alpha1.GetRequest_Revision{Revision: first},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Revision).To(Equal(first))
+			Expect(valueOf(res.Value)).To(Equal("v1"))
+		})
+
+		It("can get the value as of a time", func(ctx context.Context) {
+			set(ctx, "key", "v1")
+			time.Sleep(10 * time.Millisecond)
+			at := time.Now()
+			time.Sleep(10 * time.Millisecond)
+			set(ctx, "key", "v2")
+
+			res, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+				At:    &statev1alpha1.GetRequest_AsOf{AsOf: timestamppb.New(at)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valueOf(res.Value)).To(Equal("v1"))
+		})
+
+		It("returns revision zero before the key existed", func(ctx context.Context) {
+			at := time.Now()
+			time.Sleep(10 * time.Millisecond)
+			set(ctx, "key", "v1")
+
+			res, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+				At:    &statev1alpha1.GetRequest_AsOf{AsOf: timestamppb.New(at)},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Revision).To(BeZero())
+		})
+
+		It("fails with out of range if the revision is no longer kept", func(ctx context.Context) {
+			first := set(ctx, "key", "v0")
+			for i := 0; i < 5; i++ {
+				set(ctx, "key", "value")
+			}
+
+			_, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+				At:    &statev1alpha1.GetRequest_Revision{Revision: first},
+			})
+			Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"context"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultPageSize is the number of items returned by list calls when no
+	// page size is requested.
+	defaultPageSize = 100
+	// maxPageSize is the maximum number of items that can be requested in a
+	// single page.
+	maxPageSize = 1000
+)
+
+func (s *StateServiceServer) ListKeys(ctx context.Context, req *statev1alpha1.ListKeysRequest) (*statev1alpha1.ListKeysResponse, error) {
+	config := &state.ListKeysConfig{
+		Store:  req.Store,
+		Key:    req.GetKey(),
+		Prefix: req.GetPrefix(),
+		After:  req.GetPageToken(),
+		Limit:  defaultPageSize,
+	}
+
+	if req.PageSize != nil {
+		if *req.PageSize == 0 || *req.PageSize > maxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "page size must be between 1 and %d", maxPageSize)
+		}
+
+		config.Limit = uint(*req.PageSize)
+	}
+
+	list, err := s.state.ListKeys(ctx, config)
+	if errors.Is(err, context.Canceled) {
+		return nil, status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.Error(codes.DeadlineExceeded, "timed out")
+	} else if state.IsValidationError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &statev1alpha1.ListKeysResponse{
+		Keys: make([]*statev1alpha1.ListKeysResponse_Key, len(list.Keys)),
+	}
+	for i, key := range list.Keys {
+		res.Keys[i] = &statev1alpha1.ListKeysResponse_Key{
+			Key: key.Key,
+		}
+
+		if req.GetIncludeMetadata() {
+			res.Keys[i].Revision = &key.Revision
+			res.Keys[i].LastUpdated = timestamppb.New(key.Timestamp)
+		}
+	}
+
+	if list.HasMore && len(list.Keys) > 0 {
+		// The last key is used to continue listing
+		nextPageToken := list.Keys[len(list.Keys)-1].Key
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
@@ -0,0 +1,128 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("ListKeys", func() {
+	var service statev1alpha1.StateServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+			Store: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, key := range []string{"a.1", "a.2", "a.3", "b.1", "b.2"} {
+			_, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store: "test",
+				Key:   key,
+				Value: Data(wrapperspb.String(key)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	keysOf := func(res *statev1alpha1.ListKeysResponse) []string {
+		keys := make([]string, len(res.Keys))
+		for i, key := range res.Keys {
+			keys[i] = key.Key
+		}
+		return keys
+	}
+
+	It("lists all keys in order", func(ctx context.Context) {
+		res, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"a.1", "a.2", "a.3", "b.1", "b.2"}))
+		Expect(res.NextPageToken).To(BeNil())
+		Expect(res.Keys[0].Revision).To(BeNil())
+		Expect(res.Keys[0].LastUpdated).To(BeNil())
+	})
+
+	It("can page through keys", func(ctx context.Context) {
+		res, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:    "test",
+			PageSize: proto.Uint32(2),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"a.1", "a.2"}))
+		Expect(res.NextPageToken).ToNot(BeNil())
+
+		res, err = service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:     "test",
+			PageSize:  proto.Uint32(2),
+			PageToken: res.NextPageToken,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"a.3", "b.1"}))
+		Expect(res.NextPageToken).ToNot(BeNil())
+
+		res, err = service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:     "test",
+			PageSize:  proto.Uint32(2),
+			PageToken: res.NextPageToken,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"b.2"}))
+		Expect(res.NextPageToken).To(BeNil())
+	})
+
+	It("can filter keys by prefix", func(ctx context.Context) {
+		res, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:  "test",
+			Prefix: proto.String("b."),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"b.1", "b.2"}))
+	})
+
+	It("can filter keys by pattern", func(ctx context.Context) {
+		res, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store: "test",
+			Key:   proto.String("a.*"),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keysOf(res)).To(Equal([]string{"a.1", "a.2", "a.3"}))
+	})
+
+	It("can include metadata", func(ctx context.Context) {
+		res, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:           "test",
+			IncludeMetadata: proto.Bool(true),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Keys).To(HaveLen(5))
+		for _, key := range res.Keys {
+			Expect(key.GetRevision()).ToNot(BeZero())
+			Expect(key.LastUpdated).ToNot(BeNil())
+		}
+	})
+
+	It("fails with an invalid page size", func(ctx context.Context) {
+		_, err := service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:    "test",
+			PageSize: proto.Uint32(0),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = service.ListKeys(ctx, &statev1alpha1.ListKeysRequest{
+			Store:    "test",
+			PageSize: proto.Uint32(1001),
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
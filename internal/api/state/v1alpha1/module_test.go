@@ -6,9 +6,10 @@ import (
 	"os"
 	"time"
 
-	"github.com/levelfourab/windshift-server/internal/api/events/v1alpha1"
-	"github.com/levelfourab/windshift-server/internal/events"
-	eventsv1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/events/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/api/state/v1alpha1"
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+	"github.com/levelfourab/windshift-server/internal/state"
 
 	"github.com/levelfourab/sprout-go"
 	"github.com/levelfourab/sprout-go/test"
@@ -28,17 +29,18 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
-func GetClient() (eventsv1alpha1.EventsServiceClient, nats.JetStreamContext) {
+func GetClient() (statev1alpha1.StateServiceClient, jetstream.JetStream) {
 	t := GinkgoT()
 	var conn *grpc.ClientConn
-	var nats *nats.Conn
+	var js jetstream.JetStream
 	fx := fxtest.New(
 		t,
 		test.Module(t),
-		events.Module,
+		state.Module,
+		schemas.Module,
 		v1alpha1.Module,
 		TestModule,
-		fx.Populate(&conn, &nats),
+		fx.Populate(&conn, &js),
 	)
 	fx.RequireStart()
 
@@ -46,10 +48,7 @@ func GetClient() (eventsv1alpha1.EventsServiceClient, nats.JetStreamContext) {
 		fx.RequireStop()
 	})
 
-	js, err := nats.JetStream()
-	Expect(err).ToNot(HaveOccurred())
-
-	return eventsv1alpha1.NewEventsServiceClient(conn), js
+	return statev1alpha1.NewStateServiceClient(conn), js
 }
 
 var TestModule = fx.Module(
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
 	"github.com/levelfourab/windshift-server/internal/state"
 
 	"github.com/cockroachdb/errors"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,6 +24,7 @@ type StateServiceServer struct {
 	w3cPropagator propagation.TextMapPropagator
 
 	state      *state.Manager
+	schemas    *schemas.Manager
 	globalStop chan struct{}
 }
 
@@ -29,12 +32,14 @@ func newStateServiceServer(
 	lifecycle fx.Lifecycle,
 	logger *zap.Logger,
 	state *state.Manager,
+	schemas *schemas.Manager,
 ) *StateServiceServer {
 	server := &StateServiceServer{
 		logger:        logger,
 		w3cPropagator: propagation.TraceContext{},
 
 		state:      state,
+		schemas:    schemas,
 		globalStop: make(chan struct{}),
 	}
 
@@ -48,10 +53,42 @@ func newStateServiceServer(
 }
 
 func (s *StateServiceServer) EnsureStore(ctx context.Context, req *statev1alpha1.EnsureStoreRequest) (*statev1alpha1.EnsureStoreResponse, error) {
-	err := s.state.EnsureStore(ctx, &state.StoreConfig{
-		Name: req.Store,
-	})
+	config := &state.StoreConfig{
+		Name:        req.Store,
+		Description: req.GetDescription(),
+		History:     uint(req.GetHistory()),
+	}
+
+	if req.Ttl != nil {
+		config.TTL = req.Ttl.AsDuration()
+	}
+
+	if req.MaxValueSize != nil {
+		config.MaxValueSize = uint(*req.MaxValueSize)
+	}
+
+	if req.MaxBytes != nil {
+		config.MaxBytes = uint(*req.MaxBytes)
+	}
+
+	if req.Storage != nil {
+		if req.Storage.Replicas != nil {
+			replicas := uint(*req.Storage.Replicas)
+			config.Replicas = &replicas
+		}
+
+		if req.Storage.Type != nil {
+			switch *req.Storage.Type {
+			case statev1alpha1.EnsureStoreRequest_STORAGE_TYPE_MEMORY:
+				config.StorageType = state.StorageTypeMemory
+			case statev1alpha1.EnsureStoreRequest_STORAGE_TYPE_FILE,
+				statev1alpha1.EnsureStoreRequest_STORAGE_TYPE_UNSPECIFIED:
+				config.StorageType = state.StorageTypeFile
+			}
+		}
+	}
 
+	err := s.state.EnsureStore(ctx, config)
 	if errors.Is(err, context.Canceled) {
 		return nil, status.Error(codes.Canceled, "context canceled")
 	} else if errors.Is(err, context.DeadlineExceeded) {
@@ -66,7 +103,17 @@ func (s *StateServiceServer) EnsureStore(ctx context.Context, req *statev1alpha1
 }
 
 func (s *StateServiceServer) Get(ctx context.Context, req *statev1alpha1.GetRequest) (*statev1alpha1.GetResponse, error) {
-	value, err := s.state.Get(ctx, req.Store, req.Key)
+	var value *state.Entry
+	var err error
+	switch at := req.At.(type) {
+	case *statev1alpha1.GetRequest_Revision:
+		value, err = s.state.GetAtRevision(ctx, req.Store, req.Key, at.Revision)
+	case *statev1alpha1.GetRequest_AsOf:
+		value, err = s.state.GetAtTime(ctx, req.Store, req.Key, at.AsOf.AsTime())
+	default:
+		value, err = s.state.Get(ctx, req.Store, req.Key)
+	}
+
 	if errors.Is(err, state.ErrKeyNotFound) {
 		// The key doesn't exist, return an empty response.
 		return &statev1alpha1.GetResponse{
@@ -78,33 +125,46 @@ func (s *StateServiceServer) Get(ctx context.Context, req *statev1alpha1.GetRequ
 		return nil, status.Error(codes.DeadlineExceeded, "timed out")
 	} else if state.IsValidationError(err) {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if errors.Is(err, state.ErrRevisionUnavailable) {
+		return nil, status.Error(codes.OutOfRange, err.Error())
 	} else if err != nil {
 		return nil, err
 	}
 
-	return &statev1alpha1.GetResponse{
+	res := &statev1alpha1.GetResponse{
 		LastUpdated: timestamppb.New(value.Timestamp),
 		Revision:    value.Revision,
 		Value:       value.Value,
-	}, nil
+	}
+
+	if req.GetEncoding() == statev1alpha1.Encoding_ENCODING_JSON {
+		res.JsonValue = s.encodeJSON(ctx, value.Value)
+		if res.JsonValue != nil {
+			res.Value = nil
+		}
+	}
+
+	return res, nil
 }
 
 func (s *StateServiceServer) Set(ctx context.Context, req *statev1alpha1.SetRequest) (*statev1alpha1.SetResponse, error) {
 	var revision uint64
-	var err error
-	if req.GetCreateOnly() {
-		revision, err = s.state.Create(ctx, req.Store, req.Key, req.Value)
-	} else if req.LastRevision != nil {
-		revision, err = s.state.Update(ctx, req.Store, req.Key, req.Value, *req.LastRevision)
-	} else {
-		revision, err = s.state.Set(ctx, req.Store, req.Key, req.Value)
+	value, err := s.decodeValue(ctx, req)
+	if err == nil {
+		if req.GetCreateOnly() {
+			revision, err = s.state.Create(ctx, req.Store, req.Key, value)
+		} else if req.LastRevision != nil {
+			revision, err = s.state.Update(ctx, req.Store, req.Key, value, *req.LastRevision)
+		} else {
+			revision, err = s.state.Set(ctx, req.Store, req.Key, value)
+		}
 	}
 
 	if errors.Is(err, context.Canceled) {
 		return nil, status.Error(codes.Canceled, "context canceled")
 	} else if errors.Is(err, context.DeadlineExceeded) {
 		return nil, status.Error(codes.DeadlineExceeded, "timed out")
-	} else if state.IsValidationError(err) {
+	} else if state.IsValidationError(err) || errors.Is(err, state.ErrInvalidValue) {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	} else if err != nil {
 		return nil, err
@@ -135,3 +195,40 @@ func (s *StateServiceServer) Delete(ctx context.Context, req *statev1alpha1.Dele
 
 	return &statev1alpha1.DeleteResponse{}, nil
 }
+
+// encodeJSON encodes a value as JSON. Values that can not be encoded as JSON
+// are returned as Protobuf, so nil is returned for them.
+func (s *StateServiceServer) encodeJSON(ctx context.Context, value *anypb.Any) *string {
+	if value == nil {
+		return nil
+	}
+
+	json, err := s.schemas.EncodeJSON(ctx, value)
+	if err != nil {
+		s.logger.Debug("Could not encode value as JSON", zap.String("type", value.TypeUrl), zap.Error(err))
+		return nil
+	}
+
+	return &json
+}
+
+// decodeValue returns the value of a set request, decoding it from JSON if
+// needed.
+func (s *StateServiceServer) decodeValue(ctx context.Context, req *statev1alpha1.SetRequest) (*anypb.Any, error) {
+	if req.JsonValue == nil {
+		return req.Value, nil
+	}
+
+	if req.Value != nil {
+		return nil, errors.Wrap(state.ErrInvalidValue, "value and json_value can not both be set")
+	}
+
+	value, err := s.schemas.DecodeJSON(ctx, *req.JsonValue)
+	if schemas.IsValidationError(err) {
+		return nil, errors.Wrapf(state.ErrInvalidValue, "%s", err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,228 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"time"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("StateService", func() {
+	var service statev1alpha1.StateServiceClient
+	var js jetstream.JetStream
+
+	BeforeEach(func() {
+		service, js = GetClient()
+	})
+
+	Describe("EnsureStore", func() {
+		It("can create a store", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = js.KeyValue(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("applies the options of the store", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store:        "test",
+				Description:  proto.String("Test store"),
+				History:      proto.Uint32(5),
+				Ttl:          durationpb.New(time.Hour),
+				MaxValueSize: proto.Uint32(1024),
+				MaxBytes:     proto.Uint64(1024 * 1024),
+				Storage: &statev1alpha1.EnsureStoreRequest_Storage{
+					Type: statev1alpha1.EnsureStoreRequest_STORAGE_TYPE_MEMORY.Enum(),
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := js.Stream(ctx, "KV_test")
+			Expect(err).ToNot(HaveOccurred())
+			config := stream.CachedInfo().Config
+			Expect(config.Description).To(Equal("Test store"))
+			Expect(config.MaxMsgsPerSubject).To(Equal(int64(5)))
+			Expect(config.MaxAge).To(Equal(time.Hour))
+			Expect(config.MaxMsgSize).To(Equal(int32(1024)))
+			Expect(config.MaxBytes).To(Equal(int64(1024 * 1024)))
+			Expect(config.Storage).To(Equal(jetstream.MemoryStorage))
+		})
+
+		It("can update a store", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store:   "test",
+				History: proto.Uint32(3),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := js.Stream(ctx, "KV_test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.CachedInfo().Config.MaxMsgsPerSubject).To(Equal(int64(3)))
+		})
+
+		It("fails if the storage type is changed", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test",
+				Storage: &statev1alpha1.EnsureStoreRequest_Storage{
+					Type: statev1alpha1.EnsureStoreRequest_STORAGE_TYPE_MEMORY.Enum(),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("fails with invalid store name", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test.store",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("fails for stores used internally", func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store:   "windshift-locks",
+				History: proto.Uint32(1),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Get and Set", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+				Store: "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can set and get a value", func(ctx context.Context) {
+			set, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store: "test",
+				Key:   "key",
+				Value: Data(wrapperspb.String("value")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(set.Revision).ToNot(BeZero())
+
+			get, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(get.Revision).To(Equal(set.Revision))
+			Expect(get.LastUpdated).ToNot(BeNil())
+			Expect(get.JsonValue).To(BeNil())
+
+			var value wrapperspb.StringValue
+			Expect(get.Value.UnmarshalTo(&value)).To(Succeed())
+			Expect(value.Value).To(Equal("value"))
+		})
+
+		It("returns revision zero for missing keys", func(ctx context.Context) {
+			get, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(get.Revision).To(BeZero())
+			Expect(get.Value).To(BeNil())
+		})
+
+		It("can set and get a value as JSON", func(ctx context.Context) {
+			set, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store:     "test",
+				Key:       "key",
+				JsonValue: proto.String(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"value"}`),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			get, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store:    "test",
+				Key:      "key",
+				Encoding: statev1alpha1.Encoding_ENCODING_JSON.Enum(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(get.Revision).To(Equal(set.Revision))
+			Expect(get.Value).To(BeNil())
+			Expect(get.JsonValue).ToNot(BeNil())
+			Expect(*get.JsonValue).To(MatchJSON(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"value"}`))
+		})
+
+		It("fails with invalid JSON", func(ctx context.Context) {
+			_, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store:     "test",
+				Key:       "key",
+				JsonValue: proto.String(`{"value":"value"}`),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("fails if both a value and JSON are set", func(ctx context.Context) {
+			_, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store:     "test",
+				Key:       "key",
+				Value:     Data(wrapperspb.String("value")),
+				JsonValue: proto.String(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"value"}`),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("fails to set values in stores used internally", func(ctx context.Context) {
+			_, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store: "windshift-locks",
+				Key:   "key",
+				Value: Data(wrapperspb.String("value")),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("can delete a value", func(ctx context.Context) {
+			_, err := service.Set(ctx, &statev1alpha1.SetRequest{
+				Store: "test",
+				Key:   "key",
+				Value: Data(wrapperspb.String("value")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = service.Delete(ctx, &statev1alpha1.DeleteRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			get, err := service.Get(ctx, &statev1alpha1.GetRequest{
+				Store: "test",
+				Key:   "key",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(get.Revision).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	"context"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *StateServiceServer) Watch(req *statev1alpha1.WatchRequest, server statev1alpha1.StateService_WatchServer) error {
+	ctx := server.Context()
+	watcher, err := s.state.Watch(ctx, &state.WatchConfig{
+		Store:              req.Store,
+		Key:                req.GetKey(),
+		IncludeCurrent:     req.GetIncludeCurrent(),
+		ResumeFromRevision: req.GetResumeFromRevision(),
+	})
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "context canceled")
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "timed out")
+	} else if state.IsValidationError(err) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if errors.Is(err, state.ErrRevisionUnavailable) {
+		return status.Error(codes.OutOfRange, err.Error())
+	} else if err != nil {
+		return err
+	}
+
+	defer func() {
+		err := watcher.Stop()
+		if err != nil {
+			s.logger.Debug("Could not stop watcher", zap.Error(err))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.globalStop:
+			return nil
+		case event, ok := <-watcher.Updates():
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return status.Error(codes.Unavailable, "watch stopped")
+			}
+
+			err = server.Send(s.toWatchResponse(ctx, event, req.GetEncoding()))
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// toWatchResponse converts an update from a watcher into its API
+// representation, with values in the requested encoding.
+func (s *StateServiceServer) toWatchResponse(ctx context.Context, event state.KeyEvent, encoding statev1alpha1.Encoding) *statev1alpha1.WatchResponse {
+	switch e := event.(type) {
+	case state.KeySetEvent:
+		set := &statev1alpha1.WatchResponse_KeySet{
+			Key:         e.Key,
+			Revision:    e.Revision,
+			LastUpdated: timestamppb.New(e.Timestamp),
+			Value:       e.Value,
+		}
+
+		if encoding == statev1alpha1.Encoding_ENCODING_JSON {
+			set.JsonValue = s.encodeJSON(ctx, e.Value)
+			if set.JsonValue != nil {
+				set.Value = nil
+			}
+		}
+
+		return &statev1alpha1.WatchResponse{
+			Update: &statev1alpha1.WatchResponse_Set{
+				Set: set,
+			},
+		}
+	case state.KeyDeleteEvent:
+		return &statev1alpha1.WatchResponse{
+			Update: &statev1alpha1.WatchResponse_Deleted{
+				Deleted: &statev1alpha1.WatchResponse_KeyDeleted{
+					Key:         e.Key,
+					Revision:    e.Revision,
+					LastUpdated: timestamppb.New(e.Timestamp),
+				},
+			},
+		}
+	default:
+		return &statev1alpha1.WatchResponse{
+			Update: &statev1alpha1.WatchResponse_CaughtUp_{
+				CaughtUp: &statev1alpha1.WatchResponse_CaughtUp{},
+			},
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package v1alpha1_test
+
+import (
+	"context"
+
+	statev1alpha1 "github.com/levelfourab/windshift-server/internal/proto/windshift/state/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Watch", func() {
+	var service statev1alpha1.StateServiceClient
+
+	BeforeEach(func(ctx context.Context) {
+		service, _ = GetClient()
+
+		_, err := service.EnsureStore(ctx, &statev1alpha1.EnsureStoreRequest{
+			Store: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	set := func(ctx context.Context, key string, value string) uint64 {
+		GinkgoHelper()
+
+		res, err := service.Set(ctx, &statev1alpha1.SetRequest{
+			Store: "test",
+			Key:   key,
+			Value: Data(wrapperspb.String(value)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return res.Revision
+	}
+
+	recv := func(stream statev1alpha1.StateService_WatchClient) *statev1alpha1.WatchResponse {
+		GinkgoHelper()
+
+		res, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		return res
+	}
+
+	// watch starts watching an empty store, waiting until the watch has
+	// caught up so that updates made after it returns are received
+	watch := func(ctx context.Context, req *statev1alpha1.WatchRequest) statev1alpha1.StateService_WatchClient {
+		GinkgoHelper()
+
+		req.Store = "test"
+		req.IncludeCurrent = proto.Bool(true)
+		stream, err := service.Watch(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(recv(stream).GetCaughtUp()).ToNot(BeNil())
+		return stream
+	}
+
+	It("receives set and delete updates", func(ctx context.Context) {
+		stream := watch(ctx, &statev1alpha1.WatchRequest{})
+
+		revision := set(ctx, "key", "value")
+		res := recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Key).To(Equal("key"))
+		Expect(res.GetSet().Revision).To(Equal(revision))
+		Expect(res.GetSet().LastUpdated).ToNot(BeNil())
+
+		var value wrapperspb.StringValue
+		Expect(res.GetSet().Value.UnmarshalTo(&value)).To(Succeed())
+		Expect(value.Value).To(Equal("value"))
+
+		_, err := service.Delete(ctx, &statev1alpha1.DeleteRequest{
+			Store: "test",
+			Key:   "key",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res = recv(stream)
+		Expect(res.GetDeleted()).ToNot(BeNil())
+		Expect(res.GetDeleted().Key).To(Equal("key"))
+		Expect(res.GetDeleted().Revision).To(BeNumerically(">", revision))
+	})
+
+	It("only receives updates to the watched key", func(ctx context.Context) {
+		stream := watch(ctx, &statev1alpha1.WatchRequest{
+			Key: proto.String("key"),
+		})
+
+		set(ctx, "other", "value")
+		set(ctx, "key", "value")
+
+		res := recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Key).To(Equal("key"))
+	})
+
+	It("sends current values followed by caught up", func(ctx context.Context) {
+		revision := set(ctx, "key", "value")
+
+		stream, err := service.Watch(ctx, &statev1alpha1.WatchRequest{
+			Store:          "test",
+			IncludeCurrent: proto.Bool(true),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res := recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Revision).To(Equal(revision))
+
+		res = recv(stream)
+		Expect(res.GetCaughtUp()).ToNot(BeNil())
+
+		set(ctx, "key", "updated")
+		res = recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Revision).To(BeNumerically(">", revision))
+	})
+
+	It("can resume from a revision", func(ctx context.Context) {
+		first := set(ctx, "key", "v1")
+		second := set(ctx, "key", "v2")
+		third := set(ctx, "other", "v1")
+
+		stream, err := service.Watch(ctx, &statev1alpha1.WatchRequest{
+			Store:              "test",
+			ResumeFromRevision: proto.Uint64(first),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res := recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Revision).To(Equal(second))
+
+		res = recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Revision).To(Equal(third))
+
+		res = recv(stream)
+		Expect(res.GetCaughtUp()).ToNot(BeNil())
+	})
+
+	It("fails with out of range if the revision is newer than the store", func(ctx context.Context) {
+		revision := set(ctx, "key", "value")
+
+		stream, err := service.Watch(ctx, &statev1alpha1.WatchRequest{
+			Store:              "test",
+			ResumeFromRevision: proto.Uint64(revision + 10),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = stream.Recv()
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.OutOfRange))
+	})
+
+	It("fails if include current and resume from revision are both set", func(ctx context.Context) {
+		stream, err := service.Watch(ctx, &statev1alpha1.WatchRequest{
+			Store:              "test",
+			IncludeCurrent:     proto.Bool(true),
+			ResumeFromRevision: proto.Uint64(1),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = stream.Recv()
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("can send values as JSON", func(ctx context.Context) {
+		stream := watch(ctx, &statev1alpha1.WatchRequest{
+			Encoding: statev1alpha1.Encoding_ENCODING_JSON.Enum(),
+		})
+
+		set(ctx, "key", "value")
+		res := recv(stream)
+		Expect(res.GetSet()).ToNot(BeNil())
+		Expect(res.GetSet().Value).To(BeNil())
+		Expect(res.GetSet().GetJsonValue()).To(MatchJSON(`{"@type":"type.googleapis.com/google.protobuf.StringValue","value":"value"}`))
+	})
+})
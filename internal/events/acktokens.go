@@ -0,0 +1,293 @@
+package events
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// ackSubjectPrefix is the prefix of the subjects JetStream uses to receive
+// acknowledgements for delivered events.
+const ackSubjectPrefix = "$JS.ACK."
+
+// AckToken identifies a single delivery of an event to a consumer. Tokens
+// can be used to acknowledge, reject or ping an event from any connection,
+// not only from the Events instance that received it.
+type AckToken struct {
+	// Stream is the stream the event is stored in.
+	Stream string
+	// Consumer is the consumer the event was delivered to.
+	Consumer string
+	// StreamSeq is the sequence number of the event in the stream.
+	StreamSeq uint64
+	// DeliveryAttempt is the delivery attempt the token is for.
+	DeliveryAttempt uint64
+
+	// subject is the subject acknowledgements are sent to.
+	subject string
+}
+
+// RejectConfig is the configuration for rejecting an event using an ack
+// token.
+type RejectConfig struct {
+	// Permanently rejects the event, it will not be redelivered. Can not be
+	// combined with Delay.
+	Permanently bool
+	// Delay is the time to wait before redelivering the event. If not set
+	// the redelivery backoff of the consumer is used.
+	Delay time.Duration
+	// Failure describes why the event was rejected. Optional.
+	Failure *Failure
+}
+
+// newAckToken encodes the reply subject of a delivered event into a token,
+// signed with the ack token key so that clients can not send
+// acknowledgements to arbitrary subjects.
+func (m *Manager) newAckToken(reply string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(reply)) + "." +
+		base64.RawURLEncoding.EncodeToString(m.signAckSubject(reply))
+}
+
+// signAckSubject calculates the signature of an ack subject.
+func (m *Manager) signAckSubject(subject string) []byte {
+	mac := hmac.New(sha256.New, m.ackTokenKey)
+	mac.Write([]byte(subject))
+	return mac.Sum(nil)
+}
+
+// ParseAckToken decodes an ack token. Returns ErrInvalidAckToken if the
+// token was not created by a server using the same ack token key.
+func (m *Manager) ParseAckToken(token string) (*AckToken, error) {
+	encodedSubject, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(encodedSubject)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	subject := string(decoded)
+	if !hmac.Equal(signature, m.signAckSubject(subject)) {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	return parseAckSubject(subject)
+}
+
+// parseAckSubject parses the reply subject JetStream uses for a delivered
+// event. Only the two layouts used by JetStream are accepted:
+//
+//	$JS.ACK.<stream>.<consumer>.<delivered>.<stream seq>.<consumer seq>.<timestamp>.<pending>
+//	$JS.ACK.<domain>.<account hash>.<stream>.<consumer>.<delivered>.<stream seq>.<consumer seq>.<timestamp>.<pending>.<random>
+func parseAckSubject(subject string) (*AckToken, error) {
+	if !strings.HasPrefix(subject, ackSubjectPrefix) {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	tokens := strings.Split(subject, ".")
+	switch len(tokens) {
+	case 9:
+	case 12:
+		if tokens[2] == "" || tokens[3] == "" || tokens[11] == "" {
+			return nil, errors.WithStack(ErrInvalidAckToken)
+		}
+
+		// Drop the domain, account hash and random token to get the same
+		// positions as the older layout
+		tokens = append(tokens[:2:2], tokens[4:11]...)
+	default:
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	ackToken := &AckToken{
+		Stream:   tokens[2],
+		Consumer: tokens[3],
+		subject:  subject,
+	}
+
+	if validateStreamName(ackToken.Stream) != nil || !IsValidConsumerName(ackToken.Consumer) {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	numbers := make([]uint64, 0, 5)
+	for _, t := range tokens[4:] {
+		n, err := strconv.ParseUint(t, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(ErrInvalidAckToken)
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	// Delivery attempts and sequences start at 1
+	if numbers[0] == 0 || numbers[1] == 0 || numbers[2] == 0 {
+		return nil, errors.WithStack(ErrInvalidAckToken)
+	}
+
+	ackToken.DeliveryAttempt = numbers[0]
+	ackToken.StreamSeq = numbers[1]
+	return ackToken, nil
+}
+
+// AckEvent acknowledges an event using its ack token.
+func (m *Manager) AckEvent(ctx context.Context, token string) error {
+	ctx, span, ackToken, err := m.startAckTokenSpan(ctx, "windshift.events.AckEvent", token)
+	if err != nil {
+		return err
+	}
+	defer span.End()
+
+	m.logger.Debug("Acknowledging event", zap.String("stream", ackToken.Stream), zap.Uint64("streamSeq", ackToken.StreamSeq))
+	err = m.sendAck(ctx, ackToken, "+ACK")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to acknowledge event")
+		return errors.Wrap(err, "could not acknowledge event")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// RejectEvent rejects an event using its ack token. Works like the reject
+// methods of Event, including logging the failure if one is provided.
+func (m *Manager) RejectEvent(ctx context.Context, token string, config *RejectConfig) error {
+	ctx, span, ackToken, err := m.startAckTokenSpan(ctx, "windshift.events.RejectEvent", token)
+	if err != nil {
+		return err
+	}
+	defer span.End()
+
+	if config.Permanently && config.Delay > 0 {
+		span.SetStatus(codes.Error, "permanently and delay can not be combined")
+		return newValidationError("permanent rejection can not have a delay")
+	}
+
+	err = ValidateFailure(config.Failure)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid failure")
+		return err
+	}
+
+	delay := config.Delay
+	if !config.Permanently && delay == 0 {
+		// Use the backoff of the consumer, just like Event.Reject
+		consumer, err2 := m.js.Consumer(ctx, ackToken.Stream, ackToken.Consumer)
+		if errors.Is(err2, jetstream.ErrConsumerNotFound) || errors.Is(err2, jetstream.ErrStreamNotFound) {
+			// The event can no longer be rejected
+			span.SetStatus(codes.Error, "consumer not found")
+			return errors.WithStack(ErrInvalidAckToken)
+		} else if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to get consumer")
+			return errors.Wrap(err2, "could not get consumer info")
+		}
+
+		delay = redeliveryDelay(consumer.CachedInfo().Config.BackOff, ackToken.DeliveryAttempt)
+	}
+
+	if config.Failure != nil {
+		span.AddEvent("failure", trace.WithAttributes(failureAttributes(config.Failure)...))
+
+		err = m.recordFailure(ctx, ackToken.Stream, ackToken.Consumer, ackToken.StreamSeq, ackToken.DeliveryAttempt, config.Permanently, config.Failure)
+		if err != nil {
+			// Rejecting is more important than logging the failure
+			m.logger.Warn("Could not log failure", zap.Uint64("streamSeq", ackToken.StreamSeq), ZapFailure(config.Failure), zap.Error(err))
+			span.RecordError(err)
+		}
+	}
+
+	var body string
+	switch {
+	case config.Permanently:
+		body = "+TERM"
+	case delay > 0:
+		body = fmt.Sprintf("-NAK {\"delay\": %d}", delay.Nanoseconds())
+	default:
+		body = "-NAK"
+	}
+
+	m.logger.Debug("Rejecting event", zap.String("stream", ackToken.Stream), zap.Uint64("streamSeq", ackToken.StreamSeq))
+	err = m.sendAck(ctx, ackToken, body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to reject event")
+		return errors.Wrap(err, "could not reject event")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// PingEvent extends the deadline of an event using its ack token.
+func (m *Manager) PingEvent(ctx context.Context, token string) error {
+	ctx, span, ackToken, err := m.startAckTokenSpan(ctx, "windshift.events.PingEvent", token)
+	if err != nil {
+		return err
+	}
+	defer span.End()
+
+	m.logger.Debug("Pinging event", zap.String("stream", ackToken.Stream), zap.Uint64("streamSeq", ackToken.StreamSeq))
+	err = m.sendAck(ctx, ackToken, "+WPI")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to ping event")
+		return errors.Wrap(err, "could not ping event")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// startAckTokenSpan parses an ack token and starts a span for an operation
+// on it. The span is only returned if the token is valid.
+func (m *Manager) startAckTokenSpan(ctx context.Context, name string, token string) (context.Context, trace.Span, *AckToken, error) {
+	ackToken, err := m.ParseAckToken(token)
+	if err != nil {
+		return ctx, nil, nil, err
+	}
+
+	ctx, span := m.tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			semconv.MessagingMessageID(strconv.FormatUint(ackToken.StreamSeq, 10)),
+			attribute.String("stream", ackToken.Stream),
+			attribute.String("name", ackToken.Consumer),
+		),
+	)
+	return ctx, span, ackToken, nil
+}
+
+// sendAck sends an acknowledgement to JetStream and waits until the server
+// has received it.
+func (m *Manager) sendAck(ctx context.Context, ackToken *AckToken, body string) error {
+	err := m.conn.Publish(ackToken.subject, []byte(body))
+	if err != nil {
+		return err
+	}
+
+	return m.conn.FlushWithContext(ctx)
+}
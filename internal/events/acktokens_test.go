@@ -0,0 +1,203 @@
+package events_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Ack tokens", func() {
+	var manager *events.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+			Timeout:  time.Second,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	receive := func(ctx context.Context) (*events.Events, *events.Event) {
+		ec, err := manager.Events(ctx, &events.EventConsumeConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+
+		_, err = manager.Publish(ctx, &events.PublishConfig{
+			Subject: "events.test",
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		return ec, <-ec.Incoming()
+	}
+
+	signed := func(key string, subject string) string {
+		mac := hmac.New(sha256.New, []byte(key))
+		mac.Write([]byte(subject))
+		return base64.RawURLEncoding.EncodeToString([]byte(subject)) + "." +
+			base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	It("managers require a key to sign tokens with", func() {
+		_, err := events.NewManager(
+			zaptest.NewLogger(GinkgoT()),
+			otel.Tracer("tests"),
+			nil,
+			nil,
+			nil,
+			&events.Config{},
+		)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("events have a token that can be parsed", func(ctx context.Context) {
+		_, event := receive(ctx)
+		Expect(event.AckToken).ToNot(BeEmpty())
+
+		token, err := manager.ParseAckToken(event.AckToken)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Stream).To(Equal("events"))
+		Expect(token.Consumer).To(Equal("test"))
+		Expect(token.StreamSeq).To(Equal(uint64(1)))
+		Expect(token.DeliveryAttempt).To(Equal(uint64(1)))
+	})
+
+	It("tokens with domain and account hash can be parsed", func() {
+		token, err := manager.ParseAckToken(signed(testAckTokenKey, "$JS.ACK._.ABCDEF.events.test.2.5.3.1700000000000000000.0.xyz"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.Stream).To(Equal("events"))
+		Expect(token.Consumer).To(Equal("test"))
+		Expect(token.StreamSeq).To(Equal(uint64(5)))
+		Expect(token.DeliveryAttempt).To(Equal(uint64(2)))
+	})
+
+	It("tokens not created by the server are invalid", func() {
+		for _, token := range []string{
+			"",
+			"not base64!",
+			base64.RawURLEncoding.EncodeToString([]byte("$JS.ACK.events.test.1.1.1.1700000000000000000.0")),
+			signed("other-key", "$JS.ACK.events.test.1.1.1.1700000000000000000.0"),
+			signed(testAckTokenKey, "$JS.ACK.events.test.1.1.1.1700000000000000000.0")[1:],
+		} {
+			_, err := manager.ParseAckToken(token)
+			Expect(errors.Is(err, events.ErrInvalidAckToken)).To(BeTrue(), token)
+		}
+	})
+
+	It("signed tokens with unknown subjects are invalid", func() {
+		for _, subject := range []string{
+			"events.test",
+			"$JS.ACK.events.test.1",
+			"$JS.ACK.events.test.a.b.c.d.e",
+			"$JS.ACK.events.test.1.1.1.1700000000000000000.x",
+			"$JS.ACK.events.test.1.0.1.1700000000000000000.0",
+			"$JS.ACK.events.test.1.1.1.1700000000000000000.0.extra",
+			"$JS.ACK._.ABCDEF.events.test.1.1.1.1700000000000000000.0",
+			"$JS.ACK._.ABCDEF.events.test.1.1.1.1700000000000000000.0.xyz.extra",
+			"$JS.ACK._.ABCDEF.events.test.1.1.1.time.0.xyz",
+			"$JS.ACK._.ABCDEF.events.invalid name.1.1.1.1700000000000000000.0.xyz",
+		} {
+			_, err := manager.ParseAckToken(signed(testAckTokenKey, subject))
+			Expect(errors.Is(err, events.ErrInvalidAckToken)).To(BeTrue(), subject)
+		}
+	})
+
+	It("can acknowledge event using token", func(ctx context.Context) {
+		ec, event := receive(ctx)
+
+		err := manager.AckEvent(ctx, event.AckToken)
+		Expect(err).ToNot(HaveOccurred())
+
+		select {
+		case <-ec.Incoming():
+			Fail("event was redelivered")
+		case <-time.After(1500 * time.Millisecond):
+		}
+	})
+
+	It("can reject event using token", func(ctx context.Context) {
+		ec, event := receive(ctx)
+
+		err := manager.RejectEvent(ctx, event.AckToken, &events.RejectConfig{})
+		Expect(err).ToNot(HaveOccurred())
+
+		select {
+		case event := <-ec.Incoming():
+			Expect(event.DeliveryAttempt).To(BeNumerically("==", 2))
+		case <-time.After(500 * time.Millisecond):
+			Fail("no event received")
+		}
+	})
+
+	It("can reject event with a failure using token", func(ctx context.Context) {
+		_, event := receive(ctx)
+
+		err := manager.RejectEvent(ctx, event.AckToken, &events.RejectConfig{
+			Permanently: true,
+			Failure: &events.Failure{
+				Reason: "invalid order",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err := manager.ListFailures(ctx, &events.ListFailuresConfig{
+			Stream:   "events",
+			Consumer: "test",
+			Limit:    10,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Failures).To(HaveLen(1))
+		Expect(list.Failures[0].Permanent).To(BeTrue())
+	})
+
+	It("rejecting permanently with a delay fails", func(ctx context.Context) {
+		_, event := receive(ctx)
+
+		err := manager.RejectEvent(ctx, event.AckToken, &events.RejectConfig{
+			Permanently: true,
+			Delay:       time.Second,
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("can ping event using token", func(ctx context.Context) {
+		ec, event := receive(ctx)
+
+		// Keep the event alive past the processing timeout
+		for i := 0; i < 3; i++ {
+			time.Sleep(500 * time.Millisecond)
+			err := manager.PingEvent(ctx, event.AckToken)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		select {
+		case <-ec.Incoming():
+			Fail("event was redelivered")
+		default:
+		}
+	})
+})
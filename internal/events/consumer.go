@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -30,6 +31,16 @@ type StreamPointer struct {
 	First bool
 }
 
+const (
+	// maxExponentialBackoffDelays is the maximum number of delays generated
+	// for an exponential backoff.
+	maxExponentialBackoffDelays = 32
+	// timeoutMetadataKey is the key in the consumer metadata that holds the
+	// configured timeout of a consumer. JetStream replaces the ack wait with
+	// the first backoff delay, so the timeout can not be read back from it.
+	timeoutMetadataKey = "windshift-timeout"
+)
+
 // ConsumerConfig is the configuration for creating a consumer.
 type ConsumerConfig struct {
 	// Name of the consumer. If empty, an ephemeral consumer will be created.
@@ -49,15 +60,76 @@ type ConsumerConfig struct {
 	// delivered before it is considered failed.
 	MaxDeliveryAttempts uint
 
+	// Backoff is the delay before each redelivery of an event, the first
+	// delay is used for the first redelivery and so on. The last delay is
+	// used for any further redeliveries. Maps to the BackOff of JetStream,
+	// which also uses the delays as the processing timeout of deliveries,
+	// starting with the first delay for the first delivery, so every delay
+	// must be at least Timeout. If empty, events are redelivered when Timeout
+	// expires.
+	Backoff []time.Duration
+
 	// From describes where to start consuming from. If not specified, the
 	// default policy is to only consume new events.
 	From *StreamPointer
+
+	// DeadLetterSubject is the subject that events are copied to when they
+	// reach MaxDeliveryAttempts or are permanently rejected. The subject
+	// must be bound to a stream. If empty, failed events are not kept.
+	DeadLetterSubject string
 }
 
 // Consumer describes a consumer of events from a stream.
 type Consumer struct {
 	// ID is the ID of the consumer.
 	ID string
+	// Durable is true if the consumer is durable, false if ephemeral.
+	Durable bool
+	// Created is when the consumer was created.
+	Created time.Time
+	// Config is the current configuration of the consumer.
+	Config ConsumerConfig
+	// State is the current state of the consumer.
+	State ConsumerState
+}
+
+// ConsumerState is the current state of a consumer, used to see how far
+// behind the stream a consumer is.
+type ConsumerState struct {
+	// Pending is the number of events matching the consumer that have not
+	// been delivered yet.
+	Pending uint64
+	// AckPending is the number of events delivered but not yet acknowledged
+	// or rejected.
+	AckPending uint
+	// Redelivered is the number of events waiting for acknowledgement that
+	// have been delivered more than once.
+	Redelivered uint
+	// LastDeliveredID is the ID of the last event delivered.
+	LastDeliveredID uint64
+	// LastDeliveredTime is when the last event was delivered.
+	LastDeliveredTime time.Time
+	// AckFloorID is the ID of the event up to which all events have been
+	// acknowledged.
+	AckFloorID uint64
+}
+
+// ListConsumersConfig is the configuration for listing consumers.
+type ListConsumersConfig struct {
+	// Stream to list consumers for.
+	Stream string
+	// After is the ID of the consumer to list consumers after, used for paging.
+	After string
+	// Limit is the maximum number of consumers to return.
+	Limit uint
+}
+
+// ConsumerList is a page of consumers.
+type ConsumerList struct {
+	// Consumers in the page, ordered by ID.
+	Consumers []*Consumer
+	// HasMore indicates if there are more consumers after this page.
+	HasMore bool
 }
 
 // EnsureConsumer ensures that a consumer exists for the specified stream and
@@ -77,9 +149,10 @@ func (m *Manager) EnsureConsumer(ctx context.Context, config *ConsumerConfig) (*
 	)
 	defer span.End()
 
-	if !IsValidStreamName(config.Stream) {
+	err := validateStreamName(config.Stream)
+	if err != nil {
 		span.SetStatus(codes.Error, "invalid stream")
-		return nil, newValidationError("invalid stream: " + config.Stream)
+		return nil, err
 	}
 
 	if len(config.Subjects) == 0 {
@@ -94,19 +167,47 @@ func (m *Manager) EnsureConsumer(ctx context.Context, config *ConsumerConfig) (*
 		}
 	}
 
-	var name string
-	var err error
+	if len(config.Backoff) > 0 {
+		err := validateBackoff(config)
+		if err != nil {
+			span.SetStatus(codes.Error, "invalid backoff")
+			return nil, err
+		}
+	}
+
+	if config.DeadLetterSubject != "" {
+		if !IsValidSubject(config.DeadLetterSubject, false) {
+			span.SetStatus(codes.Error, "invalid dead-letter subject")
+			return nil, newValidationError("invalid dead-letter subject: " + config.DeadLetterSubject)
+		}
+
+		err := m.validateDeadLetterSubject(ctx, config)
+		if err != nil {
+			span.SetStatus(codes.Error, "invalid dead-letter subject")
+			return nil, err
+		}
+
+		// Advisories are only captured if the stream exists
+		err = m.ensureAdvisoryStream(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to create advisory stream")
+			return nil, err
+		}
+	}
+
+	var info *jetstream.ConsumerInfo
 	if config.Name == "" {
 		// If the name is not specified, we create an ephemeral consumer
 		span.SetAttributes(attribute.String("type", "ephemeral"))
 
-		name, err = m.declareEphemeralConsumer(ctx, config)
+		info, err = m.declareEphemeralConsumer(ctx, config)
 		if err != nil {
 			return nil, err
 		}
 
 		// Update the span with the generated name of the ephemeral consumer
-		span.SetAttributes(attribute.String("name", name))
+		span.SetAttributes(attribute.String("name", info.Name))
 	} else {
 		// If the name is specified, we create a durable consumer
 		if !IsValidConsumerName(config.Name) {
@@ -119,21 +220,132 @@ func (m *Manager) EnsureConsumer(ctx context.Context, config *ConsumerConfig) (*
 			attribute.String("name", config.Name),
 		)
 
-		name, err = m.declareDurableConsumer(ctx, config)
+		info, err = m.declareDurableConsumer(ctx, config)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &Consumer{
-		ID: name,
-	}, nil
+	return toConsumer(info), nil
+}
+
+// GetConsumer returns information about a consumer of a stream. If the
+// stream does not exist ErrStreamNotFound is returned, and if the consumer
+// does not exist ErrConsumerNotFound is returned.
+func (m *Manager) GetConsumer(ctx context.Context, stream string, id string) (*Consumer, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.GetConsumer",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", stream),
+			attribute.String("name", id),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(stream)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream")
+		return nil, err
+	}
+
+	if !IsValidConsumerName(id) {
+		span.SetStatus(codes.Error, "invalid consumer id")
+		return nil, newValidationError("invalid consumer id: " + id)
+	}
+
+	c, err := m.js.Consumer(ctx, stream, id)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if errors.Is(err, jetstream.ErrConsumerNotFound) {
+		span.SetStatus(codes.Error, "consumer not found")
+		return nil, errors.WithStack(ErrConsumerNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get consumer")
+		return nil, errors.Wrap(err, "could not get consumer info")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return toConsumer(c.CachedInfo()), nil
+}
+
+// ListConsumers returns a page of consumers of a stream ordered by ID.
+func (m *Manager) ListConsumers(ctx context.Context, config *ListConsumersConfig) (*ConsumerList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ListConsumers",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", config.Stream),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(config.Stream)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream")
+		return nil, err
+	}
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	stream, err := m.js.Stream(ctx, config.Stream)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get stream info")
+	}
+
+	lister := stream.ListConsumers(ctx)
+	infos := make([]*jetstream.ConsumerInfo, 0)
+	for info := range lister.Info() {
+		if info.Name > config.After {
+			infos = append(infos, info)
+		}
+	}
+
+	if lister.Err() != nil {
+		span.RecordError(lister.Err())
+		span.SetStatus(codes.Error, "failed to list consumers")
+		return nil, errors.Wrap(lister.Err(), "could not list consumers")
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	result := &ConsumerList{
+		HasMore: uint(len(infos)) > config.Limit,
+	}
+
+	if result.HasMore {
+		infos = infos[:config.Limit]
+	}
+
+	result.Consumers = make([]*Consumer, len(infos))
+	for i, info := range infos {
+		result.Consumers[i] = toConsumer(info)
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return result, nil
 }
 
 // declareEphemeralConsumer creates an ephemeral consumer. Ephemeral consumers
 // are automatically deleted when they have not been used for a period of time,
 // and are useful for one-off consumers.
-func (m *Manager) declareEphemeralConsumer(ctx context.Context, config *ConsumerConfig) (string, error) {
+func (m *Manager) declareEphemeralConsumer(ctx context.Context, config *ConsumerConfig) (*jetstream.ConsumerInfo, error) {
 	consumerConfig := &jetstream.ConsumerConfig{
 		Name:              uuid.New().String(),
 		InactiveThreshold: 1 * time.Hour,
@@ -146,17 +358,17 @@ func (m *Manager) declareEphemeralConsumer(ctx context.Context, config *Consumer
 		zap.Object("config", (*ZapConsumerConfig)(consumerConfig)),
 	)
 
-	_, err := m.js.CreateOrUpdateConsumer(ctx, config.Stream, *consumerConfig)
+	c, err := m.js.CreateOrUpdateConsumer(ctx, config.Stream, *consumerConfig)
 	if err != nil {
-		return "", errors.Wrap(err, "could not create consumer")
+		return nil, errors.Wrap(err, "could not create consumer")
 	}
-	return consumerConfig.Name, nil
+	return c.CachedInfo(), nil
 }
 
 // declareDurableConsumer creates a durable consumer. Durable consumers are
 // useful for long-running consumers that need to be able to resume event
 // processing.
-func (m *Manager) declareDurableConsumer(ctx context.Context, config *ConsumerConfig) (string, error) {
+func (m *Manager) declareDurableConsumer(ctx context.Context, config *ConsumerConfig) (*jetstream.ConsumerInfo, error) {
 	c, err := m.js.Consumer(ctx, config.Stream, config.Name)
 	if err != nil {
 		if errors.Is(err, jetstream.ErrConsumerNotFound) {
@@ -174,14 +386,14 @@ func (m *Manager) declareDurableConsumer(ctx context.Context, config *ConsumerCo
 
 			m.setConsumerSettings(consumerConfig, config, false)
 
-			_, err = m.js.CreateOrUpdateConsumer(ctx, config.Stream, *consumerConfig)
+			c, err = m.js.CreateOrUpdateConsumer(ctx, config.Stream, *consumerConfig)
 			if err != nil {
-				return "", errors.Wrap(err, "could not create consumer")
+				return nil, errors.Wrap(err, "could not create consumer")
 			}
-			return config.Name, nil
+			return c.CachedInfo(), nil
 		}
 
-		return "", errors.Wrap(err, "could not get consumer info")
+		return nil, errors.Wrap(err, "could not get consumer info")
 	}
 
 	// For updates certain fields can not be set, so we only set what we can
@@ -195,11 +407,11 @@ func (m *Manager) declareDurableConsumer(ctx context.Context, config *ConsumerCo
 		zap.String("name", config.Name),
 		zap.Object("config", (*ZapConsumerConfig)(&consumerConfig)),
 	)
-	_, err = m.js.CreateOrUpdateConsumer(ctx, config.Stream, consumerConfig)
+	c, err = m.js.CreateOrUpdateConsumer(ctx, config.Stream, consumerConfig)
 	if err != nil {
-		return "", errors.Wrap(err, "could not update consumer")
+		return nil, errors.Wrap(err, "could not update consumer")
 	}
-	return config.Name, nil
+	return c.CachedInfo(), nil
 }
 
 // setConsumerSettings sets the shared settings for both ephemeral and durable
@@ -220,11 +432,27 @@ func (m *Manager) setConsumerSettings(c *jetstream.ConsumerConfig, qc *ConsumerC
 		c.AckWait = 30 * time.Second
 	}
 
+	// The timeout is kept in the metadata as backoff replaces the ack wait
+	if c.Metadata == nil {
+		c.Metadata = make(map[string]string)
+	}
+	c.Metadata[timeoutMetadataKey] = c.AckWait.String()
+
 	// If the max delivery attempts is specified set it
 	if qc.MaxDeliveryAttempts > 0 {
 		c.MaxDeliver = int(qc.MaxDeliveryAttempts)
 	}
 
+	// Backoff replaces the ack wait for redeliveries
+	c.BackOff = qc.Backoff
+
+	// The dead-letter subject is kept in the metadata of the consumer
+	if qc.DeadLetterSubject != "" {
+		c.Metadata[deadLetterMetadataKey] = qc.DeadLetterSubject
+	} else {
+		delete(c.Metadata, deadLetterMetadataKey)
+	}
+
 	if !update {
 		// When creating a consumer we can specify where to start from
 		c.DeliverPolicy = jetstream.DeliverNewPolicy
@@ -242,6 +470,164 @@ func (m *Manager) setConsumerSettings(c *jetstream.ConsumerConfig, qc *ConsumerC
 	}
 }
 
+// validateDeadLetterSubject checks that the dead-letter subject of a consumer
+// does not copy failed events back into the consumer, either by being stored
+// in the stream of the consumer or by matching one of its subjects.
+func (m *Manager) validateDeadLetterSubject(ctx context.Context, config *ConsumerConfig) error {
+	for _, s := range config.Subjects {
+		if SubjectMatches(s, config.DeadLetterSubject) {
+			return newValidationError("dead-letter subject can not match the subjects of the consumer: " + config.DeadLetterSubject)
+		}
+	}
+
+	streamName, err := m.js.StreamNameBySubject(ctx, config.DeadLetterSubject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		// Unbound subjects are reported when events are dead-lettered
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "could not find dead-letter stream")
+	}
+
+	if streamName == config.Stream {
+		return newValidationError("dead-letter subject can not be bound to the stream of the consumer: " + config.DeadLetterSubject)
+	}
+
+	return nil
+}
+
+// validateBackoff checks that the backoff of a consumer can be used by
+// JetStream.
+func validateBackoff(config *ConsumerConfig) error {
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	for _, delay := range config.Backoff {
+		if delay < timeout {
+			return newValidationError("backoff delays must be at least the processing timeout of " + timeout.String())
+		}
+	}
+
+	if config.MaxDeliveryAttempts > 0 && uint(len(config.Backoff)) >= config.MaxDeliveryAttempts {
+		return newValidationError("backoff must have fewer delays than the maximum number of delivery attempts")
+	}
+
+	return nil
+}
+
+// ExponentialBackoff creates a backoff that starts at initial and is
+// multiplied by multiplier for every redelivery until maxDelay is reached. If
+// attempts is set the backoff is limited to fit within that number of
+// delivery attempts.
+func ExponentialBackoff(initial time.Duration, maxDelay time.Duration, multiplier float64, attempts uint) []time.Duration {
+	if initial <= 0 || multiplier < 1 {
+		return nil
+	}
+
+	limit := uint(maxExponentialBackoffDelays)
+	if attempts > 0 && attempts-1 < limit {
+		limit = attempts - 1
+	}
+
+	delays := make([]time.Duration, 0, limit)
+	delay := initial
+	for uint(len(delays)) < limit {
+		if maxDelay > 0 && delay >= maxDelay {
+			// The last delay is repeated by JetStream
+			delays = append(delays, maxDelay)
+			break
+		}
+
+		delays = append(delays, delay)
+		delay = time.Duration(float64(delay) * multiplier)
+	}
+
+	return delays
+}
+
+// consumerTimeout returns the timeout a consumer was configured with. Falls
+// back to the ack wait for consumers created without the timeout in their
+// metadata.
+func consumerTimeout(config *jetstream.ConsumerConfig) time.Duration {
+	timeout, err := time.ParseDuration(config.Metadata[timeoutMetadataKey])
+	if err != nil {
+		return config.AckWait
+	}
+
+	return timeout
+}
+
+// toConsumer converts the JetStream information about a consumer to a
+// Consumer.
+func toConsumer(info *jetstream.ConsumerInfo) *Consumer {
+	config := ConsumerConfig{
+		Stream:  info.Stream,
+		Timeout: consumerTimeout(&info.Config),
+	}
+
+	if info.Config.Durable != "" {
+		config.Name = info.Config.Durable
+	}
+
+	if len(info.Config.FilterSubjects) > 0 {
+		config.Subjects = info.Config.FilterSubjects
+	} else if info.Config.FilterSubject != "" {
+		config.Subjects = []string{info.Config.FilterSubject}
+	}
+
+	if info.Config.MaxDeliver > 0 {
+		config.MaxDeliveryAttempts = uint(info.Config.MaxDeliver)
+	}
+
+	config.DeadLetterSubject = info.Config.Metadata[deadLetterMetadataKey]
+
+	if len(info.Config.BackOff) > 0 {
+		config.Backoff = info.Config.BackOff
+	}
+
+	switch info.Config.DeliverPolicy {
+	case jetstream.DeliverAllPolicy:
+		config.From = &StreamPointer{
+			First: true,
+		}
+	case jetstream.DeliverByStartSequencePolicy:
+		config.From = &StreamPointer{
+			ID: info.Config.OptStartSeq,
+		}
+	case jetstream.DeliverByStartTimePolicy:
+		if info.Config.OptStartTime != nil {
+			config.From = &StreamPointer{
+				Time: *info.Config.OptStartTime,
+			}
+		}
+	case jetstream.DeliverNewPolicy,
+		jetstream.DeliverLastPolicy,
+		jetstream.DeliverLastPerSubjectPolicy:
+		// Consumers created by Windshift only use new for these cases
+	}
+
+	consumer := &Consumer{
+		ID:      info.Name,
+		Durable: info.Config.Durable != "",
+		Created: info.Created,
+		Config:  config,
+		State: ConsumerState{
+			Pending:         info.NumPending,
+			AckPending:      uint(info.NumAckPending),
+			Redelivered:     uint(info.NumRedelivered),
+			LastDeliveredID: info.Delivered.Stream,
+			AckFloorID:      info.AckFloor.Stream,
+		},
+	}
+
+	if info.Delivered.Last != nil {
+		consumer.State.LastDeliveredTime = *info.Delivered.Last
+	}
+
+	return consumer
+}
+
 // ZapConsumerConfig is a wrapper around jetstream.ConsumerConfig that
 // makes it loggable in a structured way.
 type ZapConsumerConfig jetstream.ConsumerConfig
@@ -267,6 +653,22 @@ func (c *ZapConsumerConfig) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddInt("maxDeliver", c.MaxDeliver)
 	}
 
+	if len(c.BackOff) > 0 {
+		err = enc.AddArray("backoff", zapcore.ArrayMarshalerFunc(func(enc zapcore.ArrayEncoder) error {
+			for _, delay := range c.BackOff {
+				enc.AppendDuration(delay)
+			}
+			return nil
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if subject := c.Metadata[deadLetterMetadataKey]; subject != "" {
+		enc.AddString("deadLetterSubject", subject)
+	}
+
 	switch c.DeliverPolicy {
 	case jetstream.DeliverAllPolicy:
 		enc.AddString("deliverPolicy", "all")
@@ -27,7 +27,12 @@ var _ = Describe("Consumers", func() {
 		manager, err = events.NewManager(
 			zaptest.NewLogger(GinkgoT()),
 			otel.Tracer("tests"),
+			natsConn,
 			js,
+			createSchemaManager(js),
+			&events.Config{
+				AckTokenKey: testAckTokenKey,
+			},
 		)
 		Expect(err).ToNot(HaveOccurred())
 	})
@@ -48,6 +53,30 @@ var _ = Describe("Consumers", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("consumers of internal streams can not be used", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   events.ScheduledStreamName,
+				Name:     "test",
+				Subjects: []string{"test"},
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+
+			_, err = manager.GetConsumer(ctx, events.AdvisoryStreamName, "windshift-dead-letters")
+			Expect(events.IsValidationError(err)).To(BeTrue())
+
+			_, err = manager.ListConsumers(ctx, &events.ListConsumersConfig{
+				Stream: events.ScheduledStreamName,
+				Limit:  10,
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+
+			_, err = manager.Events(ctx, &events.EventConsumeConfig{
+				Stream: events.AdvisoryStreamName,
+				Name:   "windshift-dead-letters",
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+
 		It("consumer with empty name fails", func(ctx context.Context) {
 			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
 				Stream:   "test",
@@ -383,4 +412,260 @@ var _ = Describe("Consumers", func() {
 			})
 		})
 	})
+
+	Describe("Information", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test", "other"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("ensure returns the consumer", func(ctx context.Context) {
+			consumer, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				Timeout:  time.Minute,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.ID).To(Equal("test"))
+			Expect(consumer.Durable).To(BeTrue())
+			Expect(consumer.Config.Subjects).To(Equal([]string{"test"}))
+			Expect(consumer.Config.Timeout).To(Equal(time.Minute))
+		})
+
+		It("can get a consumer", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				From: &events.StreamPointer{
+					First: true,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = js.Publish(ctx, "test", []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = js.Publish(ctx, "other", []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			consumer, err := manager.GetConsumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.ID).To(Equal("test"))
+			Expect(consumer.Config.Stream).To(Equal("test"))
+			Expect(consumer.Config.Timeout).To(Equal(30 * time.Second))
+			Expect(consumer.Config.From.First).To(BeTrue())
+			Expect(consumer.Created).ToNot(BeZero())
+			Expect(consumer.State.Pending).To(Equal(uint64(1)))
+			Expect(consumer.State.AckPending).To(Equal(uint(0)))
+			Expect(consumer.State.LastDeliveredID).To(Equal(uint64(0)))
+		})
+
+		It("tracks delivered and pending events", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				From: &events.StreamPointer{
+					First: true,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				_, err = js.Publish(ctx, "test", []byte("data"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			c, err := js.Consumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			batch, err := c.Fetch(1)
+			Expect(err).ToNot(HaveOccurred())
+			// Receive one event but leave it unacknowledged
+			Eventually(batch.Messages()).Should(Receive())
+
+			consumer, err := manager.GetConsumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.State.Pending).To(Equal(uint64(2)))
+			Expect(consumer.State.AckPending).To(Equal(uint(1)))
+			Expect(consumer.State.LastDeliveredID).To(Equal(uint64(1)))
+			Expect(consumer.State.LastDeliveredTime).ToNot(BeZero())
+			Expect(consumer.State.AckFloorID).To(Equal(uint64(0)))
+		})
+
+		It("getting a missing consumer returns not found", func(ctx context.Context) {
+			_, err := manager.GetConsumer(ctx, "test", "missing")
+			Expect(err).To(MatchError(events.ErrConsumerNotFound))
+		})
+
+		It("getting a consumer of a missing stream returns not found", func(ctx context.Context) {
+			_, err := manager.GetConsumer(ctx, "missing", "test")
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+
+		It("can list consumers in pages", func(ctx context.Context) {
+			for _, name := range []string{"c", "a", "b"} {
+				_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+					Stream:   "test",
+					Name:     name,
+					Subjects: []string{"test"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			page1, err := manager.ListConsumers(ctx, &events.ListConsumersConfig{
+				Stream: "test",
+				Limit:  2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page1.HasMore).To(BeTrue())
+			Expect(page1.Consumers).To(HaveLen(2))
+			Expect(page1.Consumers[0].ID).To(Equal("a"))
+			Expect(page1.Consumers[1].ID).To(Equal("b"))
+
+			page2, err := manager.ListConsumers(ctx, &events.ListConsumersConfig{
+				Stream: "test",
+				After:  page1.Consumers[1].ID,
+				Limit:  2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page2.HasMore).To(BeFalse())
+			Expect(page2.Consumers).To(HaveLen(1))
+			Expect(page2.Consumers[0].ID).To(Equal("c"))
+		})
+
+		It("listing consumers of a missing stream returns not found", func(ctx context.Context) {
+			_, err := manager.ListConsumers(ctx, &events.ListConsumersConfig{
+				Stream: "missing",
+				Limit:  10,
+			})
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+	})
+
+	Describe("Redelivery", func() {
+		BeforeEach(func(ctx context.Context) {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can set max delivery attempts", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:              "test",
+				Name:                "test",
+				Subjects:            []string{"test"},
+				MaxDeliveryAttempts: 5,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			c, err := js.Consumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.CachedInfo().Config.MaxDeliver).To(Equal(5))
+		})
+
+		It("can set backoff", func(ctx context.Context) {
+			consumer, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:              "test",
+				Name:                "test",
+				Subjects:            []string{"test"},
+				Timeout:             time.Second,
+				MaxDeliveryAttempts: 4,
+				Backoff:             []time.Duration{time.Second, 5 * time.Second, 10 * time.Second},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.Config.Backoff).To(Equal([]time.Duration{time.Second, 5 * time.Second, 10 * time.Second}))
+
+			c, err := js.Consumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.CachedInfo().Config.BackOff).To(HaveLen(3))
+		})
+
+		It("reports the timeout when backoff is set", func(ctx context.Context) {
+			consumer, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				Timeout:  time.Second,
+				Backoff:  []time.Duration{5 * time.Second, 10 * time.Second},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.Config.Timeout).To(Equal(time.Second))
+
+			consumer, err = manager.GetConsumer(ctx, "test", "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.Config.Timeout).To(Equal(time.Second))
+			Expect(consumer.Config.Backoff).To(Equal([]time.Duration{5 * time.Second, 10 * time.Second}))
+		})
+
+		It("can remove backoff", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				Timeout:  time.Second,
+				Backoff:  []time.Duration{time.Second, 5 * time.Second},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			consumer, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				Timeout:  time.Second,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(consumer.Config.Backoff).To(BeEmpty())
+		})
+
+		It("backoff shorter than timeout fails", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "test",
+				Name:     "test",
+				Subjects: []string{"test"},
+				Backoff:  []time.Duration{time.Second},
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("backoff with too many delays for max delivery attempts fails", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:              "test",
+				Name:                "test",
+				Subjects:            []string{"test"},
+				Timeout:             time.Second,
+				MaxDeliveryAttempts: 2,
+				Backoff:             []time.Duration{time.Second, 2 * time.Second},
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Exponential backoff", func() {
+		It("grows until max delay", func() {
+			Expect(events.ExponentialBackoff(time.Second, 10*time.Second, 2, 0)).To(Equal([]time.Duration{
+				time.Second,
+				2 * time.Second,
+				4 * time.Second,
+				8 * time.Second,
+				10 * time.Second,
+			}))
+		})
+
+		It("is limited by delivery attempts", func() {
+			Expect(events.ExponentialBackoff(time.Second, time.Minute, 2, 3)).To(Equal([]time.Duration{
+				time.Second,
+				2 * time.Second,
+			}))
+		})
+
+		It("is limited without max delay", func() {
+			Expect(events.ExponentialBackoff(time.Second, 0, 1, 0)).To(HaveLen(32))
+		})
+	})
 })
@@ -0,0 +1,666 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// AdvisoryStreamName is the name of the stream used to capture JetStream
+// advisories about events that failed processing.
+const AdvisoryStreamName = "windshift-advisories"
+
+const (
+	// maxDeliveriesAdvisoryPrefix is the subject prefix of advisories sent
+	// when an event has reached the maximum number of deliveries.
+	maxDeliveriesAdvisoryPrefix = "$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES."
+	// terminatedAdvisoryPrefix is the subject prefix of advisories sent when
+	// an event has been permanently rejected.
+	terminatedAdvisoryPrefix = "$JS.EVENT.ADVISORY.CONSUMER.MSG_TERMINATED."
+	// deadLetterConsumerName is the durable consumer shared by all server
+	// instances to process advisories.
+	deadLetterConsumerName = "windshift-dead-letters"
+	// deadLetterMetadataKey is the key in the consumer metadata that holds
+	// the dead-letter subject of a consumer.
+	deadLetterMetadataKey = "windshift-dead-letter-subject"
+)
+
+const (
+	headerDeadLetterSubject    = "WS-Dead-Letter-Subject"
+	headerDeadLetterStream     = "WS-Dead-Letter-Stream"
+	headerDeadLetterConsumer   = "WS-Dead-Letter-Consumer"
+	headerDeadLetterStreamSeq  = "WS-Dead-Letter-Stream-Seq"
+	headerDeadLetterDeliveries = "WS-Dead-Letter-Deliveries"
+	headerDeadLetterReason     = "WS-Dead-Letter-Reason"
+	headerDeadLetterTime       = "WS-Dead-Letter-Time"
+
+	headerDeadLetterFailureReason  = "WS-Dead-Letter-Failure-Reason"
+	headerDeadLetterFailureCode    = "WS-Dead-Letter-Failure-Code"
+	headerDeadLetterFailureDetails = "WS-Dead-Letter-Failure-Details"
+)
+
+// DeadLetterReason is the reason an event was dead-lettered.
+type DeadLetterReason string
+
+const (
+	// DeadLetterReasonMaxDeliveries is used when an event was delivered the
+	// maximum number of times without being acknowledged.
+	DeadLetterReasonMaxDeliveries DeadLetterReason = "max_deliveries"
+	// DeadLetterReasonRejected is used when an event was permanently
+	// rejected.
+	DeadLetterReasonRejected DeadLetterReason = "rejected"
+)
+
+// DeadLetter is a copy of an event that failed processing.
+type DeadLetter struct {
+	// ID is the sequence number of the copy in the dead-letter stream. Used
+	// to requeue the event.
+	ID uint64
+	// Subject is the subject the event was originally published to.
+	Subject string
+	// Stream is the stream the event was originally stored in.
+	Stream string
+	// Consumer is the consumer that failed to process the event.
+	Consumer string
+	// StreamSeq is the sequence number of the event in the original stream.
+	StreamSeq uint64
+	// Deliveries is the number of times the event was delivered.
+	Deliveries uint64
+	// Reason is why the event was dead-lettered.
+	Reason DeadLetterReason
+	// Timestamp is when the event was dead-lettered.
+	Timestamp time.Time
+	// Headers contains the headers of the original event.
+	Headers *Headers
+	// Data is the protobuf message published by the producer.
+	Data *anypb.Any
+	// Failure is the last failure logged for the event before it was
+	// dead-lettered. Nil if no failure was logged.
+	Failure *Failure
+}
+
+// ListDeadLettersConfig is the configuration for listing dead-lettered events.
+type ListDeadLettersConfig struct {
+	// Stream the consumer belongs to.
+	Stream string
+	// Consumer to list dead-lettered events for.
+	Consumer string
+	// After is the ID of the dead letter to list dead letters after, used for
+	// paging.
+	After uint64
+	// Limit is the maximum number of dead letters to return.
+	Limit uint
+}
+
+// DeadLetterList is a page of dead-lettered events.
+type DeadLetterList struct {
+	// DeadLetters in the page, ordered by ID.
+	DeadLetters []*DeadLetter
+	// HasMore indicates if there are more dead letters after this page.
+	HasMore bool
+}
+
+// advisory contains the fields shared by the JetStream advisories used for
+// dead-lettering.
+type advisory struct {
+	Stream     string `json:"stream"`
+	Consumer   string `json:"consumer"`
+	StreamSeq  uint64 `json:"stream_seq"`
+	Deliveries uint64 `json:"deliveries"`
+}
+
+// ensureAdvisoryStream makes sure that the stream capturing advisories
+// exists. Advisories are only captured once the stream exists, so this is
+// done both on startup and when a consumer with a dead-letter subject is
+// created.
+func (m *Manager) ensureAdvisoryStream(ctx context.Context) error {
+	m.advisoryStreamMu.Lock()
+	defer m.advisoryStreamMu.Unlock()
+
+	if m.advisoryStreamReady {
+		return nil
+	}
+
+	_, err := m.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        AdvisoryStreamName,
+		Description: "Advisories about events that failed processing",
+		Subjects: []string{
+			maxDeliveriesAdvisoryPrefix + ">",
+			terminatedAdvisoryPrefix + ">",
+		},
+		Retention: jetstream.WorkQueuePolicy,
+		MaxAge:    7 * 24 * time.Hour,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create advisory stream")
+	}
+
+	m.advisoryStreamReady = true
+	return nil
+}
+
+// StartDeadLetterProcessing starts copying events that fail processing to
+// the dead-letter subject of their consumer. The processing is shared
+// between all server instances. The returned function stops processing.
+func (m *Manager) StartDeadLetterProcessing(ctx context.Context) (func(), error) {
+	err := m.ensureAdvisoryStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := m.js.CreateOrUpdateConsumer(ctx, AdvisoryStreamName, jetstream.ConsumerConfig{
+		Durable:    deadLetterConsumerName,
+		AckPolicy:  jetstream.AckExplicitPolicy,
+		AckWait:    30 * time.Second,
+		MaxDeliver: 10,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create dead-letter consumer")
+	}
+
+	// Processing outlives the context used to start it
+	processCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		err2 := m.handleAdvisory(processCtx, msg)
+		if err2 != nil {
+			m.logger.Warn("Could not dead-letter event", zap.String("advisory", msg.Subject()), zap.Error(err2))
+			_ = msg.NakWithDelay(10 * time.Second)
+			return
+		}
+
+		_ = msg.Ack()
+	})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not consume advisories")
+	}
+
+	return func() {
+		consumeCtx.Stop()
+		cancel()
+	}, nil
+}
+
+// handleAdvisory copies the event an advisory is about to the dead-letter
+// subject of the consumer, if one is configured.
+func (m *Manager) handleAdvisory(ctx context.Context, msg jetstream.Msg) error {
+	var reason DeadLetterReason
+	switch {
+	case strings.HasPrefix(msg.Subject(), maxDeliveriesAdvisoryPrefix):
+		reason = DeadLetterReasonMaxDeliveries
+	case strings.HasPrefix(msg.Subject(), terminatedAdvisoryPrefix):
+		reason = DeadLetterReasonRejected
+	default:
+		return nil
+	}
+
+	var data advisory
+	err := json.Unmarshal(msg.Data(), &data)
+	if err != nil {
+		// Not something that will succeed if retried
+		m.logger.Warn("Could not decode advisory", zap.String("subject", msg.Subject()), zap.Error(err))
+		return nil
+	}
+
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.DeadLetter",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", data.Stream),
+			attribute.String("name", data.Consumer),
+			attribute.String("reason", string(reason)),
+		),
+	)
+	defer span.End()
+
+	consumer, err := m.js.Consumer(ctx, data.Stream, data.Consumer)
+	if errors.Is(err, jetstream.ErrConsumerNotFound) || errors.Is(err, jetstream.ErrStreamNotFound) {
+		// Consumer has been removed, nothing to dead-letter to
+		return nil
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get consumer")
+		return errors.Wrap(err, "could not get consumer info")
+	}
+
+	subject := consumer.CachedInfo().Config.Metadata[deadLetterMetadataKey]
+	if subject == "" {
+		// No dead-letter subject configured for this consumer
+		return nil
+	}
+
+	stream, err := m.js.Stream(ctx, data.Stream)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return errors.Wrap(err, "could not get stream info")
+	}
+
+	original, err := stream.GetMsg(ctx, data.StreamSeq)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		m.logger.Warn(
+			"Event removed before it could be dead-lettered",
+			zap.String("stream", data.Stream),
+			zap.String("consumer", data.Consumer),
+			zap.Uint64("streamSeq", data.StreamSeq),
+		)
+		return nil
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get event")
+		return errors.Wrap(err, "could not get event")
+	}
+
+	copied := &nats.Msg{
+		Subject: subject,
+		Header:  nats.Header{},
+		Data:    original.Data,
+	}
+
+	for key, values := range original.Header {
+		if isPublishHeader(key) {
+			continue
+		}
+
+		copied.Header[key] = values
+	}
+
+	copied.Header.Set(headerDeadLetterSubject, original.Subject)
+	copied.Header.Set(headerDeadLetterStream, data.Stream)
+	copied.Header.Set(headerDeadLetterConsumer, data.Consumer)
+	copied.Header.Set(headerDeadLetterStreamSeq, strconv.FormatUint(data.StreamSeq, 10))
+	copied.Header.Set(headerDeadLetterDeliveries, strconv.FormatUint(data.Deliveries, 10))
+	copied.Header.Set(headerDeadLetterReason, string(reason))
+	copied.Header.Set(headerDeadLetterTime, time.Now().Format(time.RFC3339Nano))
+
+	failure, err := m.lastFailure(ctx, data.Stream, data.Consumer, data.StreamSeq)
+	if err == nil {
+		err = setFailureHeaders(copied.Header, failure)
+	}
+
+	if err != nil && !errors.Is(err, jetstream.ErrMsgNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get failure")
+		return err
+	}
+
+	// The same event can only be dead-lettered once per consumer
+	copied.Header.Set("Nats-Msg-Id", fmt.Sprintf("%s:%s:%d", data.Stream, data.Consumer, data.StreamSeq))
+
+	m.logger.Debug(
+		"Dead-lettering event",
+		zap.String("stream", data.Stream),
+		zap.String("consumer", data.Consumer),
+		zap.Uint64("streamSeq", data.StreamSeq),
+		zap.String("subject", subject),
+	)
+
+	// The dead letter shares offloaded data with the original event, which
+	// must be kept while the dead letter is published
+	pending, err := m.markPayloadPending(ctx, copied.Header, subject)
+	if errors.Is(err, ErrPayloadNotFound) {
+		// Keep the failure even though its data is gone
+		m.logger.Warn("Dead-lettering event without data", zap.Uint64("streamSeq", data.StreamSeq), zap.Error(err))
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to reference data")
+		return err
+	}
+
+	ack, err := m.js.PublishMsg(ctx, copied)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to publish dead letter")
+		return errors.Wrap(err, "could not publish dead letter")
+	}
+
+	err = m.addPayloadReference(ctx, copied.Header, pending, ack.Stream, ack.Sequence)
+	if err != nil {
+		// The pending reference keeps the data until the cleanup finds the
+		// dead letter
+		m.logger.Warn("Could not reference data of dead letter", zap.Uint64("id", ack.Sequence), zap.Error(err))
+		span.RecordError(err)
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// ListDeadLetters returns the events that failed processing for a consumer.
+func (m *Manager) ListDeadLetters(ctx context.Context, config *ListDeadLettersConfig) (*DeadLetterList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ListDeadLetters",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", config.Stream),
+			attribute.String("name", config.Consumer),
+		),
+	)
+	defer span.End()
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	subject, deadLetterStream, err := m.deadLetterLocation(ctx, config.Stream, config.Consumer)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to find dead letters")
+		return nil, err
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, deadLetterStream, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:    config.After + 1,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to create consumer")
+		return nil, errors.Wrap(err, "could not create dead-letter consumer")
+	}
+
+	result := &DeadLetterList{
+		DeadLetters: make([]*DeadLetter, 0),
+	}
+	for {
+		batch, err := consumer.FetchNoWait(100)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to fetch dead letters")
+			return nil, errors.Wrap(err, "could not fetch dead letters")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			metadata, err := msg.Metadata()
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read dead letter")
+				return nil, errors.Wrap(err, "could not get dead letter metadata")
+			}
+
+			caughtUp = metadata.NumPending == 0
+
+			headers := msg.Headers()
+			if headers.Get(headerDeadLetterStream) != config.Stream ||
+				headers.Get(headerDeadLetterConsumer) != config.Consumer {
+				// Dead-letter subjects may be shared between consumers
+				continue
+			}
+
+			if uint(len(result.DeadLetters)) >= config.Limit {
+				result.HasMore = true
+				span.SetStatus(codes.Ok, "")
+				return result, nil
+			}
+
+			deadLetter, err := toDeadLetter(metadata.Sequence.Stream, msg.Headers(), msg.Data())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read dead letter")
+				return nil, err
+			}
+
+			deadLetter.Data.Value, err = m.loadPayload(ctx, msg.Headers(), deadLetter.Data.Value)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to load dead letter data")
+				return nil, err
+			}
+
+			result.DeadLetters = append(result.DeadLetters, deadLetter)
+		}
+
+		if batch.Error() != nil {
+			span.RecordError(batch.Error())
+			span.SetStatus(codes.Error, "failed to fetch dead letters")
+			return nil, errors.Wrap(batch.Error(), "could not fetch dead letters")
+		}
+
+		if received == 0 || caughtUp {
+			span.SetStatus(codes.Ok, "")
+			return result, nil
+		}
+	}
+}
+
+// RequeueDeadLetter publishes a dead-lettered event to its original subject
+// again and removes it from the dead-letter stream. All consumers matching
+// the subject will receive the event again.
+func (m *Manager) RequeueDeadLetter(ctx context.Context, stream string, consumer string, id uint64) (*PublishedEvent, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.RequeueDeadLetter",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", stream),
+			attribute.String("name", consumer),
+		),
+	)
+	defer span.End()
+
+	_, deadLetterStreamName, err := m.deadLetterLocation(ctx, stream, consumer)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to find dead letters")
+		return nil, err
+	}
+
+	deadLetterStream, err := m.js.Stream(ctx, deadLetterStreamName)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get dead-letter stream")
+	}
+
+	msg, err := deadLetterStream.GetMsg(ctx, id)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		span.SetStatus(codes.Error, "dead letter not found")
+		return nil, errors.WithStack(ErrDeadLetterNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get dead letter")
+		return nil, errors.Wrap(err, "could not get dead letter")
+	}
+
+	if msg.Header.Get(headerDeadLetterStream) != stream ||
+		msg.Header.Get(headerDeadLetterConsumer) != consumer {
+		span.SetStatus(codes.Error, "dead letter not found")
+		return nil, errors.WithStack(ErrDeadLetterNotFound)
+	}
+
+	requeued := &nats.Msg{
+		Subject: msg.Header.Get(headerDeadLetterSubject),
+		Header:  nats.Header{},
+		Data:    msg.Data,
+	}
+
+	// Copy the original headers, skipping the dead-letter information
+	for key, values := range msg.Header {
+		if strings.HasPrefix(key, "WS-Dead-Letter-") || isPublishHeader(key) {
+			continue
+		}
+
+		requeued.Header[key] = values
+	}
+
+	pending, err := m.markPayloadPending(ctx, requeued.Header, requeued.Subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to reference data")
+		return nil, err
+	}
+
+	ack, err := m.js.PublishMsg(ctx, requeued)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to requeue event")
+		return nil, errors.Wrap(err, "could not requeue event")
+	}
+
+	err = m.addPayloadReference(ctx, requeued.Header, pending, ack.Stream, ack.Sequence)
+	if err != nil {
+		// Keep the dead letter, as it references the data of the event
+		m.logger.Warn("Could not reference data of requeued event, keeping dead letter", zap.Uint64("id", id), zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Ok, "")
+		return &PublishedEvent{
+			ID: ack.Sequence,
+		}, nil
+	}
+
+	err = deadLetterStream.DeleteMsg(ctx, id)
+	if err != nil && !errors.Is(err, jetstream.ErrMsgNotFound) {
+		// The event has been requeued so this is not returned as an error
+		m.logger.Warn("Could not remove requeued dead letter", zap.Uint64("id", id), zap.Error(err))
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return &PublishedEvent{
+		ID: ack.Sequence,
+	}, nil
+}
+
+// deadLetterLocation returns the dead-letter subject of a consumer and the
+// stream that the subject is bound to.
+func (m *Manager) deadLetterLocation(ctx context.Context, stream string, consumer string) (string, string, error) {
+	err := validateStreamName(stream)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !IsValidConsumerName(consumer) {
+		return "", "", newValidationError("invalid consumer id: " + consumer)
+	}
+
+	c, err := m.js.Consumer(ctx, stream, consumer)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return "", "", errors.WithStack(ErrStreamNotFound)
+	} else if errors.Is(err, jetstream.ErrConsumerNotFound) {
+		return "", "", errors.WithStack(ErrConsumerNotFound)
+	} else if err != nil {
+		return "", "", errors.Wrap(err, "could not get consumer info")
+	}
+
+	subject := c.CachedInfo().Config.Metadata[deadLetterMetadataKey]
+	if subject == "" {
+		return "", "", newValidationError("consumer has no dead-letter subject: " + consumer)
+	}
+
+	streamName, err := m.js.StreamNameBySubject(ctx, subject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return "", "", errors.WithStack(ErrUnboundSubject)
+	} else if err != nil {
+		return "", "", errors.Wrap(err, "could not find dead-letter stream")
+	}
+
+	return subject, streamName, nil
+}
+
+func toDeadLetter(id uint64, headers nats.Header, data []byte) (*DeadLetter, error) {
+	deadLetter := &DeadLetter{
+		ID:       id,
+		Subject:  headers.Get(headerDeadLetterSubject),
+		Stream:   headers.Get(headerDeadLetterStream),
+		Consumer: headers.Get(headerDeadLetterConsumer),
+		Reason:   DeadLetterReason(headers.Get(headerDeadLetterReason)),
+		Headers: &Headers{
+			Custom: getCustomHeaders(headers),
+		},
+		Data: &anypb.Any{
+			TypeUrl: "type.googleapis.com/" + headers.Get("WS-Data-Type"),
+			Value:   data,
+		},
+	}
+
+	var err error
+	deadLetter.StreamSeq, err = strconv.ParseUint(headers.Get(headerDeadLetterStreamSeq), 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse dead letter stream sequence")
+	}
+
+	deadLetter.Deliveries, err = strconv.ParseUint(headers.Get(headerDeadLetterDeliveries), 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse dead letter deliveries")
+	}
+
+	deadLetter.Timestamp, err = time.Parse(time.RFC3339Nano, headers.Get(headerDeadLetterTime))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse dead letter time")
+	}
+
+	if headers.Get(headerDeadLetterFailureReason) != "" {
+		deadLetter.Failure = &Failure{
+			Reason: headers.Get(headerDeadLetterFailureReason),
+			Code:   headers.Get(headerDeadLetterFailureCode),
+		}
+
+		details := headers.Get(headerDeadLetterFailureDetails)
+		if details != "" {
+			err = json.Unmarshal([]byte(details), &deadLetter.Failure.Details)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not parse dead letter failure details")
+			}
+		}
+	}
+
+	publishTimeHeader := headers.Get("WS-Published-Time")
+	if publishTimeHeader != "" {
+		deadLetter.Headers.PublishedAt, err = time.Parse(time.RFC3339Nano, publishTimeHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse header")
+		}
+	}
+
+	traceParentHeader := headers.Get("WS-Trace-Parent")
+	if traceParentHeader != "" {
+		deadLetter.Headers.TraceParent = &traceParentHeader
+	}
+
+	traceStateHeader := headers.Get("WS-Trace-State")
+	if traceStateHeader != "" {
+		deadLetter.Headers.TraceState = &traceStateHeader
+	}
+
+	return deadLetter, nil
+}
+
+// setFailureHeaders stores a failure in the headers of a dead letter.
+func setFailureHeaders(headers nats.Header, failure *Failure) error {
+	headers.Set(headerDeadLetterFailureReason, failure.Reason)
+	if failure.Code != "" {
+		headers.Set(headerDeadLetterFailureCode, failure.Code)
+	}
+
+	if len(failure.Details) > 0 {
+		details, err := json.Marshal(failure.Details)
+		if err != nil {
+			return errors.Wrap(err, "could not encode failure details")
+		}
+
+		headers.Set(headerDeadLetterFailureDetails, string(details))
+	}
+
+	return nil
+}
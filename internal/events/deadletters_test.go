@@ -0,0 +1,323 @@
+package events_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Dead letters", func() {
+	var manager *events.Manager
+	var js jetstream.JetStream
+
+	BeforeEach(func(ctx context.Context) {
+		manager, js = createManagerAndJetStream()
+
+		stop, err := manager.StartDeadLetterProcessing(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		_, err = manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "dead-letters",
+			Subjects: []string{"dead-letters.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	consume := func(ctx context.Context, config *events.ConsumerConfig) *events.Events {
+		_, err := manager.EnsureConsumer(ctx, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		ec, err := manager.Events(ctx, &events.EventConsumeConfig{
+			Stream: config.Stream,
+			Name:   config.Name,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+		return ec
+	}
+
+	publish := func(ctx context.Context, subject string) {
+		_, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject: subject,
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	listDeadLetters := func(ctx context.Context) []*events.DeadLetter {
+		list, err := manager.ListDeadLetters(ctx, &events.ListDeadLettersConfig{
+			Stream:   "events",
+			Consumer: "test",
+			Limit:    100,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return list.DeadLetters
+	}
+
+	It("invalid dead-letter subject fails", func(ctx context.Context) {
+		_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.>",
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("dead-letter subject matching the consumer fails", func(ctx context.Context) {
+		_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "events.failed",
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("dead-letter subject in the stream of the consumer fails", func(ctx context.Context) {
+		_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.orders.>"},
+			DeadLetterSubject: "events.failed",
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("consumer returns dead-letter subject", func(ctx context.Context) {
+		consumer, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumer.Config.DeadLetterSubject).To(Equal("dead-letters.test"))
+	})
+
+	It("permanently rejected events are dead-lettered", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(1))
+
+		deadLetter := listDeadLetters(ctx)[0]
+		Expect(deadLetter.Subject).To(Equal("events.test"))
+		Expect(deadLetter.Stream).To(Equal("events"))
+		Expect(deadLetter.Consumer).To(Equal("test"))
+		Expect(deadLetter.StreamSeq).To(Equal(uint64(1)))
+		Expect(deadLetter.Deliveries).To(Equal(uint64(1)))
+		Expect(deadLetter.Reason).To(Equal(events.DeadLetterReasonRejected))
+		Expect(deadLetter.Data.MessageName()).To(BeEquivalentTo("google.protobuf.Empty"))
+	})
+
+	It("dead letters include the failure of the event", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		event.SetFailure(&events.Failure{
+			Reason: "invalid order",
+			Code:   "INVALID",
+			Details: map[string]string{
+				"field": "amount",
+			},
+		})
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(1))
+
+		deadLetter := listDeadLetters(ctx)[0]
+		Expect(deadLetter.Failure).ToNot(BeNil())
+		Expect(deadLetter.Failure.Reason).To(Equal("invalid order"))
+		Expect(deadLetter.Failure.Code).To(Equal("INVALID"))
+		Expect(deadLetter.Failure.Details).To(HaveKeyWithValue("field", "amount"))
+	})
+
+	It("events reaching max deliveries are dead-lettered", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:              "events",
+			Name:                "test",
+			Subjects:            []string{"events.>"},
+			MaxDeliveryAttempts: 1,
+			DeadLetterSubject:   "dead-letters.test",
+		})
+
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		Expect(event.Reject()).To(Succeed())
+
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(1))
+
+		deadLetter := listDeadLetters(ctx)[0]
+		Expect(deadLetter.Reason).To(Equal(events.DeadLetterReasonMaxDeliveries))
+		Expect(deadLetter.Deliveries).To(Equal(uint64(1)))
+	})
+
+	It("events are not dead-lettered without a subject", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		Consistently(func() uint64 {
+			stream, err := js.Stream(ctx, "dead-letters")
+			Expect(err).ToNot(HaveOccurred())
+			info, err := stream.Info(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			return info.State.Msgs
+		}, 200*time.Millisecond).Should(BeZero())
+
+		_, err := manager.ListDeadLetters(ctx, &events.ListDeadLettersConfig{
+			Stream:   "events",
+			Consumer: "test",
+			Limit:    100,
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("can page through dead letters", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+
+		for i := 0; i < 3; i++ {
+			publish(ctx, "events.test")
+
+			event := <-ec.Incoming()
+			Expect(event.RejectPermanently()).To(Succeed())
+		}
+
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(3))
+
+		page1, err := manager.ListDeadLetters(ctx, &events.ListDeadLettersConfig{
+			Stream:   "events",
+			Consumer: "test",
+			Limit:    2,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page1.DeadLetters).To(HaveLen(2))
+		Expect(page1.HasMore).To(BeTrue())
+
+		page2, err := manager.ListDeadLetters(ctx, &events.ListDeadLettersConfig{
+			Stream:   "events",
+			Consumer: "test",
+			After:    page1.DeadLetters[1].ID,
+			Limit:    2,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page2.DeadLetters).To(HaveLen(1))
+		Expect(page2.HasMore).To(BeFalse())
+	})
+
+	It("can requeue dead letters", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(1))
+		deadLetter := listDeadLetters(ctx)[0]
+
+		_, err := manager.RequeueDeadLetter(ctx, "events", "test", deadLetter.ID)
+		Expect(err).ToNot(HaveOccurred())
+
+		select {
+		case event := <-ec.Incoming():
+			Expect(event.Subject).To(Equal("events.test"))
+			Expect(event.Ack()).To(Succeed())
+		case <-time.After(time.Second):
+			Fail("requeued event not received")
+		}
+
+		Expect(listDeadLetters(ctx)).To(BeEmpty())
+
+		_, err = manager.RequeueDeadLetter(ctx, "events", "test", deadLetter.ID)
+		Expect(err).To(MatchError(events.ErrDeadLetterNotFound))
+	})
+
+	It("can dead-letter and requeue events published with an expected sequence", func(ctx context.Context) {
+		ec := consume(ctx, &events.ConsumerConfig{
+			Stream:            "events",
+			Name:              "test",
+			Subjects:          []string{"events.>"},
+			DeadLetterSubject: "dead-letters.test",
+		})
+
+		publish(ctx, "events.test")
+		seq := uint64(1)
+		_, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject:            "events.test",
+			Data:               Data(&emptypb.Empty{}),
+			ExpectedSubjectSeq: &seq,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		event := <-ec.Incoming()
+		Expect(event.Ack()).To(Succeed())
+		event = <-ec.Incoming()
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		// The expected sequence does not match the dead-letter subject, so
+		// it must not be copied to the dead letter
+		Eventually(listDeadLetters).WithContext(ctx).Should(HaveLen(1))
+		deadLetter := listDeadLetters(ctx)[0]
+		Expect(deadLetter.StreamSeq).To(Equal(uint64(2)))
+
+		_, err = manager.RequeueDeadLetter(ctx, "events", "test", deadLetter.ID)
+		Expect(err).ToNot(HaveOccurred())
+
+		select {
+		case event := <-ec.Incoming():
+			Expect(event.Subject).To(Equal("events.test"))
+			Expect(event.Ack()).To(Succeed())
+		case <-time.After(time.Second):
+			Fail("requeued event not received")
+		}
+	})
+})
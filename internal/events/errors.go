@@ -12,6 +12,34 @@ var ErrUnboundSubject = errors.New("unbound subject, no stream found for subject
 // actual sequence number.
 var ErrWrongSequence = errors.New("wrong sequence")
 
+// ErrStreamNotFound is used when a stream does not exist.
+var ErrStreamNotFound = errors.New("stream not found")
+
+// ErrConsumerNotFound is used when a consumer does not exist.
+var ErrConsumerNotFound = errors.New("consumer not found")
+
+// ErrDeadLetterNotFound is used when a dead-lettered event does not exist.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// ErrScheduledEventNotFound is used when a scheduled event does not exist,
+// such as when it has already been published.
+var ErrScheduledEventNotFound = errors.New("scheduled event not found")
+
+// ErrEventNotFound is used when an event does not exist in a stream, such as
+// when it has been removed by the retention of the stream.
+var ErrEventNotFound = errors.New("event not found")
+
+// ErrPayloadNotFound is used when the data of an event has been offloaded,
+// but the object holding it no longer exists.
+var ErrPayloadNotFound = errors.New("offloaded payload not found")
+
+// ErrInvalidAckToken is used when an ack token can not be decoded.
+var ErrInvalidAckToken = errors.New("invalid ack token")
+
+// ErrInvalidData is used when the data of an event is not allowed by the
+// schemas bound to its subject.
+var ErrInvalidData = errors.New("invalid data")
+
 type validationError struct {
 	err string
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/levelfourab/windshift-server/internal/events/flowcontrol"
 
 	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -25,17 +26,25 @@ type Headers struct {
 	TraceParent *string
 	// TraceState is the trace state of the event. May be empty.
 	TraceState *string
+	// Custom contains the custom headers set by the producer. May be nil.
+	Custom map[string]string
 }
 
+// failureRecorder logs the failure of a delivery of an event in a stream.
+type failureRecorder func(ctx context.Context, streamSeq uint64, deliveryAttempt uint64, permanent bool, failure *Failure) error
+
 // Event represents a single event consumed from a stream. It is received via
 // NATS and should be processed within a certain deadline, using Ack() or
 // Reject(shouldRetry) to acknowledge the event. If the deadline is exceeded,
 // the event will be redelivered. To extend the deadline, use Ping().
 type Event struct {
-	span      trace.Span
-	logger    *zap.Logger
-	msg       jetstream.Msg
-	onProcess func(flowcontrol.ProcessType)
+	span          trace.Span
+	logger        *zap.Logger
+	msg           jetstream.Msg
+	backoff       []time.Duration
+	failure       *Failure
+	recordFailure failureRecorder
+	onProcess     func(flowcontrol.ProcessType)
 
 	// Context is the context of this event. It will be valid until the event
 	// expires, is acknowledged or rejected.
@@ -44,7 +53,8 @@ type Event struct {
 	// Subject is the subject the event was published to.
 	Subject string
 
-	// ConsumerSeq is the sequence number of the event.
+	// ConsumerSeq is the sequence number of the delivery of the event by the
+	// consumer.
 	ConsumerSeq uint64
 
 	// StreamSeq is the sequence number of the event in the event stream. Can
@@ -57,6 +67,11 @@ type Event struct {
 	// a consumer. The first delivery is 1.
 	DeliveryAttempt uint64
 
+	// AckToken identifies this delivery of the event, and can be used with
+	// Manager.AckEvent, Manager.RejectEvent and Manager.PingEvent from any
+	// connection.
+	AckToken string
+
 	// Headers contains the headers of the event.
 	Headers *Headers
 
@@ -70,13 +85,47 @@ func newEvent(
 	logger *zap.Logger,
 	msg jetstream.Msg,
 	md *jetstream.MsgMetadata,
+	ackToken string,
+	backoff []time.Duration,
+	recordFailure failureRecorder,
 	onProcess func(flowcontrol.ProcessType),
 ) (*Event, error) {
-	headers := &Headers{
-		PublishedAt: md.Timestamp,
+	natsHeaders := msg.Headers()
+	headers, err := toHeaders(natsHeaders, md.Timestamp)
+	if err != nil {
+		return nil, err
 	}
 
-	natsHeaders := msg.Headers()
+	data := &anypb.Any{
+		TypeUrl: "type.googleapis.com/" + natsHeaders.Get("WS-Data-Type"),
+		Value:   msg.Data(),
+	}
+
+	return &Event{
+		span:            span,
+		logger:          logger,
+		msg:             msg,
+		backoff:         backoff,
+		recordFailure:   recordFailure,
+		onProcess:       onProcess,
+		Context:         ctx,
+		Subject:         msg.Subject(),
+		ConsumerSeq:     md.Sequence.Consumer,
+		StreamSeq:       md.Sequence.Stream,
+		DeliveryAttempt: md.NumDelivered,
+		AckToken:        ackToken,
+		Headers:         headers,
+		Data:            data,
+	}, nil
+}
+
+// toHeaders extracts the headers of an event from its NATS headers. The
+// timestamp is used as the publish time if the event does not have one.
+func toHeaders(natsHeaders nats.Header, timestamp time.Time) (*Headers, error) {
+	headers := &Headers{
+		PublishedAt: timestamp,
+		Custom:      getCustomHeaders(natsHeaders),
+	}
 
 	// Get the published header
 	publishTimeHeader := natsHeaders.Get("WS-Published-Time")
@@ -107,24 +156,7 @@ func newEvent(
 		headers.TraceState = &traceStateHeader
 	}
 
-	data := &anypb.Any{
-		TypeUrl: "type.googleapis.com/" + natsHeaders.Get("WS-Data-Type"),
-		Value:   msg.Data(),
-	}
-
-	return &Event{
-		span:            span,
-		logger:          logger,
-		msg:             msg,
-		onProcess:       onProcess,
-		Context:         ctx,
-		Subject:         msg.Subject(),
-		ConsumerSeq:     md.Sequence.Stream,
-		StreamSeq:       md.Sequence.Consumer,
-		DeliveryAttempt: md.NumDelivered,
-		Headers:         headers,
-		Data:            data,
-	}, nil
+	return headers, nil
 }
 
 // DiscardData discards the data of the event. This should be called if the
@@ -148,6 +180,41 @@ func (e *Event) Ping() error {
 	return nil
 }
 
+// SetFailure sets why the event could not be processed. The failure is
+// recorded on the trace of the event and logged for later inspection when the
+// event is rejected.
+func (e *Event) SetFailure(failure *Failure) {
+	e.failure = failure
+}
+
+// logFailure records the failure of the event, if one has been set. This is
+// done before the event is rejected so that the failure is available if the
+// event is dead-lettered.
+func (e *Event) logFailure(permanent bool) {
+	if e.failure == nil {
+		return
+	}
+
+	e.span.AddEvent("failure", trace.WithAttributes(failureAttributes(e.failure)...))
+
+	err := e.recordFailure(e.Context, e.StreamSeq, e.DeliveryAttempt, permanent, e.failure)
+	if err != nil {
+		// Rejecting is more important than logging the failure
+		e.logger.Warn("Could not log failure", zap.Uint64("streamSeq", e.StreamSeq), ZapFailure(e.failure), zap.Error(err))
+		e.span.RecordError(err)
+	}
+}
+
+// rejectedStatus returns the description used for the span status when the
+// event is rejected.
+func (e *Event) rejectedStatus(description string) string {
+	if e.failure == nil || e.failure.Reason == "" {
+		return description
+	}
+
+	return description + ": " + e.failure.Reason
+}
+
 // Ack acknowledges the event. The event will be removed from the consumer.
 func (e *Event) Ack() error {
 	defer e.span.End()
@@ -164,23 +231,44 @@ func (e *Event) Ack() error {
 	return nil
 }
 
-// Reject rejects the event.
+// Reject rejects the event. The event will be redelivered, if the consumer
+// has a backoff the redelivery is delayed according to it.
 func (e *Event) Reject() error {
+	if len(e.backoff) > 0 {
+		return e.RejectWithDelay(redeliveryDelay(e.backoff, e.DeliveryAttempt))
+	}
+
 	defer e.span.End()
 
 	// The event should be redelivered if possible
 	e.logger.Debug("Rejecting event", zap.Uint64("streamSeq", e.StreamSeq))
+	e.logFailure(false)
 	err := e.msg.Nak()
 	if err != nil {
 		e.span.RecordError(err)
 		return errors.Wrap(err, "could not reject message")
 	}
 
-	e.span.SetStatus(codes.Error, "event rejected")
+	e.span.SetStatus(codes.Error, e.rejectedStatus("event rejected"))
 	e.onProcess(flowcontrol.ProcessTypeReject)
 	return nil
 }
 
+// redeliveryDelay returns the backoff delay for a delivery attempt. Returns
+// zero if there is no backoff.
+func redeliveryDelay(backoff []time.Duration, deliveryAttempt uint64) time.Duration {
+	if len(backoff) == 0 {
+		return 0
+	}
+
+	idx := len(backoff) - 1
+	if deliveryAttempt > 0 && deliveryAttempt-1 < uint64(idx) {
+		idx = int(deliveryAttempt - 1)
+	}
+
+	return backoff[idx]
+}
+
 // RejectWithDelay rejects the event with a delay. The event will be redelivered
 // after the delay.
 func (e *Event) RejectWithDelay(delay time.Duration) error {
@@ -188,13 +276,14 @@ func (e *Event) RejectWithDelay(delay time.Duration) error {
 
 	// The event should be redelivered if possible
 	e.logger.Debug("Rejecting event with delay", zap.Uint64("streamSeq", e.StreamSeq), zap.Duration("delay", delay))
+	e.logFailure(false)
 	err := e.msg.NakWithDelay(delay)
 	if err != nil {
 		e.span.RecordError(err)
 		return errors.Wrap(err, "could not reject message")
 	}
 
-	e.span.SetStatus(codes.Error, "event rejected")
+	e.span.SetStatus(codes.Error, e.rejectedStatus("event rejected"))
 	e.onProcess(flowcontrol.ProcessTypeReject)
 	return nil
 }
@@ -206,13 +295,14 @@ func (e *Event) RejectPermanently() error {
 
 	// This is a permanent rejection, terminate the event
 	e.logger.Debug("Permanently rejecting event", zap.Uint64("streamSeq", e.StreamSeq))
+	e.logFailure(true)
 	err := e.msg.Term()
 	if err != nil {
 		e.span.RecordError(err)
 		return errors.Wrap(err, "could not permanently reject message")
 	}
 
-	e.span.SetStatus(codes.Error, "event permanently rejected")
+	e.span.SetStatus(codes.Error, e.rejectedStatus("event permanently rejected"))
 	e.onProcess(flowcontrol.ProcessTypePermanentReject)
 	return nil
 }
@@ -55,6 +55,15 @@ type Events struct {
 	// Timeout is the timeout for processing an event. Will be fetched
 	// from the consumer configuration.
 	Timeout time.Duration
+
+	// backoff is the redelivery backoff of the consumer, used to delay
+	// redelivery of rejected events.
+	backoff []time.Duration
+
+	// stream is the name of the stream events are consumed from.
+	stream string
+	// consumer is the name of the consumer events are consumed from.
+	consumer string
 }
 
 // Events creates a new event consumer for the specified stream and consumer.
@@ -85,8 +94,9 @@ func (m *Manager) Events(ctx context.Context, config *EventConsumeConfig) (*Even
 	ctx, span := m.tracer.Start(ctx, config.Stream+" subscribe")
 	defer span.End()
 
-	if !IsValidStreamName(config.Stream) {
-		return nil, newValidationError("invalid stream name: " + config.Stream)
+	err := validateStreamName(config.Stream)
+	if err != nil {
+		return nil, err
 	}
 
 	if !IsValidConsumerName(config.Name) {
@@ -131,7 +141,11 @@ func (m *Manager) Events(ctx context.Context, config *EventConsumeConfig) (*Even
 		messages: messages,
 		channel:  make(chan *Event),
 
-		Timeout: consumer.CachedInfo().Config.AckWait,
+		Timeout: consumerTimeout(&consumer.CachedInfo().Config),
+		backoff: consumer.CachedInfo().Config.BackOff,
+
+		stream:   config.Stream,
+		consumer: config.Name,
 	}
 
 	go q.pump(ctx, config.MaxPendingEvents)
@@ -213,19 +227,7 @@ func (q *Events) createEvent(ctx context.Context, fc *flowcontrol.FlowControl, m
 	// Unlike for most tracing the span is only ended in this function if an
 	// error occurs, otherwise it is passed into the event and ended when the
 	// event is consumed.
-	headers := msg.Headers()
-	msgCtx := q.manager.w3cPropagator.Extract(ctx, eventTracingHeaders{
-		headers: &headers,
-	})
-	msgCtx, span := q.manager.tracer.Start(
-		msgCtx,
-		msg.Subject()+" receive", trace.WithSpanKind(trace.SpanKindConsumer),
-		trace.WithAttributes(
-			semconv.MessagingSystem("nats"),
-			semconv.MessagingOperationReceive,
-			semconv.MessagingDestinationName(msg.Subject()),
-		),
-	)
+	msgCtx, span := q.manager.startReceiveSpan(ctx, msg)
 
 	md, err := msg.Metadata()
 	if err != nil {
@@ -241,7 +243,7 @@ func (q *Events) createEvent(ctx context.Context, fc *flowcontrol.FlowControl, m
 	span.SetAttributes(semconv.MessagingMessageID(fmt.Sprintf("%d", md.Sequence.Stream)))
 
 	onProcess := fc.Received(md.Sequence.Consumer)
-	event, err := newEvent(msgCtx, span, q.logger, msg, md, onProcess)
+	event, err := newEvent(msgCtx, span, q.logger, msg, md, q.manager.newAckToken(msg.Reply()), q.backoff, q.recordFailure, onProcess)
 	if err != nil {
 		q.logger.Error("failed to create event", zap.Error(err))
 		span.RecordError(err)
@@ -261,9 +263,41 @@ func (q *Events) createEvent(ctx context.Context, fc *flowcontrol.FlowControl, m
 		return nil, err
 	}
 
+	err = q.manager.loadEventPayload(msgCtx, event, msg)
+	if err != nil {
+		q.logger.Error("failed to load event data", zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to load event data")
+		span.End()
+		return nil, err
+	}
+
 	return event, nil
 }
 
+// recordFailure logs the failure of an event consumed by this consumer.
+func (q *Events) recordFailure(ctx context.Context, streamSeq uint64, deliveryAttempt uint64, permanent bool, failure *Failure) error {
+	return q.manager.recordFailure(ctx, q.stream, q.consumer, streamSeq, deliveryAttempt, permanent, failure)
+}
+
+// startReceiveSpan starts the span for receiving a message, continuing the
+// trace stored in the headers of the message if there is one.
+func (m *Manager) startReceiveSpan(ctx context.Context, msg jetstream.Msg) (context.Context, trace.Span) {
+	headers := msg.Headers()
+	msgCtx := m.w3cPropagator.Extract(ctx, eventTracingHeaders{
+		headers: &headers,
+	})
+	return m.tracer.Start(
+		msgCtx,
+		msg.Subject()+" receive", trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			semconv.MessagingOperationReceive,
+			semconv.MessagingDestinationName(msg.Subject()),
+		),
+	)
+}
+
 // Close closes the event consumer. Will stop receiving events and wait for
 // pending events to be processed.
 func (q *Events) Close() error {
@@ -224,6 +224,42 @@ var _ = Describe("Event Consumption", func() {
 				Fail("no event received")
 			}
 		})
+
+		It("events have stream and consumer sequence numbers", func(ctx context.Context) {
+			for i := 0; i < 2; i++ {
+				_, err := manager.Publish(ctx, &events.PublishConfig{
+					Subject: "events.test",
+					Data:    Data(&emptypb.Empty{}),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			sub, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream: "events",
+				Subjects: []string{
+					"events.>",
+				},
+				From: &events.StreamPointer{
+					ID: 2,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ec, err := manager.Events(ctx, &events.EventConsumeConfig{
+				Stream: "events",
+				Name:   sub.ID,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer ec.Close()
+
+			select {
+			case event := <-ec.Incoming():
+				Expect(event.StreamSeq).To(Equal(uint64(2)))
+				Expect(event.ConsumerSeq).To(Equal(uint64(1)))
+			case <-time.After(200 * time.Millisecond):
+				Fail("no event received")
+			}
+		})
 	})
 
 	Describe("Durable consumption", func() {
@@ -785,6 +821,52 @@ var _ = Describe("Event Consumption", func() {
 			}
 		})
 
+		It("rejecting uses redelivery backoff of consumer", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream: "events",
+				Name:   "test",
+				Subjects: []string{
+					"events.>",
+				},
+				Timeout: time.Second,
+				Backoff: []time.Duration{time.Second},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ec, err := manager.Events(ctx, &events.EventConsumeConfig{
+				Stream: "events",
+				Name:   "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer ec.Close()
+
+			msg, err := anypb.New(&emptypb.Empty{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    msg,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			event := <-ec.Incoming()
+			Expect(event).ToNot(BeNil())
+
+			start := time.Now()
+			err = event.Reject()
+			Expect(err).ToNot(HaveOccurred())
+
+			select {
+			case event := <-ec.Incoming():
+				if time.Since(start) < time.Second {
+					Fail("event received too early")
+				}
+
+				Expect(event.DeliveryAttempt).To(BeNumerically("==", 2))
+			case <-time.After(2 * time.Second):
+				Fail("no event received")
+			}
+		})
+
 		It("permanently rejecting event does not redeliver it", func(ctx context.Context) {
 			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
 				Stream: "events",
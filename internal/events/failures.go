@@ -0,0 +1,399 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// FailureStreamName is the name of the stream used to log failures reported
+// by consumers when rejecting events.
+const FailureStreamName = "windshift-failures"
+
+// failureSubjectPrefix is the subject prefix failures are logged to, followed
+// by the stream, consumer and stream sequence of the event.
+const failureSubjectPrefix = "$WS.FAILURES."
+
+// Failure describes why an event could not be processed.
+type Failure struct {
+	// Reason is a human readable reason for the failure.
+	Reason string
+	// Code is an optional error code, for grouping similar failures.
+	Code string
+	// Details contains additional information about the failure.
+	Details map[string]string
+}
+
+// maxFailureDetails is the maximum number of details a failure can have.
+const maxFailureDetails = 64
+
+// maxFailureDetailsLength is the maximum combined length of the keys and
+// values of the details of a failure.
+const maxFailureDetailsLength = 16384
+
+// ValidateFailure checks that a failure can be logged and stored in the
+// headers of a dead letter. The reason and code are stored as headers, so
+// they are limited in length and can not contain line breaks. Details are
+// stored as JSON and are only limited in size.
+func ValidateFailure(failure *Failure) error {
+	if failure == nil {
+		return nil
+	}
+
+	if !isValidHeaderValue(failure.Reason) {
+		return newValidationError("invalid failure reason, reasons must be at most 4096 characters and not contain line breaks")
+	}
+
+	if !isValidHeaderValue(failure.Code) {
+		return newValidationError("invalid failure code, codes must be at most 4096 characters and not contain line breaks")
+	}
+
+	if len(failure.Details) > maxFailureDetails {
+		return newValidationError("too many failure details, at most 64 are allowed")
+	}
+
+	length := 0
+	for key, value := range failure.Details {
+		length += len(key) + len(value)
+	}
+
+	if length > maxFailureDetailsLength {
+		return newValidationError("failure details are too large, at most 16384 characters are allowed")
+	}
+
+	return nil
+}
+
+// FailureRecord is a failure logged when a consumer rejected an event.
+type FailureRecord struct {
+	// ID is the sequence number of the record in the failure stream.
+	ID uint64
+	// Stream is the stream the event is stored in.
+	Stream string
+	// Consumer is the consumer that rejected the event.
+	Consumer string
+	// StreamSeq is the sequence number of the event in the stream.
+	StreamSeq uint64
+	// DeliveryAttempt is the delivery attempt that failed.
+	DeliveryAttempt uint64
+	// Permanent is set if the event was permanently rejected.
+	Permanent bool
+	// Timestamp is when the event was rejected.
+	Timestamp time.Time
+	// Failure is why the event could not be processed.
+	Failure *Failure
+}
+
+// ListFailuresConfig is the configuration for listing logged failures.
+type ListFailuresConfig struct {
+	// Stream the consumer belongs to.
+	Stream string
+	// Consumer to list failures for.
+	Consumer string
+	// StreamSeq limits the failures to a single event if set.
+	StreamSeq uint64
+	// After is the ID of the record to list failures after, used for paging.
+	After uint64
+	// Limit is the maximum number of failures to return.
+	Limit uint
+}
+
+// FailureList is a page of logged failures.
+type FailureList struct {
+	// Failures in the page, ordered by ID.
+	Failures []*FailureRecord
+	// HasMore indicates if there are more failures after this page.
+	HasMore bool
+}
+
+// failureEntry is how a failure is stored in the failure stream.
+type failureEntry struct {
+	Reason          string            `json:"reason"`
+	Code            string            `json:"code,omitempty"`
+	Details         map[string]string `json:"details,omitempty"`
+	DeliveryAttempt uint64            `json:"delivery_attempt"`
+	Permanent       bool              `json:"permanent"`
+	Timestamp       time.Time         `json:"timestamp"`
+}
+
+// ensureFailureStream makes sure that the stream used to log failures exists.
+func (m *Manager) ensureFailureStream(ctx context.Context) error {
+	m.failureStreamMu.Lock()
+	defer m.failureStreamMu.Unlock()
+
+	if m.failureStreamReady {
+		return nil
+	}
+
+	_, err := m.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        FailureStreamName,
+		Description: "Failures reported by consumers when rejecting events",
+		Subjects: []string{
+			failureSubjectPrefix + ">",
+		},
+		MaxAge: 7 * 24 * time.Hour,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create failure stream")
+	}
+
+	m.failureStreamReady = true
+	return nil
+}
+
+// failureSubject returns the subject failures of an event are logged to.
+func failureSubject(stream string, consumer string, streamSeq uint64) string {
+	return failureSubjectPrefix + stream + "." + consumer + "." + strconv.FormatUint(streamSeq, 10)
+}
+
+// recordFailure logs a failure for a delivery of an event.
+func (m *Manager) recordFailure(
+	ctx context.Context,
+	stream string,
+	consumer string,
+	streamSeq uint64,
+	deliveryAttempt uint64,
+	permanent bool,
+	failure *Failure,
+) error {
+	err := m.ensureFailureStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(&failureEntry{
+		Reason:          failure.Reason,
+		Code:            failure.Code,
+		Details:         failure.Details,
+		DeliveryAttempt: deliveryAttempt,
+		Permanent:       permanent,
+		Timestamp:       time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not encode failure")
+	}
+
+	msg := &nats.Msg{
+		Subject: failureSubject(stream, consumer, streamSeq),
+		Header:  nats.Header{},
+		Data:    data,
+	}
+
+	// A delivery attempt can only be rejected once
+	msg.Header.Set("Nats-Msg-Id", fmt.Sprintf("%s:%s:%d:%d", stream, consumer, streamSeq, deliveryAttempt))
+
+	_, err = m.js.PublishMsg(ctx, msg)
+	if err != nil {
+		return errors.Wrap(err, "could not log failure")
+	}
+
+	return nil
+}
+
+// lastFailure returns the last failure logged for an event. Returns
+// jetstream.ErrMsgNotFound if no failure has been logged.
+func (m *Manager) lastFailure(ctx context.Context, stream string, consumer string, streamSeq uint64) (*Failure, error) {
+	failureStream, err := m.js.Stream(ctx, FailureStreamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		// No failures have been logged yet
+		return nil, errors.WithStack(jetstream.ErrMsgNotFound)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not get failure stream")
+	}
+
+	msg, err := failureStream.GetLastMsgForSubject(ctx, failureSubject(stream, consumer, streamSeq))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get failure")
+	}
+
+	var entry failureEntry
+	err = json.Unmarshal(msg.Data, &entry)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode failure")
+	}
+
+	return entry.toFailure(), nil
+}
+
+// ListFailures returns the failures logged when a consumer rejected events.
+func (m *Manager) ListFailures(ctx context.Context, config *ListFailuresConfig) (*FailureList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ListFailures",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", config.Stream),
+			attribute.String("name", config.Consumer),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(config.Stream)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream name")
+		return nil, err
+	}
+
+	if !IsValidConsumerName(config.Consumer) {
+		span.SetStatus(codes.Error, "invalid consumer name")
+		return nil, newValidationError("invalid consumer id: " + config.Consumer)
+	}
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	result := &FailureList{
+		Failures: make([]*FailureRecord, 0),
+	}
+
+	_, err = m.js.Stream(ctx, FailureStreamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		// No failures have been logged yet
+		span.SetStatus(codes.Ok, "")
+		return result, nil
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get failure stream")
+	}
+
+	subject := failureSubjectPrefix + config.Stream + "." + config.Consumer + ".>"
+	if config.StreamSeq > 0 {
+		subject = failureSubject(config.Stream, config.Consumer, config.StreamSeq)
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, FailureStreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:    config.After + 1,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to create consumer")
+		return nil, errors.Wrap(err, "could not create failure consumer")
+	}
+
+	for {
+		batch, err := consumer.FetchNoWait(100)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to fetch failures")
+			return nil, errors.Wrap(err, "could not fetch failures")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			metadata, err := msg.Metadata()
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read failure")
+				return nil, errors.Wrap(err, "could not get failure metadata")
+			}
+
+			caughtUp = metadata.NumPending == 0
+
+			if uint(len(result.Failures)) >= config.Limit {
+				result.HasMore = true
+				span.SetStatus(codes.Ok, "")
+				return result, nil
+			}
+
+			record, err := toFailureRecord(metadata.Sequence.Stream, config.Stream, config.Consumer, msg.Subject(), msg.Data())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read failure")
+				return nil, err
+			}
+
+			result.Failures = append(result.Failures, record)
+		}
+
+		if batch.Error() != nil {
+			span.RecordError(batch.Error())
+			span.SetStatus(codes.Error, "failed to fetch failures")
+			return nil, errors.Wrap(batch.Error(), "could not fetch failures")
+		}
+
+		if received == 0 || caughtUp {
+			span.SetStatus(codes.Ok, "")
+			return result, nil
+		}
+	}
+}
+
+// ZapFailure returns a zap field for a failure.
+func ZapFailure(failure *Failure) zap.Field {
+	return zap.Dict(
+		"failure",
+		zap.String("reason", failure.Reason),
+		zap.String("code", failure.Code),
+		zap.Any("details", failure.Details),
+	)
+}
+
+// failureAttributes returns the tracing attributes describing a failure.
+func failureAttributes(failure *Failure) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(failure.Details)+2)
+	attributes = append(attributes, attribute.String("failure.reason", failure.Reason))
+	if failure.Code != "" {
+		attributes = append(attributes, attribute.String("failure.code", failure.Code))
+	}
+
+	for key, value := range failure.Details {
+		attributes = append(attributes, attribute.String("failure.details."+key, value))
+	}
+
+	return attributes
+}
+
+func (e *failureEntry) toFailure() *Failure {
+	return &Failure{
+		Reason:  e.Reason,
+		Code:    e.Code,
+		Details: e.Details,
+	}
+}
+
+func toFailureRecord(id uint64, stream string, consumer string, subject string, data []byte) (*FailureRecord, error) {
+	var entry failureEntry
+	err := json.Unmarshal(data, &entry)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode failure")
+	}
+
+	// The stream sequence is the last token of the subject
+	prefix := failureSubjectPrefix + stream + "." + consumer + "."
+	streamSeq, err := strconv.ParseUint(strings.TrimPrefix(subject, prefix), 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse failure stream sequence")
+	}
+
+	return &FailureRecord{
+		ID:              id,
+		Stream:          stream,
+		Consumer:        consumer,
+		StreamSeq:       streamSeq,
+		DeliveryAttempt: entry.DeliveryAttempt,
+		Permanent:       entry.Permanent,
+		Timestamp:       entry.Timestamp,
+		Failure:         entry.toFailure(),
+	}, nil
+}
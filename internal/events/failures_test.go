@@ -0,0 +1,190 @@
+package events_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Failures", func() {
+	var manager *events.Manager
+	var ec *events.Events
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		ec, err = manager.Events(ctx, &events.EventConsumeConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+	})
+
+	publish := func(ctx context.Context, subject string) {
+		_, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject: subject,
+			Data:    Data(&emptypb.Empty{}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	listFailures := func(ctx context.Context, config *events.ListFailuresConfig) *events.FailureList {
+		config.Stream = "events"
+		config.Consumer = "test"
+		if config.Limit == 0 {
+			config.Limit = 100
+		}
+
+		list, err := manager.ListFailures(ctx, config)
+		Expect(err).ToNot(HaveOccurred())
+		return list
+	}
+
+	It("no failures are listed before any are logged", func(ctx context.Context) {
+		list := listFailures(ctx, &events.ListFailuresConfig{})
+		Expect(list.Failures).To(BeEmpty())
+		Expect(list.HasMore).To(BeFalse())
+	})
+
+	It("rejecting without a failure logs nothing", func(ctx context.Context) {
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		Expect(event.Reject()).To(Succeed())
+
+		Expect(listFailures(ctx, &events.ListFailuresConfig{}).Failures).To(BeEmpty())
+	})
+
+	It("rejecting with a failure logs it", func(ctx context.Context) {
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		event.SetFailure(&events.Failure{
+			Reason: "could not reach payment provider",
+			Code:   "UNAVAILABLE",
+			Details: map[string]string{
+				"provider": "test",
+			},
+		})
+		Expect(event.Reject()).To(Succeed())
+
+		list := listFailures(ctx, &events.ListFailuresConfig{})
+		Expect(list.Failures).To(HaveLen(1))
+
+		record := list.Failures[0]
+		Expect(record.Stream).To(Equal("events"))
+		Expect(record.Consumer).To(Equal("test"))
+		Expect(record.StreamSeq).To(Equal(uint64(1)))
+		Expect(record.DeliveryAttempt).To(Equal(uint64(1)))
+		Expect(record.Permanent).To(BeFalse())
+		Expect(record.Failure.Reason).To(Equal("could not reach payment provider"))
+		Expect(record.Failure.Code).To(Equal("UNAVAILABLE"))
+		Expect(record.Failure.Details).To(HaveKeyWithValue("provider", "test"))
+	})
+
+	It("permanently rejecting with a failure logs it", func(ctx context.Context) {
+		publish(ctx, "events.test")
+
+		event := <-ec.Incoming()
+		event.SetFailure(&events.Failure{
+			Reason: "invalid order",
+		})
+		Expect(event.RejectPermanently()).To(Succeed())
+
+		list := listFailures(ctx, &events.ListFailuresConfig{})
+		Expect(list.Failures).To(HaveLen(1))
+		Expect(list.Failures[0].Permanent).To(BeTrue())
+	})
+
+	It("can list failures of a single event", func(ctx context.Context) {
+		publish(ctx, "events.test")
+		publish(ctx, "events.test")
+
+		for i := 0; i < 2; i++ {
+			event := <-ec.Incoming()
+			event.SetFailure(&events.Failure{
+				Reason: "failed",
+			})
+			Expect(event.RejectPermanently()).To(Succeed())
+		}
+
+		list := listFailures(ctx, &events.ListFailuresConfig{
+			StreamSeq: 2,
+		})
+		Expect(list.Failures).To(HaveLen(1))
+		Expect(list.Failures[0].StreamSeq).To(Equal(uint64(2)))
+	})
+
+	It("can page through failures", func(ctx context.Context) {
+		publish(ctx, "events.test")
+		publish(ctx, "events.test")
+
+		for i := 0; i < 2; i++ {
+			event := <-ec.Incoming()
+			event.SetFailure(&events.Failure{
+				Reason: "failed",
+			})
+			Expect(event.RejectPermanently()).To(Succeed())
+		}
+
+		page1 := listFailures(ctx, &events.ListFailuresConfig{
+			Limit: 1,
+		})
+		Expect(page1.Failures).To(HaveLen(1))
+		Expect(page1.HasMore).To(BeTrue())
+
+		page2 := listFailures(ctx, &events.ListFailuresConfig{
+			After: page1.Failures[0].ID,
+			Limit: 1,
+		})
+		Expect(page2.Failures).To(HaveLen(1))
+		Expect(page2.HasMore).To(BeFalse())
+		Expect(page2.Failures[0].StreamSeq).ToNot(Equal(page1.Failures[0].StreamSeq))
+	})
+
+	It("failures with line breaks in the reason or code are invalid", func() {
+		Expect(events.ValidateFailure(&events.Failure{Reason: "failed"})).To(Succeed())
+		Expect(events.IsValidationError(events.ValidateFailure(&events.Failure{
+			Reason: "failed\r\nat main.go:10",
+		}))).To(BeTrue())
+		Expect(events.IsValidationError(events.ValidateFailure(&events.Failure{
+			Reason: "failed",
+			Code:   "code\n",
+		}))).To(BeTrue())
+	})
+
+	It("failures with a long reason are invalid", func() {
+		err := events.ValidateFailure(&events.Failure{
+			Reason: strings.Repeat("a", 4097),
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("listing with an invalid consumer fails", func(ctx context.Context) {
+		_, err := manager.ListFailures(ctx, &events.ListFailuresConfig{
+			Stream:   "events",
+			Consumer: "invalid name",
+			Limit:    10,
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+})
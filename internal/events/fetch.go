@@ -0,0 +1,198 @@
+package events
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events/flowcontrol"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// fetchDeadlineMargin is the time kept free before the deadline of a fetch
+// to return the fetched events.
+const fetchDeadlineMargin = 100 * time.Millisecond
+
+// FetchConfig is the configuration for fetching a batch of events from a
+// consumer.
+type FetchConfig struct {
+	// Stream is the name of the stream to fetch events from.
+	Stream string
+	// Name is the name of the consumer to fetch events from.
+	Name string
+	// MaxEvents is the maximum number of events to fetch.
+	MaxEvents uint
+	// MaxWait is the maximum time to wait for events to become available.
+	// If not set the default of one second is used. The wait is shortened to
+	// end before the deadline of the context.
+	MaxWait time.Duration
+}
+
+// Fetch pulls a batch of events from a consumer. Unlike Events this does not
+// keep a subscription open, which makes it useful for short-lived processes.
+// Fetch returns once MaxEvents have been received or MaxWait has passed, so
+// the returned batch may be empty.
+//
+// The returned events are expected to be acknowledged, rejected or pinged
+// using their AckToken, as the request that fetched them will usually have
+// completed by then. The events can also be handled directly using the
+// methods of Event.
+func (m *Manager) Fetch(ctx context.Context, config *FetchConfig) ([]*Event, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.Fetch",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", config.Stream),
+			attribute.String("name", config.Name),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(config.Stream)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream name")
+		return nil, err
+	}
+
+	if !IsValidConsumerName(config.Name) {
+		span.SetStatus(codes.Error, "invalid consumer name")
+		return nil, newValidationError("invalid consumer name: " + config.Name)
+	}
+
+	if config.MaxEvents == 0 {
+		span.SetStatus(codes.Error, "max events must be greater than 0")
+		return nil, newValidationError("max events must be greater than 0")
+	}
+
+	maxWait := config.MaxWait
+	if maxWait <= 0 {
+		maxWait = time.Second
+	}
+
+	consumer, err := m.js.Consumer(ctx, config.Stream, config.Name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if errors.Is(err, jetstream.ErrConsumerNotFound) {
+		span.SetStatus(codes.Error, "consumer not found")
+		return nil, errors.WithStack(ErrConsumerNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get consumer")
+		return nil, errors.Wrap(err, "could not get consumer info")
+	}
+
+	// Fetching does not take a context, so stop waiting before the caller
+	// gives up to not fetch events that can not be returned
+	if ctx.Err() != nil {
+		span.SetStatus(codes.Error, "context done")
+		return nil, errors.WithStack(ctx.Err())
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		maxWait = min(maxWait, time.Until(deadline)-fetchDeadlineMargin)
+	}
+
+	var batch jetstream.MessageBatch
+	if maxWait > 0 {
+		batch, err = consumer.Fetch(int(config.MaxEvents), jetstream.FetchMaxWait(maxWait))
+	} else {
+		// Only return events that are already available
+		batch, err = consumer.FetchNoWait(int(config.MaxEvents))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to fetch events")
+		return nil, errors.Wrap(err, "could not fetch events")
+	}
+
+	logger := m.logger.With(zap.String("stream", config.Stream), zap.String("subscription", config.Name))
+	backoff := consumer.CachedInfo().Config.BackOff
+	recordFailure := func(ctx context.Context, streamSeq uint64, deliveryAttempt uint64, permanent bool, failure *Failure) error {
+		return m.recordFailure(ctx, config.Stream, config.Name, streamSeq, deliveryAttempt, permanent, failure)
+	}
+
+	events := make([]*Event, 0, config.MaxEvents)
+	for msg := range batch.Messages() {
+		event, err := m.createFetchedEvent(ctx, logger, msg, backoff, recordFailure)
+		if err != nil {
+			span.RecordError(err)
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	// Running out of time is expected when fewer events are available
+	if batch.Error() != nil && !errors.Is(batch.Error(), nats.ErrTimeout) {
+		span.RecordError(batch.Error())
+		span.SetStatus(codes.Error, "failed to fetch events")
+		return nil, errors.Wrap(batch.Error(), "could not fetch events")
+	}
+
+	span.SetAttributes(attribute.Int("events", len(events)))
+	span.SetStatus(codes.Ok, "")
+	return events, nil
+}
+
+// createFetchedEvent creates an Event for a message that was fetched in a
+// batch. The receive span of the event is ended once the event has been
+// created, as the event is usually completed using its ack token.
+func (m *Manager) createFetchedEvent(
+	ctx context.Context,
+	logger *zap.Logger,
+	msg jetstream.Msg,
+	backoff []time.Duration,
+	recordFailure failureRecorder,
+) (*Event, error) {
+	msgCtx, span := m.startReceiveSpan(ctx, msg)
+	defer span.End()
+
+	md, err := msg.Metadata()
+	if err != nil {
+		logger.Error("failed to get message metadata", zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get message metadata")
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.MessagingMessageID(strconv.FormatUint(md.Sequence.Stream, 10)))
+
+	event, err := newEvent(msgCtx, span, logger, msg, md, m.newAckToken(msg.Reply()), backoff, recordFailure, func(flowcontrol.ProcessType) {})
+	if err != nil {
+		logger.Error("failed to create event", zap.Error(err))
+		span.RecordError(err)
+
+		// Same as for Events, invalid events are terminated so they are not
+		// redelivered
+		err2 := msg.Term()
+		if err2 != nil {
+			logger.Warn("failed to terminate message", zap.Error(err2))
+			span.RecordError(err2)
+		}
+
+		span.SetStatus(codes.Error, "failed to create event")
+		return nil, err
+	}
+
+	err = m.loadEventPayload(msgCtx, event, msg)
+	if err != nil {
+		logger.Error("failed to load event data", zap.Error(err))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to load event data")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return event, nil
+}
@@ -0,0 +1,154 @@
+package events_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Fetching events", func() {
+	var manager *events.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	publish := func(ctx context.Context, count int) {
+		for i := 0; i < count; i++ {
+			_, err := manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	It("can fetch a batch of events", func(ctx context.Context) {
+		publish(ctx, 5)
+
+		fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 3,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(HaveLen(3))
+		Expect(fetched[0].AckToken).ToNot(BeEmpty())
+		Expect(fetched[0].Data.MessageName()).To(BeEquivalentTo("google.protobuf.Empty"))
+	})
+
+	It("returns fewer events when the wait is over", func(ctx context.Context) {
+		publish(ctx, 1)
+
+		fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 10,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(HaveLen(1))
+	})
+
+	It("returns no events if none are available", func(ctx context.Context) {
+		fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 10,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(BeEmpty())
+	})
+
+	It("stops waiting before the deadline of the context", func(ctx context.Context) {
+		publish(ctx, 1)
+
+		deadlineCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		fetched, err := manager.Fetch(deadlineCtx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 10,
+			MaxWait:   10 * time.Second,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(HaveLen(1))
+		Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+	})
+
+	It("fails if the context is done", func(ctx context.Context) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := manager.Fetch(canceledCtx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 10,
+		})
+		Expect(err).To(MatchError(context.Canceled))
+	})
+
+	It("acknowledged events are not fetched again", func(ctx context.Context) {
+		publish(ctx, 2)
+
+		fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 1,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(HaveLen(1))
+		Expect(manager.AckEvent(ctx, fetched[0].AckToken)).To(Succeed())
+
+		fetched2, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 10,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched2).To(HaveLen(1))
+		Expect(fetched2[0].StreamSeq).ToNot(Equal(fetched[0].StreamSeq))
+	})
+
+	It("fetching from a missing consumer fails", func(ctx context.Context) {
+		_, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "missing",
+			MaxEvents: 1,
+		})
+		Expect(errors.Is(err, events.ErrConsumerNotFound)).To(BeTrue())
+	})
+
+	It("fetching without max events fails", func(ctx context.Context) {
+		_, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+})
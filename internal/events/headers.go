@@ -0,0 +1,92 @@
+package events
+
+import (
+	"strings"
+
+	"github.com/nats-io/nats.go"
+)
+
+// customHeaderPrefix is the prefix used for custom headers set by publishers.
+// The prefix keeps custom headers separate from the headers used by Windshift
+// and NATS.
+const customHeaderPrefix = "WS-Custom-"
+
+// maxCustomHeaders is the maximum number of custom headers an event can have.
+const maxCustomHeaders = 64
+
+// maxCustomHeaderValueLength is the maximum length of the value of a custom
+// header.
+const maxCustomHeaderValueLength = 4096
+
+// IsValidHeaderName checks if the name of a custom header is valid.
+//
+// Header names are limited to the characters `a`-`z`, `A`-`Z`, `0`-`9`, `_`,
+// and `-`, which are safe to use as NATS header names. Names are
+// case-sensitive.
+//
+// Empty header names are not allowed.
+func IsValidHeaderName(name string) bool {
+	return IsValidConsumerName(name)
+}
+
+// isValidHeaderValue checks that a header value can be stored in NATS headers,
+// which means it must not contain line breaks.
+func isValidHeaderValue(value string) bool {
+	return len(value) <= maxCustomHeaderValueLength && !strings.ContainsAny(value, "\r\n")
+}
+
+// validateCustomHeaders checks that custom headers can be stored in an event.
+func validateCustomHeaders(headers map[string]string) error {
+	if len(headers) > maxCustomHeaders {
+		return newValidationError("too many headers, at most 64 are allowed")
+	}
+
+	for name, value := range headers {
+		if !IsValidHeaderName(name) {
+			return newValidationError("invalid header name: " + name)
+		}
+
+		if !isValidHeaderValue(value) {
+			return newValidationError("invalid value for header " + name + ", values must be at most 4096 characters and not contain line breaks")
+		}
+	}
+
+	return nil
+}
+
+// isPublishHeader checks if a header controls how NATS stores a published
+// message, such as deduplication and the expected last sequence. NATS keeps
+// these headers on stored messages, so they must not be copied when an event
+// is published again.
+func isPublishHeader(key string) bool {
+	return key == "Nats-Msg-Id" ||
+		key == "Nats-Rollup" ||
+		strings.HasPrefix(key, "Nats-Expected-")
+}
+
+// setCustomHeaders stores custom headers in NATS headers. The headers are
+// set directly in the map so that the case of the names is kept.
+func setCustomHeaders(natsHeaders nats.Header, headers map[string]string) {
+	for name, value := range headers {
+		natsHeaders[customHeaderPrefix+name] = []string{value}
+	}
+}
+
+// getCustomHeaders extracts custom headers from NATS headers. Returns nil if
+// there are no custom headers.
+func getCustomHeaders(natsHeaders nats.Header) map[string]string {
+	var headers map[string]string
+	for key, values := range natsHeaders {
+		if !strings.HasPrefix(key, customHeaderPrefix) || len(values) == 0 {
+			continue
+		}
+
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+
+		headers[key[len(customHeaderPrefix):]] = values[0]
+	}
+
+	return headers
+}
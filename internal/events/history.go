@@ -0,0 +1,295 @@
+package events
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// StoredEvent is an event read from the history of a stream, outside of any
+// consumer.
+type StoredEvent struct {
+	// ID is the sequence number of the event in its stream.
+	ID uint64
+	// Subject is the subject the event was published to.
+	Subject string
+	// StoredAt is when the event was stored in the stream.
+	StoredAt time.Time
+	// Headers contains the headers of the event.
+	Headers *Headers
+	// Data is the protobuf message published by the producer.
+	Data *anypb.Any
+}
+
+// ReadEventsConfig is the configuration for reading events from a stream.
+type ReadEventsConfig struct {
+	// Stream is the name of the stream to read events from.
+	Stream string
+	// Subject limits the events to those published to subjects matching it,
+	// may contain wildcards. Optional.
+	Subject string
+	// StartID is the ID of the first event to read. Optional, defaults to
+	// the first event in the stream.
+	StartID uint64
+	// StartTime is the time to start reading events from, based on when
+	// they were stored. Optional, can not be combined with StartID.
+	StartTime time.Time
+	// EndID is the ID of the last event to read. Optional.
+	EndID uint64
+	// EndTime is the time to stop reading events at, events stored at or
+	// after it are not read. Optional, can not be combined with EndID.
+	EndTime time.Time
+	// Limit is the maximum number of events to return.
+	Limit uint
+}
+
+// EventList is a page of events read from a stream.
+type EventList struct {
+	// Events are the events in the page, in the order they were stored.
+	Events []*StoredEvent
+	// HasMore is set if there are more events to read after the last event
+	// in the page.
+	HasMore bool
+}
+
+// GetEvent returns a single event from a stream by its ID. If the stream
+// does not exist ErrStreamNotFound is returned, and if the event does not
+// exist ErrEventNotFound is returned.
+func (m *Manager) GetEvent(ctx context.Context, streamName string, id uint64) (*StoredEvent, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.GetEvent",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", streamName),
+			semconv.MessagingMessageID(strconv.FormatUint(id, 10)),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(streamName)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream name")
+		return nil, err
+	}
+
+	stream, err := m.js.Stream(ctx, streamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get stream")
+	}
+
+	msg, err := stream.GetMsg(ctx, id)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		span.SetStatus(codes.Error, "event not found")
+		return nil, errors.WithStack(ErrEventNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get event")
+		return nil, errors.Wrap(err, "could not get event")
+	}
+
+	event, err := m.toStoredEvent(ctx, msg.Sequence, msg.Subject, msg.Time, msg.Header, msg.Data)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to read event")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return event, nil
+}
+
+// ReadEvents reads a page of events from a stream, starting at an ID or a
+// time and optionally stopping at an ID or a time. Events are read without a
+// consumer, so reading does not affect the delivery of events.
+func (m *Manager) ReadEvents(ctx context.Context, config *ReadEventsConfig) (*EventList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ReadEvents",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", config.Stream),
+			attribute.String("subject", config.Subject),
+		),
+	)
+	defer span.End()
+
+	err := validateReadEventsConfig(config)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid config")
+		return nil, err
+	}
+
+	result := &EventList{
+		Events: make([]*StoredEvent, 0),
+	}
+
+	stream, err := m.js.Stream(ctx, config.Stream)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get stream")
+	}
+
+	if stream.CachedInfo().State.Msgs == 0 {
+		// Ordered consumers wait for events that do not exist yet
+		span.SetStatus(codes.Ok, "")
+		return result, nil
+	}
+
+	consumerConfig := jetstream.OrderedConsumerConfig{
+		DeliverPolicy: jetstream.DeliverAllPolicy,
+	}
+	if config.Subject != "" {
+		consumerConfig.FilterSubjects = []string{config.Subject}
+	}
+
+	if config.StartID > 0 {
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = config.StartID
+	} else if !config.StartTime.IsZero() {
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartTimePolicy
+		consumerConfig.OptStartTime = &config.StartTime
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, config.Stream, consumerConfig)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to create consumer")
+		return nil, errors.Wrap(err, "could not create consumer")
+	}
+
+	for {
+		batch, err := consumer.FetchNoWait(100)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to fetch events")
+			return nil, errors.Wrap(err, "could not fetch events")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			metadata, err := msg.Metadata()
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read event")
+				return nil, errors.Wrap(err, "could not get event metadata")
+			}
+
+			if (config.EndID > 0 && metadata.Sequence.Stream > config.EndID) ||
+				(!config.EndTime.IsZero() && !metadata.Timestamp.Before(config.EndTime)) {
+				// Reached the end of the range
+				span.SetStatus(codes.Ok, "")
+				return result, nil
+			}
+
+			caughtUp = metadata.NumPending == 0
+
+			if uint(len(result.Events)) >= config.Limit {
+				result.HasMore = true
+				span.SetStatus(codes.Ok, "")
+				return result, nil
+			}
+
+			event, err := m.toStoredEvent(ctx, metadata.Sequence.Stream, msg.Subject(), metadata.Timestamp, msg.Headers(), msg.Data())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read event")
+				return nil, err
+			}
+
+			result.Events = append(result.Events, event)
+		}
+
+		if batch.Error() != nil {
+			span.RecordError(batch.Error())
+			span.SetStatus(codes.Error, "failed to fetch events")
+			return nil, errors.Wrap(batch.Error(), "could not fetch events")
+		}
+
+		if received == 0 || caughtUp {
+			span.SetStatus(codes.Ok, "")
+			return result, nil
+		}
+	}
+}
+
+// validateReadEventsConfig checks that a range of events can be read.
+func validateReadEventsConfig(config *ReadEventsConfig) error {
+	err := validateStreamName(config.Stream)
+	if err != nil {
+		return err
+	}
+
+	if config.Subject != "" && !IsValidSubject(config.Subject, true) {
+		return newValidationError("invalid subject: " + config.Subject)
+	}
+
+	if config.StartID > 0 && !config.StartTime.IsZero() {
+		return newValidationError("start id and start time can not both be set")
+	}
+
+	if config.EndID > 0 && !config.EndTime.IsZero() {
+		return newValidationError("end id and end time can not both be set")
+	}
+
+	if config.Limit == 0 {
+		return newValidationError("limit must be greater than 0")
+	}
+
+	return nil
+}
+
+// toStoredEvent converts a message read from a stream into an event, loading
+// its data if it has been offloaded.
+func (m *Manager) toStoredEvent(
+	ctx context.Context,
+	id uint64,
+	subject string,
+	storedAt time.Time,
+	natsHeaders nats.Header,
+	data []byte,
+) (*StoredEvent, error) {
+	headers, err := toHeaders(natsHeaders, storedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = m.loadPayload(ctx, natsHeaders, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StoredEvent{
+		ID:       id,
+		Subject:  subject,
+		StoredAt: storedAt,
+		Headers:  headers,
+		Data: &anypb.Any{
+			TypeUrl: "type.googleapis.com/" + natsHeaders.Get("WS-Data-Type"),
+			Value:   data,
+		},
+	}, nil
+}
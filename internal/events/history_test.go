@@ -0,0 +1,233 @@
+package events_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Reading events", func() {
+	var manager *events.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	publish := func(ctx context.Context, subject string, value string) uint64 {
+		GinkgoHelper()
+
+		published, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject: subject,
+			Data:    Data(wrapperspb.String(value)),
+			Headers: map[string]string{
+				"test": value,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return published.ID
+	}
+
+	read := func(ctx context.Context, config *events.ReadEventsConfig) *events.EventList {
+		GinkgoHelper()
+
+		config.Stream = "events"
+		if config.Limit == 0 {
+			config.Limit = 100
+		}
+
+		list, err := manager.ReadEvents(ctx, config)
+		Expect(err).ToNot(HaveOccurred())
+		return list
+	}
+
+	ids := func(list *events.EventList) []uint64 {
+		res := make([]uint64, len(list.Events))
+		for i, event := range list.Events {
+			res[i] = event.ID
+		}
+		return res
+	}
+
+	Describe("GetEvent", func() {
+		It("can get an event by id", func(ctx context.Context) {
+			id := publish(ctx, "events.test", "first")
+
+			event, err := manager.GetEvent(ctx, "events", id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.ID).To(Equal(id))
+			Expect(event.Subject).To(Equal("events.test"))
+			Expect(event.Headers.Custom).To(HaveKeyWithValue("test", "first"))
+
+			var value wrapperspb.StringValue
+			Expect(event.Data.UnmarshalTo(&value)).To(Succeed())
+			Expect(value.Value).To(Equal("first"))
+		})
+
+		It("does not affect consumers", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "events",
+				Name:     "test",
+				Subjects: []string{"events.>"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			id := publish(ctx, "events.test", "first")
+			_, err = manager.GetEvent(ctx, "events", id)
+			Expect(err).ToNot(HaveOccurred())
+
+			fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+				Stream:    "events",
+				Name:      "test",
+				MaxEvents: 1,
+				MaxWait:   100 * time.Millisecond,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched).To(HaveLen(1))
+			Expect(fetched[0].Ack()).To(Succeed())
+		})
+
+		It("returns an error if the event does not exist", func(ctx context.Context) {
+			publish(ctx, "events.test", "first")
+
+			_, err := manager.GetEvent(ctx, "events", 100)
+			Expect(err).To(MatchError(events.ErrEventNotFound))
+		})
+
+		It("returns an error if the stream does not exist", func(ctx context.Context) {
+			_, err := manager.GetEvent(ctx, "unknown", 1)
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+	})
+
+	It("can not read internal streams", func(ctx context.Context) {
+		_, err := manager.GetEvent(ctx, events.FailureStreamName, 1)
+		Expect(events.IsValidationError(err)).To(BeTrue())
+
+		_, err = manager.ReadEvents(ctx, &events.ReadEventsConfig{
+			Stream: events.ScheduledStreamName,
+			Limit:  10,
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+
+	Describe("ReadEvents", func() {
+		It("returns nothing for an empty stream", func(ctx context.Context) {
+			list := read(ctx, &events.ReadEventsConfig{})
+			Expect(list.Events).To(BeEmpty())
+			Expect(list.HasMore).To(BeFalse())
+		})
+
+		It("reads all events in order", func(ctx context.Context) {
+			first := publish(ctx, "events.a", "1")
+			second := publish(ctx, "events.b", "2")
+			third := publish(ctx, "events.a", "3")
+
+			list := read(ctx, &events.ReadEventsConfig{})
+			Expect(ids(list)).To(Equal([]uint64{first, second, third}))
+			Expect(list.HasMore).To(BeFalse())
+		})
+
+		It("can filter by subject", func(ctx context.Context) {
+			first := publish(ctx, "events.a", "1")
+			publish(ctx, "events.b", "2")
+			third := publish(ctx, "events.a", "3")
+
+			list := read(ctx, &events.ReadEventsConfig{
+				Subject: "events.a",
+			})
+			Expect(ids(list)).To(Equal([]uint64{first, third}))
+		})
+
+		It("can read a range of ids", func(ctx context.Context) {
+			publish(ctx, "events.test", "1")
+			second := publish(ctx, "events.test", "2")
+			third := publish(ctx, "events.test", "3")
+			publish(ctx, "events.test", "4")
+
+			list := read(ctx, &events.ReadEventsConfig{
+				StartID: second,
+				EndID:   third,
+			})
+			Expect(ids(list)).To(Equal([]uint64{second, third}))
+			Expect(list.HasMore).To(BeFalse())
+		})
+
+		It("can read a range of time", func(ctx context.Context) {
+			publish(ctx, "events.test", "1")
+			time.Sleep(50 * time.Millisecond)
+			start := time.Now()
+			second := publish(ctx, "events.test", "2")
+			time.Sleep(50 * time.Millisecond)
+			end := time.Now()
+			publish(ctx, "events.test", "3")
+
+			list := read(ctx, &events.ReadEventsConfig{
+				StartTime: start,
+				EndTime:   end,
+			})
+			Expect(ids(list)).To(Equal([]uint64{second}))
+		})
+
+		It("limits the number of events", func(ctx context.Context) {
+			first := publish(ctx, "events.test", "1")
+			second := publish(ctx, "events.test", "2")
+			third := publish(ctx, "events.test", "3")
+
+			list := read(ctx, &events.ReadEventsConfig{
+				Limit: 2,
+			})
+			Expect(ids(list)).To(Equal([]uint64{first, second}))
+			Expect(list.HasMore).To(BeTrue())
+
+			list = read(ctx, &events.ReadEventsConfig{
+				StartID: second + 1,
+				Limit:   2,
+			})
+			Expect(ids(list)).To(Equal([]uint64{third}))
+			Expect(list.HasMore).To(BeFalse())
+		})
+
+		It("does not have more events if the limit is reached at the end of the range", func(ctx context.Context) {
+			first := publish(ctx, "events.test", "1")
+			second := publish(ctx, "events.test", "2")
+			publish(ctx, "events.test", "3")
+
+			list := read(ctx, &events.ReadEventsConfig{
+				EndID: second,
+				Limit: 2,
+			})
+			Expect(ids(list)).To(Equal([]uint64{first, second}))
+			Expect(list.HasMore).To(BeFalse())
+		})
+
+		It("returns an error if the stream does not exist", func(ctx context.Context) {
+			_, err := manager.ReadEvents(ctx, &events.ReadEventsConfig{
+				Stream: "unknown",
+				Limit:  10,
+			})
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+
+		It("can not combine a start id and a start time", func(ctx context.Context) {
+			_, err := manager.ReadEvents(ctx, &events.ReadEventsConfig{
+				Stream:    "events",
+				StartID:   1,
+				StartTime: time.Now(),
+				Limit:     10,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+	})
+})
@@ -1,10 +1,16 @@
 package events
 
 import (
+	"context"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // Manager is used to manage everything related to events, streams, consumers,
@@ -18,22 +24,76 @@ type Manager struct {
 	// into published and consumed events.
 	w3cPropagator propagation.TextMapPropagator
 
+	// conn is the NATS connection, used to acknowledge events outside of
+	// the consumer that received them.
+	conn *nats.Conn
 	// js is the NATS JetStream instance used to talk to NATS.
 	js jetstream.JetStream
+	// schemas validates the data of published events.
+	schemas SchemaValidator
+	// config is the configuration of events.
+	config *Config
+	// ackTokenKey is the key used to sign ack tokens.
+	ackTokenKey []byte
+
+	// advisoryStreamMu protects advisoryStreamReady.
+	advisoryStreamMu sync.Mutex
+	// advisoryStreamReady is set when the advisory stream has been created.
+	advisoryStreamReady bool
+
+	// failureStreamMu protects failureStreamReady.
+	failureStreamMu sync.Mutex
+	// failureStreamReady is set when the failure stream has been created.
+	failureStreamReady bool
+
+	// scheduledStreamMu protects scheduledStreamReady.
+	scheduledStreamMu sync.Mutex
+	// scheduledStreamReady is set when the scheduled stream has been created.
+	scheduledStreamReady bool
+
+	// payloadStoreMu protects payloadStore.
+	payloadStoreMu sync.Mutex
+	// payloadStore is the object store for offloaded payloads, set when it
+	// has been created.
+	payloadStore jetstream.ObjectStore
+
+	// payloadRefsMu protects payloadRefs.
+	payloadRefsMu sync.Mutex
+	// payloadRefs is the bucket tracking references to offloaded payloads,
+	// set when it has been created.
+	payloadRefs jetstream.KeyValue
+}
+
+// SchemaValidator checks the data of events before they are published.
+type SchemaValidator interface {
+	// ValidateEvent returns an error marked with ErrInvalidData if the data
+	// is not allowed for the subject.
+	ValidateEvent(ctx context.Context, subject string, data *anypb.Any) error
 }
 
 // NewManager creates a new event manager.
 func NewManager(
 	logger *zap.Logger,
 	tracer trace.Tracer,
+	conn *nats.Conn,
 	js jetstream.JetStream,
+	schemas SchemaValidator,
+	config *Config,
 ) (*Manager, error) {
 	m := &Manager{
 		logger:        logger,
 		tracer:        tracer,
 		w3cPropagator: propagation.TraceContext{},
+		conn:          conn,
 		js:            js,
+		schemas:       schemas,
+		config:        config,
+	}
+
+	if config.AckTokenKey == "" {
+		return nil, errors.New("an ack token key is required")
 	}
 
+	m.ackTokenKey = []byte(config.AckTokenKey)
 	return m, nil
 }
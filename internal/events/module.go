@@ -1,6 +1,9 @@
 package events
 
 import (
+	"context"
+	"time"
+
 	"github.com/levelfourab/sprout-go"
 	"go.uber.org/fx"
 )
@@ -10,5 +13,79 @@ var Module = fx.Module(
 	"events",
 	fx.Provide(sprout.Logger("events"), fx.Private),
 	fx.Provide(sprout.ServiceTracer(), fx.Private),
+	fx.Provide(sprout.Config("EVENTS", &Config{}), fx.Private),
 	fx.Provide(NewManager),
+	fx.Invoke(startDeadLetterProcessing),
+	fx.Invoke(startScheduler),
+	fx.Invoke(startPayloadCleanup),
 )
+
+type Config struct {
+	// PayloadOffloadThreshold is the size in bytes above which the data of
+	// an event is stored in an object store instead of in its stream. Zero
+	// disables offloading.
+	PayloadOffloadThreshold int `env:"PAYLOAD_OFFLOAD_THRESHOLD" envDefault:"262144"`
+	// PayloadCleanupInterval is how often offloaded data of removed events
+	// is deleted.
+	PayloadCleanupInterval time.Duration `env:"PAYLOAD_CLEANUP_INTERVAL" envDefault:"5m"`
+	// AckTokenKey is the key used to sign ack tokens. Must be the same for
+	// all instances for ack tokens to be usable with any instance.
+	AckTokenKey string `env:"ACK_TOKEN_KEY,required"`
+}
+
+// startDeadLetterProcessing processes dead letters while the application is
+// running.
+func startDeadLetterProcessing(lifecycle fx.Lifecycle, manager *Manager) {
+	var stop func()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			stop, err = manager.StartDeadLetterProcessing(ctx)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			if stop != nil {
+				stop()
+			}
+			return nil
+		},
+	})
+}
+
+// startScheduler publishes scheduled events while the application is
+// running.
+func startScheduler(lifecycle fx.Lifecycle, manager *Manager) {
+	var stop func()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			stop, err = manager.StartScheduler(ctx)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			if stop != nil {
+				stop()
+			}
+			return nil
+		},
+	})
+}
+
+// startPayloadCleanup removes offloaded payloads of removed events while the
+// application is running.
+func startPayloadCleanup(lifecycle fx.Lifecycle, manager *Manager) {
+	var stop func()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			stop, err = manager.StartPayloadCleanup(ctx)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			if stop != nil {
+				stop()
+			}
+			return nil
+		},
+	})
+}
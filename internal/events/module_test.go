@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/schemas"
 
 	"github.com/nats-io/nats-server/v2/server"
 	"github.com/nats-io/nats.go"
@@ -17,6 +18,9 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+// testAckTokenKey is the key managers in tests sign ack tokens with.
+const testAckTokenKey = "test-key"
+
 func GetNATS() *nats.Conn {
 	tempDir, err := os.MkdirTemp("", "nats")
 	Expect(err).ToNot(HaveOccurred())
@@ -66,13 +70,33 @@ func createManagerAndJetStream() (*events.Manager, jetstream.JetStream) {
 	manager, err := events.NewManager(
 		zaptest.NewLogger(GinkgoT()),
 		otel.Tracer("tests"),
+		natsConn,
 		js,
+		createSchemaManager(js),
+		&events.Config{
+			AckTokenKey: testAckTokenKey,
+		},
 	)
 	Expect(err).ToNot(HaveOccurred())
 
 	return manager, js
 }
 
+func createSchemaManager(js jetstream.JetStream) *schemas.Manager {
+	manager, err := schemas.NewManager(
+		zaptest.NewLogger(GinkgoT()),
+		otel.Tracer("tests"),
+		js,
+		&schemas.Config{
+			Compatibility: schemas.CompatibilityBackward,
+		},
+	)
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(manager.Destroy)
+
+	return manager
+}
+
 func Data(msg proto.Message) *anypb.Any {
 	data, err := anypb.New(msg)
 	Expect(err).ToNot(HaveOccurred())
@@ -1,5 +1,7 @@
 package events
 
+import "strings"
+
 // IsValidSubject checks if the subject is valid, either allowing or disallowing
 // wildcards.
 //
@@ -64,6 +66,29 @@ func IsValidSubject(subject string, allowWildcards bool) bool {
 	return true
 }
 
+// SubjectMatches checks if a subject matches a pattern, where `*` matches a
+// single token and `>` matches one or more tokens at the end of the subject.
+func SubjectMatches(pattern string, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+
+		if i >= len(subjectTokens) {
+			return false
+		}
+
+		if token != "*" && token != subjectTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
+
 // IsValidStreamName checks if the stream name is valid.
 //
 // NATS provides the guidance that stream names cannot contain whitespace, `.`,
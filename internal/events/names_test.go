@@ -76,4 +76,29 @@ var _ = Describe("Names", func() {
 		Expect(events.IsValidConsumerName("a.b.*")).To(BeFalse())
 		Expect(events.IsValidConsumerName("a.b.>")).To(BeFalse())
 	})
+
+	It("SubjectMatches", func() {
+		Expect(events.SubjectMatches("a.b", "a.b")).To(BeTrue())
+		Expect(events.SubjectMatches("a.*", "a.b")).To(BeTrue())
+		Expect(events.SubjectMatches("a.>", "a.b")).To(BeTrue())
+		Expect(events.SubjectMatches("a.>", "a.b.c")).To(BeTrue())
+		Expect(events.SubjectMatches("*.b.>", "a.b.c")).To(BeTrue())
+
+		Expect(events.SubjectMatches("a.b", "a.c")).To(BeFalse())
+		Expect(events.SubjectMatches("a.*", "a.b.c")).To(BeFalse())
+		Expect(events.SubjectMatches("a.>", "a")).To(BeFalse())
+		Expect(events.SubjectMatches("a.b.c", "a.b")).To(BeFalse())
+	})
+
+	It("IsValidHeaderName", func() {
+		Expect(events.IsValidHeaderName("")).To(BeFalse())
+
+		Expect(events.IsValidHeaderName("tenant")).To(BeTrue())
+		Expect(events.IsValidHeaderName("Causation-Id")).To(BeTrue())
+		Expect(events.IsValidHeaderName("source_service")).To(BeTrue())
+
+		Expect(events.IsValidHeaderName("tenant id")).To(BeFalse())
+		Expect(events.IsValidHeaderName("tenant:id")).To(BeFalse())
+		Expect(events.IsValidHeaderName("tenant.id")).To(BeFalse())
+	})
 })
@@ -0,0 +1,695 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+// PayloadBucketName is the name of the object store that the data of large
+// events is offloaded to.
+const PayloadBucketName = "windshift-payloads"
+
+// PayloadRefsBucketName is the name of the key-value bucket tracking the
+// events that reference offloaded data, keyed by the name of the object.
+const PayloadRefsBucketName = "windshift-payload-refs"
+
+const (
+	// headerPayloadRef is the header holding the name of the object the data
+	// of an event has been offloaded to.
+	headerPayloadRef = "WS-Payload-Ref"
+	// payloadPendingTimeout is how long to wait for the result of a publish
+	// whose caller stopped waiting, to record or remove its payload. Pending
+	// events older than this are looked up in their stream by the cleanup.
+	payloadPendingTimeout = time.Minute
+	// payloadClockSkew is how far the clock of the server publishing an
+	// event may be off from NATS, used when looking up pending events.
+	payloadClockSkew = time.Minute
+	// payloadUpdateTimeout limits how long recording the result of a
+	// publish may take once the caller is done with it.
+	payloadUpdateTimeout = 10 * time.Second
+	// maxPayloadUpdateAttempts is how many times updating the references of
+	// a payload is tried if they are being changed at the same time.
+	maxPayloadUpdateAttempts = 10
+)
+
+// payloadRefs is what is stored for an offloaded payload in the references
+// bucket.
+type payloadRefs struct {
+	// Refs are the events referencing the payload, as `stream:seq` values.
+	Refs []string `json:"refs,omitempty"`
+	// Pending are events being published with a reference to the payload,
+	// as `subject@unix nanos` values. Recorded before publishing so that a
+	// payload is never removed while an event that may reference it is not
+	// known.
+	Pending []string `json:"pending,omitempty"`
+	// Deleted is set when the cleanup has started removing the payload, so
+	// that no new references are recorded for it.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// getPayloadStore returns the object store used for offloaded payloads,
+// creating it if needed.
+func (m *Manager) getPayloadStore(ctx context.Context) (jetstream.ObjectStore, error) {
+	m.payloadStoreMu.Lock()
+	defer m.payloadStoreMu.Unlock()
+
+	if m.payloadStore != nil {
+		return m.payloadStore, nil
+	}
+
+	store, err := m.js.ObjectStore(ctx, PayloadBucketName)
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		store, err = m.js.CreateObjectStore(ctx, jetstream.ObjectStoreConfig{
+			Bucket:      PayloadBucketName,
+			Description: "Data of large events, offloaded from their streams",
+		})
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			// Another instance created the bucket at the same time
+			store, err = m.js.ObjectStore(ctx, PayloadBucketName)
+		}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get payload store")
+	}
+
+	m.payloadStore = store
+	return store, nil
+}
+
+// getPayloadRefsBucket returns the bucket tracking references to offloaded
+// payloads, creating it if needed.
+func (m *Manager) getPayloadRefsBucket(ctx context.Context) (jetstream.KeyValue, error) {
+	m.payloadRefsMu.Lock()
+	defer m.payloadRefsMu.Unlock()
+
+	if m.payloadRefs != nil {
+		return m.payloadRefs, nil
+	}
+
+	bucket, err := m.js.KeyValue(ctx, PayloadRefsBucketName)
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		bucket, err = m.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+			Bucket:      PayloadRefsBucketName,
+			Description: "Events referencing offloaded data of large events",
+		})
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			// Another instance created the bucket at the same time
+			bucket, err = m.js.KeyValue(ctx, PayloadRefsBucketName)
+		}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get payload references bucket")
+	}
+
+	m.payloadRefs = bucket
+	return bucket, nil
+}
+
+// offloadPayload moves the data of a message to the payload store if it is
+// larger than the configured threshold, leaving a reference in its headers.
+// The message is recorded as a pending event before the object is stored.
+// Returns the name of the object and the pending event, or empty strings if
+// the data was kept in the message.
+func (m *Manager) offloadPayload(ctx context.Context, msg *nats.Msg) (string, string, error) {
+	if m.config.PayloadOffloadThreshold <= 0 || len(msg.Data) <= m.config.PayloadOffloadThreshold {
+		return "", "", nil
+	}
+
+	store, err := m.getPayloadStore(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	bucket, err := m.getPayloadRefsBucket(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	// The pending event is recorded before the data is stored, so that the
+	// cleanup knows about every object
+	name := uuid.NewString()
+	pending := pendingPayloadRef(msg.Subject, time.Now())
+	data, err := json.Marshal(&payloadRefs{Pending: []string{pending}})
+	if err != nil {
+		return "", "", errors.Wrap(err, "could not encode payload references")
+	}
+
+	_, err = bucket.Create(ctx, name, data)
+	if err != nil {
+		return "", "", errors.Wrap(err, "could not record pending payload reference")
+	}
+
+	_, err = store.Put(ctx, jetstream.ObjectMeta{
+		Name: name,
+	}, bytes.NewReader(msg.Data))
+	if err != nil {
+		m.deletePayload(ctx, name)
+		return "", "", errors.Wrap(err, "could not store payload")
+	}
+
+	m.logger.Debug(
+		"Offloaded event data",
+		zap.String("subject", msg.Subject),
+		zap.String("object", name),
+		zap.Int("size", len(msg.Data)),
+	)
+
+	msg.Header.Set(headerPayloadRef, name)
+	msg.Data = nil
+	return name, pending, nil
+}
+
+// loadPayload returns the data of an event, loading it from the payload
+// store if it has been offloaded.
+func (m *Manager) loadPayload(ctx context.Context, headers nats.Header, data []byte) ([]byte, error) {
+	name := headers.Get(headerPayloadRef)
+	if name == "" {
+		return data, nil
+	}
+
+	store, err := m.getPayloadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := store.GetBytes(ctx, name)
+	if errors.Is(err, jetstream.ErrObjectNotFound) {
+		return nil, errors.Wrapf(ErrPayloadNotFound, "object %s", name)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not load payload")
+	}
+
+	return value, nil
+}
+
+// loadEventPayload sets the data of an event being delivered if it has been
+// offloaded. If the data no longer exists the event can never be delivered
+// and is terminated, otherwise it is redelivered after a short delay.
+func (m *Manager) loadEventPayload(ctx context.Context, event *Event, msg jetstream.Msg) error {
+	value, err := m.loadPayload(ctx, msg.Headers(), event.Data.Value)
+	if err == nil {
+		event.Data.Value = value
+		return nil
+	}
+
+	if errors.Is(err, ErrPayloadNotFound) {
+		err2 := msg.Term()
+		if err2 != nil {
+			m.logger.Warn("failed to terminate message", zap.Error(err2))
+		}
+	} else {
+		err2 := msg.NakWithDelay(time.Second)
+		if err2 != nil {
+			m.logger.Warn("failed to nak message", zap.Error(err2))
+		}
+	}
+
+	return err
+}
+
+// pendingPayloadRef creates the value recorded for an event that is about
+// to be published with a reference to a payload.
+func pendingPayloadRef(subject string, at time.Time) string {
+	return subject + "@" + strconv.FormatInt(at.UnixNano(), 10)
+}
+
+// parsePendingPayloadRef parses the value recorded for a pending event.
+func parsePendingPayloadRef(pending string) (string, time.Time, bool) {
+	subject, at, ok := strings.Cut(pending, "@")
+	if !ok {
+		return "", time.Time{}, false
+	}
+
+	nanos, err := strconv.ParseInt(at, 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+
+	return subject, time.Unix(0, nanos), true
+}
+
+// markPayloadPending records that an event referencing the payload in its
+// headers is about to be published to subject, so the payload is kept even
+// if recording the reference after publishing fails. Returns the pending
+// event to pass to addPayloadReference, or an empty string if the data of
+// the event has not been offloaded.
+func (m *Manager) markPayloadPending(ctx context.Context, headers nats.Header, subject string) (string, error) {
+	name := headers.Get(headerPayloadRef)
+	if name == "" {
+		return "", nil
+	}
+
+	pending := pendingPayloadRef(subject, time.Now())
+	err := m.updatePayloadRefs(ctx, name, func(refs *payloadRefs) bool {
+		refs.Pending = append(refs.Pending, pending)
+		return true
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "could not record pending payload reference")
+	}
+
+	return pending, nil
+}
+
+// addPayloadReference records that an event references the payload in its
+// headers, replacing the pending event recorded before publishing it. Does
+// nothing if the data of the event has not been offloaded.
+func (m *Manager) addPayloadReference(ctx context.Context, headers nats.Header, pending string, stream string, seq uint64) error {
+	name := headers.Get(headerPayloadRef)
+	if name == "" {
+		return nil
+	}
+
+	ref := stream + ":" + strconv.FormatUint(seq, 10)
+	err := m.updatePayloadRefs(ctx, name, func(refs *payloadRefs) bool {
+		changed := refs.removePending(pending)
+		return refs.addRef(ref) || changed
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not record payload reference")
+	}
+
+	return nil
+}
+
+// updatePayloadRefs changes the references recorded for a payload. They are
+// written using compare-and-set, so that concurrent changes, such as from
+// dead-lettering and requeuing events sharing a payload, are not lost.
+// Update returns false if nothing needs to be changed.
+func (m *Manager) updatePayloadRefs(ctx context.Context, name string, update func(*payloadRefs) bool) error {
+	bucket, err := m.getPayloadRefsBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; attempt < maxPayloadUpdateAttempts; attempt++ {
+		entry, refs, err := getPayloadRefs(ctx, bucket, name)
+		if err != nil {
+			return err
+		}
+
+		if refs.Deleted {
+			return errors.Wrapf(ErrPayloadNotFound, "object %s", name)
+		}
+
+		if !update(refs) {
+			return nil
+		}
+
+		data, err := json.Marshal(refs)
+		if err != nil {
+			return errors.Wrap(err, "could not encode payload references")
+		}
+
+		_, err = bucket.Update(ctx, name, data, entry.Revision())
+		if isRevisionMismatch(err) {
+			// Changed by someone else, try again with the new references
+			continue
+		} else if err != nil {
+			return errors.Wrap(err, "could not update payload references")
+		}
+
+		return nil
+	}
+
+	return errors.Newf("could not update payload %s, it is changed too often", name)
+}
+
+// getPayloadRefs gets the references recorded for a payload.
+func getPayloadRefs(ctx context.Context, bucket jetstream.KeyValue, name string) (jetstream.KeyValueEntry, *payloadRefs, error) {
+	entry, err := bucket.Get(ctx, name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil, errors.Wrapf(ErrPayloadNotFound, "object %s", name)
+	} else if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get payload references")
+	}
+
+	var refs payloadRefs
+	err = json.Unmarshal(entry.Value(), &refs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decode payload references")
+	}
+
+	return entry, &refs, nil
+}
+
+// addRef adds an event referencing the payload. Returns if it was not
+// already recorded.
+func (r *payloadRefs) addRef(ref string) bool {
+	if slices.Contains(r.Refs, ref) {
+		return false
+	}
+
+	r.Refs = append(r.Refs, ref)
+	return true
+}
+
+// removePending removes a pending event. Returns if it was found.
+func (r *payloadRefs) removePending(pending string) bool {
+	i := slices.Index(r.Pending, pending)
+	if i < 0 {
+		return false
+	}
+
+	r.Pending = slices.Delete(r.Pending, i, i+1)
+	return true
+}
+
+// deletePayload removes a payload that no event references, such as when
+// publishing its event failed. The object is removed before its references,
+// so that it is never left without them.
+func (m *Manager) deletePayload(ctx context.Context, name string) {
+	store, err := m.getPayloadStore(ctx)
+	if err == nil {
+		err = store.Delete(ctx, name)
+		if errors.Is(err, jetstream.ErrObjectNotFound) {
+			err = nil
+		}
+	}
+
+	if err == nil {
+		var bucket jetstream.KeyValue
+		bucket, err = m.getPayloadRefsBucket(ctx)
+		if err == nil {
+			err = bucket.Purge(ctx, name)
+		}
+	}
+
+	if err != nil {
+		// Left for the cleanup to remove
+		m.logger.Warn("Could not delete payload", zap.String("object", name), zap.Error(err))
+	}
+}
+
+// publishedPayload records the result of publishing an event with an
+// offloaded payload. If the event was a duplicate the payload is not
+// referenced by anything and is removed. This runs after the caller is
+// done with the publish, so it uses its own context. If recording fails
+// the pending event is resolved by the cleanup instead.
+func (m *Manager) publishedPayload(name string, pending string, ack *jetstream.PubAck) {
+	ctx, cancel := context.WithTimeout(context.Background(), payloadUpdateTimeout)
+	defer cancel()
+
+	if ack.Duplicate {
+		m.deletePayload(ctx, name)
+		return
+	}
+
+	headers := nats.Header{}
+	headers.Set(headerPayloadRef, name)
+	err := m.addPayloadReference(ctx, headers, pending, ack.Stream, ack.Sequence)
+	if err != nil {
+		m.logger.Warn(
+			"Could not record reference to offloaded payload, leaving it for cleanup",
+			zap.String("object", name),
+			zap.String("stream", ack.Stream),
+			zap.Uint64("seq", ack.Sequence),
+			zap.Error(err),
+		)
+	}
+}
+
+// failedPayload removes the payload of an event that was not published.
+func (m *Manager) failedPayload(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), payloadUpdateTimeout)
+	defer cancel()
+
+	m.deletePayload(ctx, name)
+}
+
+// StartPayloadCleanup starts removing offloaded payloads once the events
+// referencing them have been removed from their streams, such as by the
+// retention of the stream. Returns a function that stops the cleanup.
+func (m *Manager) StartPayloadCleanup(ctx context.Context) (func(), error) {
+	_, err := m.getPayloadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.getPayloadRefsBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cleanup outlives the context used to start it
+	cleanupCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(m.config.PayloadCleanupInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-cleanupCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := m.cleanupPayloads(cleanupCtx)
+			if err != nil && cleanupCtx.Err() == nil {
+				m.logger.Warn("Could not clean up payloads", zap.Error(err))
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
+
+// cleanupPayloads removes payloads that are no longer referenced by any
+// event. Events that were being published with a payload are looked up in
+// their stream before the payload is removed.
+func (m *Manager) cleanupPayloads(ctx context.Context) error {
+	bucket, err := m.getPayloadRefsBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	lister, err := bucket.ListKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not list payloads")
+	}
+	defer lister.Stop() //nolint:errcheck
+
+	var names []string
+	for name := range lister.Keys() {
+		names = append(names, name)
+	}
+
+	streams := make(map[string]jetstream.Stream)
+	for _, name := range names {
+		entry, refs, err := getPayloadRefs(ctx, bucket, name)
+		if errors.Is(err, ErrPayloadNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if !refs.Deleted {
+			referenced, err := m.isPayloadReferenced(ctx, streams, refs.Refs)
+			if err != nil {
+				return err
+			}
+
+			if !referenced && len(refs.Pending) > 0 {
+				referenced, err = m.resolvePendingPayload(ctx, name, refs.Pending)
+				if err != nil {
+					return err
+				}
+			}
+
+			if referenced {
+				continue
+			}
+
+			// Mark the payload as deleted, unless a reference was
+			// recorded while checking
+			_, err = bucket.Update(ctx, name, []byte(`{"deleted":true}`), entry.Revision())
+			if isRevisionMismatch(err) {
+				continue
+			} else if err != nil {
+				return errors.Wrap(err, "could not update payload references")
+			}
+		}
+
+		m.logger.Debug("Removing unreferenced payload", zap.String("object", name))
+		m.deletePayload(ctx, name)
+	}
+
+	err = bucket.PurgeDeletes(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not purge removed payloads")
+	}
+
+	return nil
+}
+
+// resolvePendingPayload looks up the events that were being published with a
+// payload but whose reference was never recorded, such as if the server
+// stopped while publishing. Found events are recorded as references. Returns
+// if the payload is referenced, or may still be referenced by an event being
+// published.
+func (m *Manager) resolvePendingPayload(ctx context.Context, name string, pending []string) (bool, error) {
+	referenced := false
+	for _, value := range pending {
+		subject, at, ok := parsePendingPayloadRef(value)
+		if ok && time.Since(at) < payloadPendingTimeout+payloadClockSkew {
+			// Still being published
+			referenced = true
+			continue
+		}
+
+		var refs []string
+		if ok {
+			var err error
+			refs, err = m.findPayloadReferences(ctx, name, subject, at)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		err := m.updatePayloadRefs(ctx, name, func(r *payloadRefs) bool {
+			changed := r.removePending(value)
+			for _, ref := range refs {
+				changed = r.addRef(ref) || changed
+			}
+
+			return changed
+		})
+		if errors.Is(err, ErrPayloadNotFound) {
+			return true, nil
+		} else if err != nil {
+			return false, err
+		}
+
+		if len(refs) > 0 {
+			referenced = true
+		}
+	}
+
+	return referenced, nil
+}
+
+// findPayloadReferences finds events published to a subject around the time
+// a pending event was recorded that reference a payload. Returns them as
+// `stream:seq` values.
+func (m *Manager) findPayloadReferences(ctx context.Context, name string, subject string, at time.Time) ([]string, error) {
+	streamName, err := m.js.StreamNameBySubject(ctx, subject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		// Nothing could have been published
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not find stream")
+	}
+
+	start := at.Add(-payloadClockSkew)
+	end := at.Add(payloadPendingTimeout + payloadClockSkew)
+	consumer, err := m.js.OrderedConsumer(ctx, streamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverByStartTimePolicy,
+		OptStartTime:   &start,
+		HeadersOnly:    true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create consumer")
+	}
+
+	var refs []string
+	for {
+		batch, err := consumer.FetchNoWait(100)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch events")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			metadata, err := msg.Metadata()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not get event metadata")
+			}
+
+			if metadata.Timestamp.After(end) {
+				return refs, nil
+			}
+
+			caughtUp = metadata.NumPending == 0
+			if msg.Headers().Get(headerPayloadRef) == name {
+				refs = append(refs, streamName+":"+strconv.FormatUint(metadata.Sequence.Stream, 10))
+			}
+		}
+
+		if batch.Error() != nil {
+			return nil, errors.Wrap(batch.Error(), "could not fetch events")
+		}
+
+		if received == 0 || caughtUp {
+			return refs, nil
+		}
+	}
+}
+
+// isPayloadReferenced checks if any of the events referencing a payload
+// still exists.
+func (m *Manager) isPayloadReferenced(ctx context.Context, streams map[string]jetstream.Stream, refs []string) (bool, error) {
+	for _, ref := range refs {
+		streamName, seqValue, ok := strings.Cut(ref, ":")
+		seq, err := strconv.ParseUint(seqValue, 10, 64)
+		if !ok || err != nil {
+			continue
+		}
+
+		stream, ok := streams[streamName]
+		if !ok {
+			stream, err = m.js.Stream(ctx, streamName)
+			if errors.Is(err, jetstream.ErrStreamNotFound) {
+				continue
+			} else if err != nil {
+				return false, errors.Wrap(err, "could not get stream")
+			}
+
+			streams[streamName] = stream
+		}
+
+		_, err = stream.GetMsg(ctx, seq)
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			continue
+		} else if err != nil {
+			return false, errors.Wrap(err, "could not get event")
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// isRevisionMismatch checks if an error is caused by a key having been
+// changed since it was read.
+func isRevisionMismatch(err error) bool {
+	var apiError *jetstream.APIError
+	if errors.As(err, &apiError) {
+		return apiError.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence
+	}
+
+	return false
+}
@@ -0,0 +1,327 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Offloaded payloads", func() {
+	var manager *events.Manager
+	var js jetstream.JetStream
+
+	BeforeEach(func(ctx context.Context) {
+		natsConn := GetNATS()
+
+		var err error
+		js, err = jetstream.New(natsConn)
+		Expect(err).ToNot(HaveOccurred())
+
+		manager, err = events.NewManager(
+			zaptest.NewLogger(GinkgoT()),
+			otel.Tracer("tests"),
+			natsConn,
+			js,
+			createSchemaManager(js),
+			&events.Config{
+				PayloadOffloadThreshold: 1024,
+				PayloadCleanupInterval:  100 * time.Millisecond,
+				AckTokenKey:             testAckTokenKey,
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	large := strings.Repeat("a", 4096)
+
+	publish := func(ctx context.Context, value string, idempotencyKey string) uint64 {
+		GinkgoHelper()
+
+		published, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject:        "events.test",
+			Data:           Data(wrapperspb.String(value)),
+			IdempotencyKey: idempotencyKey,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return published.ID
+	}
+
+	objects := func(ctx context.Context) []*jetstream.ObjectInfo {
+		GinkgoHelper()
+
+		store, err := js.ObjectStore(ctx, events.PayloadBucketName)
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err := store.List(ctx)
+		if errors.Is(err, jetstream.ErrNoObjectsFound) {
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return list
+	}
+
+	refsBucket := func(ctx context.Context) jetstream.KeyValue {
+		GinkgoHelper()
+
+		bucket, err := js.KeyValue(ctx, events.PayloadRefsBucketName)
+		Expect(err).ToNot(HaveOccurred())
+		return bucket
+	}
+
+	type payloadRefs struct {
+		Refs    []string `json:"refs,omitempty"`
+		Pending []string `json:"pending,omitempty"`
+	}
+
+	refsOf := func(ctx context.Context, name string) payloadRefs {
+		GinkgoHelper()
+
+		entry, err := refsBucket(ctx).Get(ctx, name)
+		Expect(err).ToNot(HaveOccurred())
+
+		var refs payloadRefs
+		Expect(json.Unmarshal(entry.Value(), &refs)).To(Succeed())
+		return refs
+	}
+
+	setRefs := func(ctx context.Context, name string, refs payloadRefs) {
+		GinkgoHelper()
+
+		data, err := json.Marshal(&refs)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = refsBucket(ctx).Put(ctx, name, data)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	fetchValue := func(ctx context.Context) string {
+		GinkgoHelper()
+
+		fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+			Stream:    "events",
+			Name:      "test",
+			MaxEvents: 1,
+			MaxWait:   100 * time.Millisecond,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetched).To(HaveLen(1))
+
+		var value wrapperspb.StringValue
+		Expect(fetched[0].Data.UnmarshalTo(&value)).To(Succeed())
+		Expect(fetched[0].Ack()).To(Succeed())
+		return value.Value
+	}
+
+	It("stores large data outside of the stream", func(ctx context.Context) {
+		id := publish(ctx, large, "")
+
+		stream, err := js.Stream(ctx, "events")
+		Expect(err).ToNot(HaveOccurred())
+		msg, err := stream.GetMsg(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg.Data).To(BeEmpty())
+		Expect(msg.Header.Get("WS-Payload-Ref")).ToNot(BeEmpty())
+
+		Expect(objects(ctx)).To(HaveLen(1))
+	})
+
+	It("keeps small data in the stream", func(ctx context.Context) {
+		id := publish(ctx, "small", "")
+
+		stream, err := js.Stream(ctx, "events")
+		Expect(err).ToNot(HaveOccurred())
+		msg, err := stream.GetMsg(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg.Data).ToNot(BeEmpty())
+		Expect(msg.Header.Get("WS-Payload-Ref")).To(BeEmpty())
+	})
+
+	It("fetched events contain the data", func(ctx context.Context) {
+		publish(ctx, large, "")
+
+		Expect(fetchValue(ctx)).To(Equal(large))
+	})
+
+	It("consumed events contain the data", NodeTimeout(5*time.Second), func(ctx context.Context) {
+		ec, err := manager.Events(ctx, &events.EventConsumeConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+
+		publish(ctx, large, "")
+
+		select {
+		case event := <-ec.Incoming():
+			var value wrapperspb.StringValue
+			Expect(event.Data.UnmarshalTo(&value)).To(Succeed())
+			Expect(value.Value).To(Equal(large))
+			Expect(event.Ack()).To(Succeed())
+		case <-ctx.Done():
+			Fail("event was not received")
+		}
+	})
+
+	It("duplicate events do not store their data", func(ctx context.Context) {
+		id1 := publish(ctx, large, "test")
+		id2 := publish(ctx, large, "test")
+		Expect(id2).To(Equal(id1))
+
+		Expect(objects(ctx)).To(HaveLen(1))
+	})
+
+	It("data is removed when the event is removed", func(ctx context.Context) {
+		stop, err := manager.StartPayloadCleanup(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		keep := publish(ctx, large, "")
+		remove := publish(ctx, large, "")
+		Expect(objects(ctx)).To(HaveLen(2))
+
+		stream, err := js.Stream(ctx, "events")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stream.DeleteMsg(ctx, remove)).To(Succeed())
+
+		Eventually(objects).WithContext(ctx).Should(HaveLen(1))
+		Consistently(objects).WithContext(ctx).WithTimeout(300 * time.Millisecond).Should(HaveLen(1))
+
+		msg, err := stream.GetMsg(ctx, keep)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects(ctx)[0].Name).To(Equal(msg.Header.Get("WS-Payload-Ref")))
+	})
+
+	It("events reference their data once published", func(ctx context.Context) {
+		id := publish(ctx, large, "")
+
+		list := objects(ctx)
+		Expect(list).To(HaveLen(1))
+		Expect(refsOf(ctx, list[0].Name)).To(Equal(payloadRefs{
+			Refs: []string{fmt.Sprintf("events:%d", id)},
+		}))
+	})
+
+	It("data of events without a recorded reference is kept", func(ctx context.Context) {
+		id := publish(ctx, large, "")
+
+		// Replace the reference with a pending event, as if the server
+		// stopped before the reference was recorded. The event was published
+		// at most two minutes after the pending event was recorded, and
+		// pending events are resolved once they are older than that
+		object := objects(ctx)[0]
+		pendingAt := time.Now().Add(-2 * time.Minute)
+		setRefs(ctx, object.Name, payloadRefs{
+			Pending: []string{fmt.Sprintf("events.test@%d", pendingAt.UnixNano())},
+		})
+
+		stop, err := manager.StartPayloadCleanup(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		Eventually(func(g Gomega, ctx context.Context) {
+			g.Expect(objects(ctx)).To(HaveLen(1))
+			g.Expect(refsOf(ctx, object.Name)).To(Equal(payloadRefs{
+				Refs: []string{fmt.Sprintf("events:%d", id)},
+			}))
+		}).WithContext(ctx).Should(Succeed())
+	})
+
+	It("data of events that were never published is removed", func(ctx context.Context) {
+		// Starting the cleanup creates the buckets
+		stop, err := manager.StartPayloadCleanup(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		store, err := js.ObjectStore(ctx, events.PayloadBucketName)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Objects are only looked at by the cleanup once they have references
+		// recorded, so store them first
+		_, err = store.PutString(ctx, "unpublished", large)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = store.PutString(ctx, "publishing", large)
+		Expect(err).ToNot(HaveOccurred())
+
+		pendingAt := time.Now().Add(-5 * time.Minute)
+		setRefs(ctx, "unpublished", payloadRefs{
+			Pending: []string{fmt.Sprintf("events.test@%d", pendingAt.UnixNano())},
+		})
+
+		// A recent pending event is still being published
+		setRefs(ctx, "publishing", payloadRefs{
+			Pending: []string{fmt.Sprintf("events.test@%d", time.Now().UnixNano())},
+		})
+
+		Eventually(objects).WithContext(ctx).Should(HaveLen(1))
+		Expect(objects(ctx)[0].Name).To(Equal("publishing"))
+
+		_, err = refsBucket(ctx).Get(ctx, "unpublished")
+		Expect(err).To(MatchError(jetstream.ErrKeyNotFound))
+	})
+
+	It("scheduled events keep their data", NodeTimeout(5*time.Second), func(ctx context.Context) {
+		stop, err := manager.StartScheduler(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		deliverAt := time.Now().Add(200 * time.Millisecond)
+		_, err = manager.Publish(ctx, &events.PublishConfig{
+			Subject:   "events.test",
+			Data:      Data(wrapperspb.String(large)),
+			DeliverAt: &deliverAt,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err := manager.ListScheduledEvents(ctx, &events.ListScheduledEventsConfig{
+			Limit: 10,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Events).To(HaveLen(1))
+
+		var value wrapperspb.StringValue
+		Expect(list.Events[0].Data.UnmarshalTo(&value)).To(Succeed())
+		Expect(value.Value).To(Equal(large))
+
+		Eventually(func(ctx context.Context) int {
+			fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+				Stream:    "events",
+				Name:      "test",
+				MaxEvents: 1,
+				MaxWait:   100 * time.Millisecond,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			for _, event := range fetched {
+				var value wrapperspb.StringValue
+				Expect(event.Data.UnmarshalTo(&value)).To(Succeed())
+				Expect(value.Value).To(Equal(large))
+				Expect(event.Ack()).To(Succeed())
+			}
+			return len(fetched)
+		}).WithContext(ctx).Should(Equal(1))
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
 	"go.opentelemetry.io/otel/trace"
@@ -27,16 +28,85 @@ type PublishConfig struct {
 	PublishedTime *time.Time
 	// IdempotencyKey is the idempotency key for the event. If empty, the event will not be idempotent.
 	IdempotencyKey string
+	// Headers are custom headers to store with the event. Optional.
+	Headers map[string]string
+	// DeliverAt schedules the event to be published at a later time. If nil,
+	// the event is published directly.
+	DeliverAt *time.Time
 }
 
 // PublishedEvent contains information about a published event.
 type PublishedEvent struct {
-	// ID is the sequence number of the event.
+	// ID is the sequence number of the event. Zero if the event was
+	// scheduled.
 	ID uint64
+	// ScheduledID is the ID of the scheduled event, used to cancel it. Only
+	// set if the event was scheduled.
+	ScheduledID uint64
+}
+
+// PendingPublish is an event that has been sent to NATS but whose
+// publishing has not been confirmed yet.
+type PendingPublish struct {
+	manager   *Manager
+	span      trace.Span
+	future    jetstream.PubAckFuture
+	scheduled bool
+	// payload is the name of the object the data was offloaded to, if any.
+	payload string
+	// payloadPending is the pending event recorded for the payload.
+	payloadPending string
+}
+
+// PublishResult is the result of publishing one event in a batch.
+type PublishResult struct {
+	// Event is set if the event was published.
+	Event *PublishedEvent
+	// Err is set if the event could not be published.
+	Err error
 }
 
 // Publish publishes an event to a stream.
 func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*PublishedEvent, error) {
+	pending, err := m.PublishAsync(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return pending.Wait(ctx)
+}
+
+// PublishBatch publishes several events. All events are sent before waiting
+// for any confirmation, so a batch is much faster than publishing events one
+// by one. The results are in the same order as the configs.
+func (m *Manager) PublishBatch(ctx context.Context, configs []*PublishConfig) []*PublishResult {
+	results := make([]*PublishResult, len(configs))
+	pending := make([]*PendingPublish, len(configs))
+	for i, config := range configs {
+		p, err := m.PublishAsync(ctx, config)
+		if err != nil {
+			results[i] = &PublishResult{Err: err}
+			continue
+		}
+
+		pending[i] = p
+	}
+
+	for i, p := range pending {
+		if p == nil {
+			continue
+		}
+
+		event, err := p.Wait(ctx)
+		results[i] = &PublishResult{Event: event, Err: err}
+	}
+
+	return results
+}
+
+// PublishAsync sends an event to NATS without waiting for it to be stored.
+// Use Wait on the returned PendingPublish to get the result.
+func (m *Manager) PublishAsync(ctx context.Context, config *PublishConfig) (*PendingPublish, error) {
 	ctx, span := m.tracer.Start(
 		ctx,
 		config.Subject+" publish",
@@ -47,18 +117,34 @@ func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*Publishe
 			semconv.MessagingDestinationName(config.Subject),
 		),
 	)
-	defer span.End()
 
 	if !IsValidSubject(config.Subject, false) {
 		span.SetStatus(codes.Error, "invalid subject")
+		span.End()
 		return nil, newValidationError("invalid subject: " + config.Subject)
 	}
 
 	if config.Data == nil {
 		span.SetStatus(codes.Error, "no data specified")
+		span.End()
 		return nil, newValidationError("no data specified")
 	}
 
+	err := validateCustomHeaders(config.Headers)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid headers")
+		span.End()
+		return nil, err
+	}
+
+	err = m.schemas.ValidateEvent(ctx, config.Subject, config.Data)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid data")
+		span.End()
+		return nil, err
+	}
+
 	// Create the message
 	msg := &nats.Msg{
 		Subject: config.Subject,
@@ -67,12 +153,13 @@ func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*Publishe
 
 	publishOpts := []jetstream.PublishOpt{}
 
-	// Set the published time
-	publishTime := time.Now()
+	// Set the published time, scheduled events default to when they are
+	// published by the scheduler
 	if config.PublishedTime != nil {
-		publishTime = *config.PublishedTime
+		msg.Header.Set("WS-Published-Time", config.PublishedTime.Format(time.RFC3339Nano))
+	} else if config.DeliverAt == nil {
+		msg.Header.Set("WS-Published-Time", time.Now().Format(time.RFC3339Nano))
 	}
-	msg.Header.Set("WS-Published-Time", publishTime.Format(time.RFC3339Nano))
 
 	// Set the idempotency key
 	if config.IdempotencyKey != "" {
@@ -84,6 +171,9 @@ func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*Publishe
 		publishOpts = append(publishOpts, jetstream.WithExpectLastSequencePerSubject(*config.ExpectedSubjectSeq))
 	}
 
+	// Set the custom headers
+	setCustomHeaders(msg.Header, config.Headers)
+
 	// Inject the tracing headers
 	m.w3cPropagator.Inject(ctx, eventTracingHeaders{
 		headers: &msg.Header,
@@ -93,39 +183,105 @@ func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*Publishe
 	msg.Header.Set("WS-Data-Type", string(config.Data.MessageName()))
 	msg.Data = config.Data.Value
 
+	if config.DeliverAt != nil {
+		err = m.prepareScheduled(ctx, msg, config)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to schedule event")
+			span.End()
+			return nil, err
+		}
+
+		span.SetAttributes(attribute.String("deliver_at", config.DeliverAt.Format(time.RFC3339Nano)))
+	}
+
+	// Large data is stored outside of the stream
+	payload, payloadPending, err := m.offloadPayload(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to offload data")
+		span.End()
+		return nil, err
+	}
+
+	if payload != "" {
+		span.SetAttributes(attribute.String("payload", payload))
+	}
+
 	m.logger.Debug(
 		"Publishing event",
 		zap.String("subject", config.Subject),
 		zap.String("dataType", config.Data.TypeUrl),
 		zap.Any("headers", msg.Header),
+		zap.Timep("deliverAt", config.DeliverAt),
 	)
 
 	// Publish the message.
 	f, err := m.js.PublishMsgAsync(msg, publishOpts...)
 	if err != nil {
+		if payload != "" {
+			m.failedPayload(payload)
+		}
+
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to publish message")
+		span.End()
 		return nil, errors.Wrap(err, "failed to publish message")
 	}
 
+	return &PendingPublish{
+		manager:   m,
+		span:      span,
+		future:    f,
+		scheduled: config.DeliverAt != nil,
+		payload:   payload,
+
+		payloadPending: payloadPending,
+	}, nil
+}
+
+// Wait waits for the event to be stored in its stream.
+func (p *PendingPublish) Wait(ctx context.Context) (*PublishedEvent, error) {
+	span := p.span
+	defer span.End()
+
 	select {
 	case <-ctx.Done():
 		// We don't know if the message was published or not, so the trace
 		// will be marked as unset.
 		span.SetStatus(codes.Unset, "context canceled")
+		if p.payload != "" {
+			go p.waitForPayload()
+		}
 		return nil, errors.Wrapf(ctx.Err(), "failed to publish message")
-	case ack := <-f.Ok():
+	case ack := <-p.future.Ok():
 		span.SetAttributes(
 			semconv.MessagingMessageID(fmt.Sprintf("%d", ack.Sequence)),
 		)
 		span.SetStatus(codes.Ok, "")
+		if p.payload != "" {
+			p.manager.publishedPayload(p.payload, p.payloadPending, ack)
+		}
+
+		if p.scheduled {
+			return &PublishedEvent{
+				ScheduledID: ack.Sequence,
+			}, nil
+		}
+
 		return &PublishedEvent{
 			ID: ack.Sequence,
 		}, nil
-	case err := <-f.Err():
+	case err := <-p.future.Err():
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to publish message")
 
+		if p.payload != "" && !errors.Is(err, nats.ErrTimeout) {
+			// Nothing references the data, unless the publish timed out in
+			// which case the event may have been stored
+			p.manager.failedPayload(p.payload)
+		}
+
 		if errors.Is(err, jetstream.ErrNoStreamResponse) || errors.Is(err, nats.ErrNoResponders) {
 			return nil, ErrUnboundSubject
 		} else if errors.Is(err, nats.ErrTimeout) {
@@ -142,3 +298,19 @@ func (m *Manager) Publish(ctx context.Context, config *PublishConfig) (*Publishe
 		return nil, errors.Wrap(err, "failed to publish message")
 	}
 }
+
+// waitForPayload records or removes the offloaded data of an event once
+// the result of publishing it is known, for when the caller stopped waiting
+// for it.
+func (p *PendingPublish) waitForPayload() {
+	select {
+	case <-time.After(payloadPendingTimeout):
+		// Left for the cleanup to handle
+	case ack := <-p.future.Ok():
+		p.manager.publishedPayload(p.payload, p.payloadPending, ack)
+	case err := <-p.future.Err():
+		if !errors.Is(err, nats.ErrTimeout) {
+			p.manager.failedPayload(p.payload)
+		}
+	}
+}
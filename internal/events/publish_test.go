@@ -262,6 +262,143 @@ var _ = Describe("Publish", func() {
 		Expect(stream.CachedInfo().State.Msgs).To(Equal(uint64(1)))
 	})
 
+	Describe("Batches", func() {
+		It("can publish a batch of events", func(ctx context.Context) {
+			results := manager.PublishBatch(ctx, []*events.PublishConfig{
+				{Subject: "events.a", Data: Data(&emptypb.Empty{})},
+				{Subject: "events.b", Data: Data(&emptypb.Empty{})},
+				{Subject: "events.c", Data: Data(&emptypb.Empty{})},
+			})
+			Expect(results).To(HaveLen(3))
+			for i, result := range results {
+				Expect(result.Err).ToNot(HaveOccurred())
+				Expect(result.Event.ID).To(Equal(uint64(i + 1)))
+			}
+
+			stream, err := js.Stream(ctx, "events")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.CachedInfo().State.Msgs).To(Equal(uint64(3)))
+		})
+
+		It("events in a batch fail on their own", func(ctx context.Context) {
+			results := manager.PublishBatch(ctx, []*events.PublishConfig{
+				{Subject: "events.a", Data: Data(&emptypb.Empty{})},
+				{Subject: "events.>", Data: Data(&emptypb.Empty{})},
+				{Subject: "unbound", Data: Data(&emptypb.Empty{})},
+				{Subject: "events.b", Data: Data(&emptypb.Empty{})},
+			})
+			Expect(results).To(HaveLen(4))
+			Expect(results[0].Err).ToNot(HaveOccurred())
+			Expect(events.IsValidationError(results[1].Err)).To(BeTrue())
+			Expect(results[2].Err).To(MatchError(events.ErrUnboundSubject))
+			Expect(results[3].Err).ToNot(HaveOccurred())
+			Expect(results[3].Event.ID).To(Equal(uint64(2)))
+		})
+	})
+
+	Describe("Custom headers", func() {
+		It("are stored with the event", func(ctx context.Context) {
+			e, err := manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant":      "acme",
+					"causationId": "1234",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := js.Stream(ctx, "events")
+			Expect(err).ToNot(HaveOccurred())
+
+			msg, err := stream.GetMsg(ctx, e.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(msg.Header["WS-Custom-tenant"]).To(Equal([]string{"acme"}))
+			Expect(msg.Header["WS-Custom-causationId"]).To(Equal([]string{"1234"}))
+		})
+
+		It("are returned to consumers", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "events",
+				Name:     "test",
+				Subjects: []string{"events.>"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant":      "acme",
+					"causationId": "1234",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+				Stream:    "events",
+				Name:      "test",
+				MaxEvents: 1,
+				MaxWait:   100 * time.Millisecond,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched).To(HaveLen(1))
+			Expect(fetched[0].Headers.Custom).To(Equal(map[string]string{
+				"tenant":      "acme",
+				"causationId": "1234",
+			}))
+		})
+
+		It("are nil for events without custom headers", func(ctx context.Context) {
+			_, err := manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+				Stream:   "events",
+				Name:     "test",
+				Subjects: []string{"events.>"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			fetched, err := manager.Fetch(ctx, &events.FetchConfig{
+				Stream:    "events",
+				Name:      "test",
+				MaxEvents: 1,
+				MaxWait:   100 * time.Millisecond,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetched).To(HaveLen(1))
+			Expect(fetched[0].Headers.Custom).To(BeNil())
+		})
+
+		It("invalid header name fails", func(ctx context.Context) {
+			_, err := manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant id": "acme",
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("header value with line break fails", func(ctx context.Context) {
+			_, err := manager.Publish(ctx, &events.PublishConfig{
+				Subject: "events.test",
+				Data:    Data(&emptypb.Empty{}),
+				Headers: map[string]string{
+					"tenant": "acme\r\nWS-Data-Type: test",
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
 	Describe("OpenTelemetry", func() {
 		var tracer trace.Tracer
 
@@ -0,0 +1,463 @@
+package events
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.18.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ScheduledStreamName is the name of the stream that scheduled events are
+// kept in until they are due.
+const ScheduledStreamName = "windshift-scheduled"
+
+const (
+	// scheduledSubjectPrefix is the subject prefix scheduled events are
+	// stored under, followed by the subject they will be published to.
+	scheduledSubjectPrefix = "$WS.SCHEDULED."
+	// schedulerConsumerName is the durable consumer shared by all server
+	// instances to publish scheduled events when they are due.
+	schedulerConsumerName = "windshift-scheduler"
+	// headerScheduledTime is the header holding when a scheduled event is
+	// due.
+	headerScheduledTime = "WS-Scheduled-Time"
+)
+
+// ScheduledEvent is an event waiting to be published.
+type ScheduledEvent struct {
+	// ID is the sequence number of the event in the scheduled stream. Used
+	// to cancel the event.
+	ID uint64
+	// Subject is the subject the event will be published to.
+	Subject string
+	// DeliverAt is when the event will be published.
+	DeliverAt time.Time
+	// Headers contains the headers the event will be published with. If no
+	// timestamp was given when scheduling PublishedAt is the same as
+	// DeliverAt.
+	Headers *Headers
+	// Data is the protobuf message that will be published.
+	Data *anypb.Any
+}
+
+// ListScheduledEventsConfig is the configuration for listing scheduled
+// events.
+type ListScheduledEventsConfig struct {
+	// Subject limits the events to those that will be published to subjects
+	// matching it, may contain wildcards. Optional.
+	Subject string
+	// After is the ID of the event to list events after, used for paging.
+	After uint64
+	// Limit is the maximum number of events to return.
+	Limit uint
+}
+
+// ScheduledEventList is a page of scheduled events.
+type ScheduledEventList struct {
+	// Events in the page, ordered by ID.
+	Events []*ScheduledEvent
+	// HasMore indicates if there are more events after this page.
+	HasMore bool
+}
+
+// ensureScheduledStream makes sure that the stream holding scheduled events
+// exists.
+func (m *Manager) ensureScheduledStream(ctx context.Context) error {
+	m.scheduledStreamMu.Lock()
+	defer m.scheduledStreamMu.Unlock()
+
+	if m.scheduledStreamReady {
+		return nil
+	}
+
+	_, err := m.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        ScheduledStreamName,
+		Description: "Events waiting to be published at a later time",
+		Subjects: []string{
+			scheduledSubjectPrefix + ">",
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create scheduled stream")
+	}
+
+	m.scheduledStreamReady = true
+	return nil
+}
+
+// prepareScheduled changes a message so that it is stored in the scheduled
+// stream instead of being published directly.
+func (m *Manager) prepareScheduled(ctx context.Context, msg *nats.Msg, config *PublishConfig) error {
+	if config.ExpectedSubjectSeq != nil {
+		return newValidationError("expected last id can not be used with scheduled events")
+	}
+
+	// Check the subject now, as the event can not be rejected later
+	_, err := m.js.StreamNameBySubject(ctx, config.Subject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return ErrUnboundSubject
+	} else if err != nil {
+		return errors.Wrap(err, "could not find stream for subject")
+	}
+
+	err = m.ensureScheduledStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg.Subject = scheduledSubjectPrefix + config.Subject
+	msg.Header.Set(headerScheduledTime, config.DeliverAt.Format(time.RFC3339Nano))
+	return nil
+}
+
+// StartScheduler starts publishing scheduled events when they are due. The
+// work is shared between all server instances, and every event is only
+// handled by one of them at a time. The returned function stops publishing.
+func (m *Manager) StartScheduler(ctx context.Context) (func(), error) {
+	err := m.ensureScheduledStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := m.js.Stream(ctx, ScheduledStreamName)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get scheduled stream")
+	}
+
+	// Events that are not due are held by the consumer until they are, so
+	// neither the number of deliveries nor the pending events are limited
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:       schedulerConsumerName,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       30 * time.Second,
+		MaxDeliver:    -1,
+		MaxAckPending: -1,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create scheduler consumer")
+	}
+
+	// Processing outlives the context used to start it
+	processCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		delay, err2 := m.publishScheduled(processCtx, stream, msg)
+		if err2 != nil {
+			m.logger.Warn("Could not publish scheduled event", zap.String("subject", msg.Subject()), zap.Error(err2))
+			_ = msg.NakWithDelay(10 * time.Second)
+			return
+		} else if delay > 0 {
+			_ = msg.NakWithDelay(delay)
+			return
+		}
+
+		_ = msg.Ack()
+	})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not consume scheduled events")
+	}
+
+	return func() {
+		consumeCtx.Stop()
+		cancel()
+	}, nil
+}
+
+// publishScheduled publishes a scheduled event if it is due and removes it
+// from the scheduled stream. Returns how long to wait if the event is not
+// due yet.
+func (m *Manager) publishScheduled(ctx context.Context, stream jetstream.Stream, msg jetstream.Msg) (time.Duration, error) {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get scheduled event metadata")
+	}
+
+	headers := msg.Headers()
+	event, err := toScheduledEvent(metadata.Sequence.Stream, msg.Subject(), headers, msg.Data())
+	if err != nil {
+		// Not something that will succeed if retried
+		m.logger.Warn("Could not decode scheduled event", zap.Uint64("id", metadata.Sequence.Stream), zap.Error(err))
+		return 0, m.removeScheduled(ctx, stream, metadata.Sequence.Stream)
+	}
+
+	if delay := time.Until(event.DeliverAt); delay > 0 {
+		return delay, nil
+	}
+
+	// Offloaded data is published like any other data, which offloads it
+	// again if still needed
+	event.Data.Value, err = m.loadPayload(ctx, headers, event.Data.Value)
+	if errors.Is(err, ErrPayloadNotFound) {
+		m.logger.Warn("Dropping scheduled event without data", zap.Uint64("id", event.ID), zap.Error(err))
+		return 0, m.removeScheduled(ctx, stream, event.ID)
+	} else if err != nil {
+		return 0, err
+	}
+
+	config := &PublishConfig{
+		Subject: event.Subject,
+		Data:    event.Data,
+		Headers: event.Headers.Custom,
+	}
+
+	if headers.Get("WS-Published-Time") != "" {
+		config.PublishedTime = &event.Headers.PublishedAt
+	}
+
+	if event.Headers.IdempotencyKey != nil {
+		config.IdempotencyKey = *event.Headers.IdempotencyKey
+	}
+
+	// Continue the trace the event was scheduled in
+	ctx = m.w3cPropagator.Extract(ctx, eventTracingHeaders{
+		headers: &headers,
+	})
+
+	_, err = m.Publish(ctx, config)
+	if errors.Is(err, ErrUnboundSubject) || errors.Is(err, ErrInvalidData) || IsValidationError(err) {
+		// The subject or its schemas changed after the event was scheduled
+		m.logger.Warn(
+			"Dropping scheduled event that can no longer be published",
+			zap.Uint64("id", event.ID),
+			zap.String("subject", event.Subject),
+			zap.Error(err),
+		)
+	} else if err != nil {
+		return 0, err
+	}
+
+	return 0, m.removeScheduled(ctx, stream, event.ID)
+}
+
+// removeScheduled removes an event that has been handled from the scheduled
+// stream.
+func (m *Manager) removeScheduled(ctx context.Context, stream jetstream.Stream, id uint64) error {
+	err := stream.DeleteMsg(ctx, id)
+	if errors.Is(err, jetstream.ErrMsgDeleteUnsuccessful) {
+		// Already removed, such as by being canceled
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "could not remove scheduled event")
+	}
+
+	return nil
+}
+
+// ListScheduledEvents returns the events that are waiting to be published,
+// in the order they were scheduled.
+func (m *Manager) ListScheduledEvents(ctx context.Context, config *ListScheduledEventsConfig) (*ScheduledEventList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ListScheduledEvents",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("subject", config.Subject),
+		),
+	)
+	defer span.End()
+
+	if config.Subject != "" && !IsValidSubject(config.Subject, true) {
+		span.SetStatus(codes.Error, "invalid subject")
+		return nil, newValidationError("invalid subject: " + config.Subject)
+	}
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	result := &ScheduledEventList{
+		Events: make([]*ScheduledEvent, 0),
+	}
+
+	_, err := m.js.Stream(ctx, ScheduledStreamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		// No events have been scheduled yet
+		span.SetStatus(codes.Ok, "")
+		return result, nil
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "could not get scheduled stream")
+	}
+
+	subject := scheduledSubjectPrefix + ">"
+	if config.Subject != "" {
+		subject = scheduledSubjectPrefix + config.Subject
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, ScheduledStreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+		DeliverPolicy:  jetstream.DeliverByStartSequencePolicy,
+		OptStartSeq:    config.After + 1,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to create consumer")
+		return nil, errors.Wrap(err, "could not create scheduled event consumer")
+	}
+
+	for {
+		batch, err := consumer.FetchNoWait(100)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to fetch scheduled events")
+			return nil, errors.Wrap(err, "could not fetch scheduled events")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			metadata, err := msg.Metadata()
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read scheduled event")
+				return nil, errors.Wrap(err, "could not get scheduled event metadata")
+			}
+
+			caughtUp = metadata.NumPending == 0
+
+			if uint(len(result.Events)) >= config.Limit {
+				result.HasMore = true
+				span.SetStatus(codes.Ok, "")
+				return result, nil
+			}
+
+			event, err := toScheduledEvent(metadata.Sequence.Stream, msg.Subject(), msg.Headers(), msg.Data())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to read scheduled event")
+				return nil, err
+			}
+
+			event.Data.Value, err = m.loadPayload(ctx, msg.Headers(), event.Data.Value)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to load scheduled event data")
+				return nil, err
+			}
+
+			result.Events = append(result.Events, event)
+		}
+
+		if batch.Error() != nil {
+			span.RecordError(batch.Error())
+			span.SetStatus(codes.Error, "failed to fetch scheduled events")
+			return nil, errors.Wrap(batch.Error(), "could not fetch scheduled events")
+		}
+
+		if received == 0 || caughtUp {
+			span.SetStatus(codes.Ok, "")
+			return result, nil
+		}
+	}
+}
+
+// CancelScheduledEvent removes a scheduled event so that it is never
+// published. If the event does not exist, or has already been published,
+// ErrScheduledEventNotFound is returned.
+func (m *Manager) CancelScheduledEvent(ctx context.Context, id uint64) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.CancelScheduledEvent",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+		),
+	)
+	defer span.End()
+
+	stream, err := m.js.Stream(ctx, ScheduledStreamName)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "scheduled event not found")
+		return errors.WithStack(ErrScheduledEventNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return errors.Wrap(err, "could not get scheduled stream")
+	}
+
+	// Deleting does not report if the event exists, so check that first
+	_, err = stream.GetMsg(ctx, id)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		span.SetStatus(codes.Error, "scheduled event not found")
+		return errors.WithStack(ErrScheduledEventNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get scheduled event")
+		return errors.Wrap(err, "could not get scheduled event")
+	}
+
+	err = stream.DeleteMsg(ctx, id)
+	if errors.Is(err, jetstream.ErrMsgDeleteUnsuccessful) {
+		// Published or canceled since it was checked
+		span.SetStatus(codes.Error, "scheduled event not found")
+		return errors.WithStack(ErrScheduledEventNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to cancel scheduled event")
+		return errors.Wrap(err, "could not cancel scheduled event")
+	}
+
+	m.logger.Debug("Canceled scheduled event", zap.Uint64("id", id))
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+func toScheduledEvent(id uint64, subject string, headers nats.Header, data []byte) (*ScheduledEvent, error) {
+	deliverAt, err := time.Parse(time.RFC3339Nano, headers.Get(headerScheduledTime))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse scheduled time")
+	}
+
+	event := &ScheduledEvent{
+		ID:        id,
+		Subject:   strings.TrimPrefix(subject, scheduledSubjectPrefix),
+		DeliverAt: deliverAt,
+		Headers: &Headers{
+			PublishedAt: deliverAt,
+			Custom:      getCustomHeaders(headers),
+		},
+		Data: &anypb.Any{
+			TypeUrl: "type.googleapis.com/" + headers.Get("WS-Data-Type"),
+			Value:   data,
+		},
+	}
+
+	publishTimeHeader := headers.Get("WS-Published-Time")
+	if publishTimeHeader != "" {
+		event.Headers.PublishedAt, err = time.Parse(time.RFC3339Nano, publishTimeHeader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not parse header")
+		}
+	}
+
+	idempotencyKey := headers.Get("Nats-Msg-Id")
+	if idempotencyKey != "" {
+		event.Headers.IdempotencyKey = &idempotencyKey
+	}
+
+	traceParentHeader := headers.Get("WS-Trace-Parent")
+	if traceParentHeader != "" {
+		event.Headers.TraceParent = &traceParentHeader
+	}
+
+	traceStateHeader := headers.Get("WS-Trace-State")
+	if traceStateHeader != "" {
+		event.Headers.TraceState = &traceStateHeader
+	}
+
+	return event, nil
+}
@@ -0,0 +1,166 @@
+package events_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Scheduled events", func() {
+	var manager *events.Manager
+	var ec *events.Events
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		stop, err := manager.StartScheduler(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		_, err = manager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		ec, err = manager.Events(ctx, &events.EventConsumeConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+	})
+
+	schedule := func(ctx context.Context, subject string, deliverAt time.Time) uint64 {
+		GinkgoHelper()
+
+		published, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject:        subject,
+			Data:           Data(&emptypb.Empty{}),
+			IdempotencyKey: "scheduled-" + subject,
+			DeliverAt:      &deliverAt,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(published.ID).To(BeZero())
+		Expect(published.ScheduledID).ToNot(BeZero())
+		return published.ScheduledID
+	}
+
+	listScheduled := func(ctx context.Context) []*events.ScheduledEvent {
+		list, err := manager.ListScheduledEvents(ctx, &events.ListScheduledEventsConfig{
+			Limit: 100,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return list.Events
+	}
+
+	It("scheduled events are published when due", NodeTimeout(5*time.Second), func(ctx context.Context) {
+		deliverAt := time.Now().Add(500 * time.Millisecond)
+		schedule(ctx, "events.test", deliverAt)
+
+		select {
+		case event := <-ec.Incoming():
+			Expect(time.Now()).To(BeTemporally(">=", deliverAt))
+			Expect(event.Subject).To(Equal("events.test"))
+			Expect(event.Headers.IdempotencyKey).ToNot(BeNil())
+			Expect(*event.Headers.IdempotencyKey).To(Equal("scheduled-events.test"))
+			Expect(event.Headers.PublishedAt).To(BeTemporally(">=", deliverAt))
+			Expect(event.Ack()).To(Succeed())
+		case <-ctx.Done():
+			Fail("scheduled event was not published")
+		}
+
+		Eventually(listScheduled).WithContext(ctx).Should(BeEmpty())
+	})
+
+	It("scheduled events are not published before they are due", func(ctx context.Context) {
+		schedule(ctx, "events.test", time.Now().Add(time.Hour))
+
+		Consistently(ec.Incoming(), 200*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("can list scheduled events", func(ctx context.Context) {
+		deliverAt := time.Now().Add(time.Hour)
+		id1 := schedule(ctx, "events.a", deliverAt)
+		id2 := schedule(ctx, "events.b", deliverAt)
+
+		list := listScheduled(ctx)
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].ID).To(Equal(id1))
+		Expect(list[0].Subject).To(Equal("events.a"))
+		Expect(list[0].DeliverAt).To(BeTemporally("~", deliverAt, time.Millisecond))
+		Expect(list[1].ID).To(Equal(id2))
+
+		page, err := manager.ListScheduledEvents(ctx, &events.ListScheduledEventsConfig{
+			Subject: "events.b",
+			Limit:   100,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page.Events).To(HaveLen(1))
+		Expect(page.Events[0].ID).To(Equal(id2))
+
+		page, err = manager.ListScheduledEvents(ctx, &events.ListScheduledEventsConfig{
+			Limit: 1,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page.Events).To(HaveLen(1))
+		Expect(page.HasMore).To(BeTrue())
+	})
+
+	It("scheduling the same idempotency key twice only schedules once", func(ctx context.Context) {
+		deliverAt := time.Now().Add(time.Hour)
+		id1 := schedule(ctx, "events.test", deliverAt)
+		id2 := schedule(ctx, "events.test", deliverAt)
+		Expect(id2).To(Equal(id1))
+		Expect(listScheduled(ctx)).To(HaveLen(1))
+	})
+
+	It("can cancel scheduled events", func(ctx context.Context) {
+		id := schedule(ctx, "events.test", time.Now().Add(500*time.Millisecond))
+
+		err := manager.CancelScheduledEvent(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listScheduled(ctx)).To(BeEmpty())
+
+		Consistently(ec.Incoming(), time.Second).ShouldNot(Receive())
+	})
+
+	It("canceling a missing event fails", func(ctx context.Context) {
+		err := manager.CancelScheduledEvent(ctx, 1000)
+		Expect(err).To(MatchError(events.ErrScheduledEventNotFound))
+	})
+
+	It("scheduling to an unbound subject fails", func(ctx context.Context) {
+		deliverAt := time.Now().Add(time.Hour)
+		_, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject:   "unbound.test",
+			Data:      Data(&emptypb.Empty{}),
+			DeliverAt: &deliverAt,
+		})
+		Expect(err).To(MatchError(events.ErrUnboundSubject))
+	})
+
+	It("scheduling with an expected sequence fails", func(ctx context.Context) {
+		deliverAt := time.Now().Add(time.Hour)
+		seq := uint64(1)
+		_, err := manager.Publish(ctx, &events.PublishConfig{
+			Subject:            "events.test",
+			Data:               Data(&emptypb.Empty{}),
+			DeliverAt:          &deliverAt,
+			ExpectedSubjectSeq: &seq,
+		})
+		Expect(events.IsValidationError(err)).To(BeTrue())
+	})
+})
@@ -2,6 +2,8 @@ package events
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -89,7 +91,48 @@ type StreamConfig struct {
 }
 
 // Stream contains information about a stream.
-type Stream struct{}
+type Stream struct {
+	// Config is the effective configuration of the stream.
+	Config StreamConfig
+	// Created is when the stream was created.
+	Created time.Time
+	// State is the current state of the stream.
+	State StreamState
+}
+
+// StreamState is the current state of a stream.
+type StreamState struct {
+	// Events is the number of events stored in the stream.
+	Events uint64
+	// Bytes is the number of bytes stored in the stream.
+	Bytes uint64
+	// FirstID is the ID of the first event in the stream.
+	FirstID uint64
+	// FirstTime is when the first event in the stream was stored.
+	FirstTime time.Time
+	// LastID is the ID of the last event in the stream.
+	LastID uint64
+	// LastTime is when the last event in the stream was stored.
+	LastTime time.Time
+	// Consumers is the number of consumers of the stream.
+	Consumers uint
+}
+
+// ListStreamsConfig is the configuration for listing streams.
+type ListStreamsConfig struct {
+	// After is the name of the stream to list streams after, used for paging.
+	After string
+	// Limit is the maximum number of streams to return.
+	Limit uint
+}
+
+// StreamList is a page of streams.
+type StreamList struct {
+	// Streams in the page, ordered by name.
+	Streams []*Stream
+	// HasMore indicates if there are more streams after this page.
+	HasMore bool
+}
 
 // EnsureStream ensures that a JetStream stream exists with the given configuration.
 // If the stream already exists, it will be updated with the new configuration.
@@ -105,9 +148,10 @@ func (m *Manager) EnsureStream(ctx context.Context, config *StreamConfig) (*Stre
 	)
 	defer span.End()
 
-	if !IsValidStreamName(config.Name) {
+	err := validateStreamName(config.Name)
+	if err != nil {
 		span.SetStatus(codes.Error, "invalid stream name")
-		return nil, newValidationError("invalid stream name: " + config.Name)
+		return nil, err
 	}
 
 	natsDiscardPolicy := jetstream.DiscardOld
@@ -187,7 +231,7 @@ func (m *Manager) EnsureStream(ctx context.Context, config *StreamConfig) (*Stre
 		streamConfig.MaxMsgSize = int32(*config.MaxEventSize)
 	}
 
-	_, err := m.js.Stream(ctx, config.Name)
+	_, err = m.js.Stream(ctx, config.Name)
 	if errors.Is(err, jetstream.ErrStreamNotFound) {
 		// No stream with this name exists
 		m.logger.Info(
@@ -196,12 +240,12 @@ func (m *Manager) EnsureStream(ctx context.Context, config *StreamConfig) (*Stre
 			zap.Object("config", (*ZapStreamConfig)(&streamConfig)),
 		)
 
-		_, err = m.js.CreateStream(ctx, streamConfig)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to create JetStream stream")
+		stream, err2 := m.js.CreateStream(ctx, streamConfig)
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to create JetStream stream")
 		}
 
-		return &Stream{}, nil
+		return toStream(stream.CachedInfo()), nil
 	} else if err != nil {
 		return nil, errors.Wrap(err, "failed to get JetStream stream info")
 	}
@@ -211,12 +255,282 @@ func (m *Manager) EnsureStream(ctx context.Context, config *StreamConfig) (*Stre
 		zap.String("name", config.Name),
 		zap.Object("config", (*ZapStreamConfig)(&streamConfig)),
 	)
-	_, err = m.js.UpdateStream(ctx, streamConfig)
+	stream, err := m.js.UpdateStream(ctx, streamConfig)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update JetStream stream")
 	}
 
-	return &Stream{}, nil
+	return toStream(stream.CachedInfo()), nil
+}
+
+// GetStream returns information about a stream. If the stream does not exist
+// ErrStreamNotFound is returned.
+func (m *Manager) GetStream(ctx context.Context, name string) (*Stream, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.GetStream",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", name),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(name)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream name")
+		return nil, err
+	}
+
+	stream, err := m.js.Stream(ctx, name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return nil, errors.WithStack(ErrStreamNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get stream")
+		return nil, errors.Wrap(err, "failed to get JetStream stream info")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return toStream(stream.CachedInfo()), nil
+}
+
+// StreamForSubject returns the name of the stream a subject is bound to. If
+// no stream is bound to the subject ErrUnboundSubject is returned.
+func (m *Manager) StreamForSubject(ctx context.Context, subject string) (string, error) {
+	if !IsValidSubject(subject, false) {
+		return "", newValidationError("invalid subject: " + subject)
+	}
+
+	name, err := m.js.StreamNameBySubject(ctx, subject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		return "", errors.WithStack(ErrUnboundSubject)
+	} else if err != nil {
+		return "", errors.Wrap(err, "could not find stream for subject")
+	}
+
+	return name, nil
+}
+
+// ListStreams returns a page of streams ordered by name. Streams used
+// internally, such as for dead letters and key-value stores, are not listed.
+func (m *Manager) ListStreams(ctx context.Context, config *ListStreamsConfig) (*StreamList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.ListStreams",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+		),
+	)
+	defer span.End()
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	lister := m.js.StreamNames(ctx)
+	names := make([]string, 0)
+	for name := range lister.Name() {
+		if name > config.After && !isInternalStream(name) {
+			names = append(names, name)
+		}
+	}
+
+	if lister.Err() != nil {
+		span.RecordError(lister.Err())
+		span.SetStatus(codes.Error, "failed to list streams")
+		return nil, errors.Wrap(lister.Err(), "failed to list JetStream streams")
+	}
+
+	sort.Strings(names)
+
+	result := &StreamList{
+		Streams: make([]*Stream, 0, min(len(names), int(config.Limit))),
+	}
+	for _, name := range names {
+		if uint(len(result.Streams)) >= config.Limit {
+			result.HasMore = true
+			break
+		}
+
+		stream, err := m.js.Stream(ctx, name)
+		if errors.Is(err, jetstream.ErrStreamNotFound) {
+			// Stream was deleted while listing
+			continue
+		} else if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to get stream")
+			return nil, errors.Wrap(err, "failed to get JetStream stream info")
+		}
+
+		result.Streams = append(result.Streams, toStream(stream.CachedInfo()))
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return result, nil
+}
+
+// isInternalStream checks if a stream is used internally by Windshift or by
+// the key-value and object stores of NATS, rather than for events.
+func isInternalStream(name string) bool {
+	return strings.HasPrefix(name, "windshift-") ||
+		strings.HasPrefix(name, "KV_") ||
+		strings.HasPrefix(name, "OBJ_")
+}
+
+// validateStreamName checks that a stream name given by a client is valid
+// and does not refer to an internal stream. Every method that takes the name
+// of a stream from a client uses it, so internal streams and their consumers
+// can not be used through the public API.
+func validateStreamName(name string) error {
+	if !IsValidStreamName(name) {
+		return newValidationError("invalid stream name: " + name)
+	}
+
+	if isInternalStream(name) {
+		return newValidationError("stream name is reserved for internal use: " + name)
+	}
+
+	return nil
+}
+
+// DeleteStream deletes a stream and all events stored in it. If the stream
+// does not exist ErrStreamNotFound is returned.
+func (m *Manager) DeleteStream(ctx context.Context, name string) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.events.DeleteStream",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.MessagingSystem("nats"),
+			attribute.String("stream", name),
+		),
+	)
+	defer span.End()
+
+	err := validateStreamName(name)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid stream name")
+		return err
+	}
+
+	m.logger.Info("Deleting stream", zap.String("name", name))
+	err = m.js.DeleteStream(ctx, name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		span.SetStatus(codes.Error, "stream not found")
+		return errors.WithStack(ErrStreamNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to delete stream")
+		return errors.Wrap(err, "failed to delete JetStream stream")
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// toStream converts the JetStream information about a stream to a Stream.
+func toStream(info *jetstream.StreamInfo) *Stream {
+	config := info.Config
+
+	streamConfig := StreamConfig{
+		Name:     config.Name,
+		Subjects: config.Subjects,
+
+		MaxAge:               config.MaxAge,
+		DiscardNewPerSubject: config.DiscardNewPerSubject,
+	}
+
+	if config.MaxMsgs > 0 {
+		streamConfig.MaxMsgs = uint(config.MaxMsgs)
+	}
+
+	if config.MaxBytes > 0 {
+		streamConfig.MaxBytes = uint(config.MaxBytes)
+	}
+
+	if config.MaxMsgsPerSubject > 0 {
+		streamConfig.MaxMsgsPerSubject = uint(config.MaxMsgsPerSubject)
+	}
+
+	switch config.Discard {
+	case jetstream.DiscardOld:
+		streamConfig.DiscardPolicy = DiscardPolicyOld
+	case jetstream.DiscardNew:
+		streamConfig.DiscardPolicy = DiscardPolicyNew
+	}
+
+	switch config.Storage {
+	case jetstream.FileStorage:
+		streamConfig.StorageType = StorageTypeFile
+	case jetstream.MemoryStorage:
+		streamConfig.StorageType = StorageTypeMemory
+	}
+
+	if config.Mirror != nil {
+		streamConfig.Mirror = fromNatsStreamSource(config.Mirror)
+	}
+
+	if len(config.Sources) > 0 {
+		streamConfig.Sources = make([]*StreamSource, len(config.Sources))
+		for i, source := range config.Sources {
+			streamConfig.Sources[i] = fromNatsStreamSource(source)
+		}
+	}
+
+	replicas := uint(config.Replicas)
+	streamConfig.Replicas = &replicas
+
+	duplicates := config.Duplicates
+	streamConfig.DeduplicationWindow = &duplicates
+
+	if config.MaxMsgSize > 0 {
+		maxEventSize := uint(config.MaxMsgSize)
+		streamConfig.MaxEventSize = &maxEventSize
+	}
+
+	return &Stream{
+		Config:  streamConfig,
+		Created: info.Created,
+		State: StreamState{
+			Events:    info.State.Msgs,
+			Bytes:     info.State.Bytes,
+			FirstID:   info.State.FirstSeq,
+			FirstTime: info.State.FirstTime,
+			LastID:    info.State.LastSeq,
+			LastTime:  info.State.LastTime,
+			Consumers: uint(info.State.Consumers),
+		},
+	}
+}
+
+func fromNatsStreamSource(source *jetstream.StreamSource) *StreamSource {
+	res := &StreamSource{
+		Name: source.Name,
+		From: &StreamPointer{
+			First: true,
+		},
+	}
+
+	if source.FilterSubject != "" {
+		res.FilterSubjects = []string{source.FilterSubject}
+	}
+
+	if source.OptStartSeq != 0 {
+		res.From = &StreamPointer{
+			ID: source.OptStartSeq,
+		}
+	} else if source.OptStartTime != nil {
+		res.From = &StreamPointer{
+			Time: *source.OptStartTime,
+		}
+	}
+
+	return res
 }
 
 func toNatsStreamSource(source *StreamSource) (*jetstream.StreamSource, error) {
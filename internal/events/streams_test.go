@@ -42,6 +42,16 @@ var _ = Describe("Streams", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("stream can not use names reserved for internal streams", func(ctx context.Context) {
+		for _, name := range []string{"windshift-test", "KV_test", "OBJ_test"} {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     name,
+				Subjects: []string{"test"},
+			})
+			Expect(events.IsValidationError(err)).To(BeTrue(), name)
+		}
+	})
+
 	It("parallel requests to create stream succeed", func(ctx context.Context) {
 		_, err := js.Stream(ctx, "test")
 		Expect(err).To(MatchError(jetstream.ErrStreamNotFound))
@@ -1842,4 +1852,170 @@ var _ = Describe("Streams", func() {
 			Expect(stream.CachedInfo().Config.Replicas).To(Equal(1))
 		})
 	})
+
+	Describe("Information", func() {
+		It("ensure returns the stream", func(ctx context.Context) {
+			stream, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test"},
+				MaxAge:   time.Hour,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.Config.Name).To(Equal("test"))
+			Expect(stream.Config.Subjects).To(Equal([]string{"test"}))
+			Expect(stream.Config.MaxAge).To(Equal(time.Hour))
+			Expect(stream.Created).ToNot(BeZero())
+		})
+
+		It("can get a stream", func(ctx context.Context) {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = js.Publish(ctx, "test", []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = js.Publish(ctx, "test", []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := manager.GetStream(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.Config.Name).To(Equal("test"))
+			Expect(*stream.Config.Replicas).To(Equal(uint(1)))
+			Expect(*stream.Config.DeduplicationWindow).To(Equal(2 * time.Minute))
+			Expect(stream.State.Events).To(Equal(uint64(2)))
+			Expect(stream.State.Bytes).To(BeNumerically(">", 0))
+			Expect(stream.State.FirstID).To(Equal(uint64(1)))
+			Expect(stream.State.LastID).To(Equal(uint64(2)))
+			Expect(stream.State.FirstTime).ToNot(BeZero())
+			Expect(stream.State.LastTime).ToNot(BeZero())
+			Expect(stream.State.Consumers).To(Equal(uint(0)))
+		})
+
+		It("getting a missing stream returns not found", func(ctx context.Context) {
+			_, err := manager.GetStream(ctx, "test")
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+
+		It("getting a stream with an invalid name fails", func(ctx context.Context) {
+			_, err := manager.GetStream(ctx, "invalid name")
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("getting an internal stream fails", func(ctx context.Context) {
+			_, err := manager.GetStream(ctx, "KV_test")
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("includes sources of a mirror", func(ctx context.Context) {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.EnsureStream(ctx, &events.StreamConfig{
+				Name: "mirror",
+				Mirror: &events.StreamSource{
+					Name: "test",
+					From: &events.StreamPointer{
+						ID: 10,
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			stream, err := manager.GetStream(ctx, "mirror")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stream.Config.Mirror).ToNot(BeNil())
+			Expect(stream.Config.Mirror.Name).To(Equal("test"))
+			Expect(stream.Config.Mirror.From.ID).To(Equal(uint64(10)))
+		})
+	})
+
+	Describe("Listing", func() {
+		ensureStreams := func(ctx context.Context, names ...string) {
+			for _, name := range names {
+				_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+					Name:     name,
+					Subjects: []string{name},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+
+		It("can list streams", func(ctx context.Context) {
+			ensureStreams(ctx, "c", "a", "b")
+
+			list, err := manager.ListStreams(ctx, &events.ListStreamsConfig{
+				Limit: 10,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.HasMore).To(BeFalse())
+			Expect(list.Streams).To(HaveLen(3))
+			Expect(list.Streams[0].Config.Name).To(Equal("a"))
+			Expect(list.Streams[1].Config.Name).To(Equal("b"))
+			Expect(list.Streams[2].Config.Name).To(Equal("c"))
+		})
+
+		It("can page through streams", func(ctx context.Context) {
+			ensureStreams(ctx, "c", "a", "b")
+
+			page1, err := manager.ListStreams(ctx, &events.ListStreamsConfig{
+				Limit: 2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page1.HasMore).To(BeTrue())
+			Expect(page1.Streams).To(HaveLen(2))
+
+			page2, err := manager.ListStreams(ctx, &events.ListStreamsConfig{
+				After: page1.Streams[1].Config.Name,
+				Limit: 2,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page2.HasMore).To(BeFalse())
+			Expect(page2.Streams).To(HaveLen(1))
+			Expect(page2.Streams[0].Config.Name).To(Equal("c"))
+		})
+
+		It("listing without a limit fails", func(ctx context.Context) {
+			_, err := manager.ListStreams(ctx, &events.ListStreamsConfig{})
+			Expect(events.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Deleting", func() {
+		It("can delete a stream", func(ctx context.Context) {
+			_, err := manager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "test",
+				Subjects: []string{"test"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.DeleteStream(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = js.Stream(ctx, "test")
+			Expect(err).To(MatchError(jetstream.ErrStreamNotFound))
+		})
+
+		It("deleting a missing stream returns not found", func(ctx context.Context) {
+			err := manager.DeleteStream(ctx, "test")
+			Expect(err).To(MatchError(events.ErrStreamNotFound))
+		})
+
+		It("can not delete internal streams", func(ctx context.Context) {
+			_, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+				Bucket: "test",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.DeleteStream(ctx, "KV_test")
+			Expect(events.IsValidationError(err)).To(BeTrue())
+
+			_, err = js.Stream(ctx, "KV_test")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
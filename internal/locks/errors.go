@@ -0,0 +1,54 @@
+package locks
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLockHeld is returned when a lock is currently held by someone else and
+// could not be acquired. The actual error will be a *HeldError which contains
+// information about when the current hold expires.
+var ErrLockHeld = errors.New("lock is held")
+
+// ErrLockNotHeld is returned when trying to extend or release a lock that is
+// not held, either because it expired, was released or the token does not
+// match.
+var ErrLockNotHeld = errors.New("lock is not held")
+
+// HeldError is returned when a lock could not be acquired because it is held
+// by someone else.
+type HeldError struct {
+	// Name is the name of the lock.
+	Name string
+	// Expires is when the current hold of the lock expires.
+	Expires time.Time
+}
+
+func (e *HeldError) Error() string {
+	return "lock " + e.Name + " is held until " + e.Expires.Format(time.RFC3339Nano)
+}
+
+func (e *HeldError) Is(target error) bool {
+	return target == ErrLockHeld
+}
+
+type validationError struct {
+	err string
+}
+
+func (e *validationError) Error() string {
+	return e.err
+}
+
+func newValidationError(err string) error {
+	return &validationError{err: err}
+}
+
+func IsValidationError(err error) bool {
+	_, ok := err.(*validationError)
+	return ok
+}
+
+// errRevisionConflict is used internally when a lock was changed by someone
+// else while trying to acquire it.
+var errRevisionConflict = errors.New("revision conflict")
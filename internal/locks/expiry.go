@@ -0,0 +1,89 @@
+package locks
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+// StartExpiry starts removing locks that have expired without being extended
+// or released, recording an expired event for each of them. Every replica
+// can run this, as locks are removed using compare-and-set so that every
+// expiry is only recorded once. Returns a function that stops the expiry.
+func (m *Manager) StartExpiry(ctx context.Context) (func(), error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expiry outlives the context used to start it
+	expiryCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(m.config.ExpiryCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-expiryCtx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := m.expireLocks(expiryCtx)
+			if err != nil && expiryCtx.Err() == nil {
+				m.logger.Warn("Could not expire locks", zap.Error(err))
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
+
+// expireLocks removes all locks that have expired.
+func (m *Manager) expireLocks(ctx context.Context) error {
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	lister, err := bucket.ListKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not list locks")
+	}
+	defer lister.Stop() //nolint:errcheck
+
+	for name := range lister.Keys() {
+		entry, current, err := m.get(ctx, bucket, name)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			// Released while listing
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if current.Expires.After(time.Now()) {
+			continue
+		}
+
+		err = bucket.Delete(ctx, name, jetstream.LastRevision(entry.Revision()))
+		if isRevisionMismatch(err) {
+			// Taken over or expired by another replica
+			continue
+		} else if err != nil {
+			return errors.Wrap(err, "could not remove expired lock")
+		}
+
+		m.appendEvent(ctx, EventExpired, name, current)
+	}
+
+	return nil
+}
@@ -0,0 +1,346 @@
+package locks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// EventStreamName is the name of the JetStream stream that every lock
+// transition is appended to.
+const EventStreamName = "windshift-lock-events"
+
+// eventSubjectPrefix is the prefix of the subjects used for lock events, the
+// name of the lock is appended to it. The `$` prefix makes sure that these
+// subjects can never be bound to a stream via the events API.
+const eventSubjectPrefix = "$WS.LOCKS."
+
+// historyBatchSize is the number of events fetched at a time when reading
+// the history of locks.
+const historyBatchSize = 100
+
+// EventType is the type of a lock transition.
+type EventType string
+
+const (
+	// EventAcquired is used when a lock was acquired.
+	EventAcquired EventType = "acquired"
+	// EventExtended is used when a lock was extended.
+	EventExtended EventType = "extended"
+	// EventReleased is used when a lock was released by its holder.
+	EventReleased EventType = "released"
+	// EventExpired is used when a lock expired without being extended or
+	// released.
+	EventExpired EventType = "expired"
+)
+
+// Event is a transition of a lock as recorded in the event log.
+type Event struct {
+	// ID is the sequence of the event in the event log, used to continue
+	// reading the history after it.
+	ID uint64
+	// Type is the type of transition.
+	Type EventType
+	// Timestamp is when the transition happened.
+	Timestamp time.Time
+	// Name is the name of the lock.
+	Name string
+	// Owner is the owner of the lock.
+	Owner string
+	// Expires is when the lock expires, not set for released and expired
+	// events.
+	Expires time.Time
+}
+
+// eventData is what is stored in the event stream for every transition.
+type eventData struct {
+	Type    EventType  `json:"type"`
+	Owner   string     `json:"owner,omitempty"`
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// HistoryConfig is the configuration used to read the history of locks.
+type HistoryConfig struct {
+	// Names are the locks to include, if empty all locks are included.
+	Names []string
+	// Limit is the maximum number of events to return, zero means no limit.
+	Limit uint
+	// After only includes events that happened after this time. Can not be
+	// combined with AfterID.
+	After time.Time
+	// AfterID only includes events after the event with this ID, used to
+	// continue reading where a previous read stopped.
+	AfterID uint64
+}
+
+// EventMonitor is used to receive lock transitions as they happen.
+type EventMonitor struct {
+	events  chan *Event
+	consume jetstream.ConsumeContext
+}
+
+// Events returns the channel that lock transitions are sent to.
+func (m *EventMonitor) Events() <-chan *Event {
+	return m.events
+}
+
+// Stop stops receiving lock transitions.
+func (m *EventMonitor) Stop() {
+	m.consume.Stop()
+}
+
+// ensureEventStream makes sure that the stream lock events are appended to
+// exists and has the configured retention.
+func (m *Manager) ensureEventStream(ctx context.Context) error {
+	m.eventStreamMu.Lock()
+	defer m.eventStreamMu.Unlock()
+
+	if m.eventStreamReady {
+		return nil
+	}
+
+	_, err := m.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        EventStreamName,
+		Description: "History of distributed locks managed by Windshift",
+		Subjects:    []string{eventSubjectPrefix + ">"},
+		MaxAge:      m.config.HistoryMaxAge,
+		Discard:     jetstream.DiscardOld,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create lock event stream")
+	}
+
+	m.eventStreamReady = true
+	return nil
+}
+
+// appendEvent appends a lock transition to the event log. Failures are
+// logged but not returned as the lock itself has already changed.
+func (m *Manager) appendEvent(ctx context.Context, eventType EventType, name string, lock *lockData) {
+	data := &eventData{
+		Type:  eventType,
+		Owner: lock.Owner,
+	}
+
+	if eventType == EventAcquired || eventType == EventExtended {
+		data.Expires = &lock.Expires
+	}
+
+	err := m.ensureEventStream(ctx)
+	if err != nil {
+		m.logger.Warn("Could not record lock event", zap.String("name", name), zap.Error(err))
+		return
+	}
+
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		m.logger.Warn("Could not record lock event", zap.String("name", name), zap.Error(err))
+		return
+	}
+
+	_, err = m.js.Publish(ctx, eventSubjectPrefix+name, encoded)
+	if err != nil {
+		m.logger.Warn("Could not record lock event", zap.String("name", name), zap.Error(err))
+	}
+}
+
+// Monitor starts receiving lock transitions for the given locks as they
+// happen. If no names are given all locks are monitored.
+func (m *Manager) Monitor(ctx context.Context, names []string) (*EventMonitor, error) {
+	filters, err := eventFilters(names)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.ensureEventStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, EventStreamName, jetstream.OrderedConsumerConfig{
+		FilterSubjects: filters,
+		DeliverPolicy:  jetstream.DeliverNewPolicy,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create lock event consumer")
+	}
+
+	monitor := &EventMonitor{
+		events: make(chan *Event),
+	}
+
+	monitor.consume, err = consumer.Consume(func(msg jetstream.Msg) {
+		event, err2 := decodeEvent(msg)
+		if err2 != nil {
+			m.logger.Warn("Could not decode lock event", zap.Error(err2))
+			return
+		}
+
+		select {
+		case monitor.events <- event:
+		case <-ctx.Done():
+		}
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not consume lock events")
+	}
+
+	return monitor, nil
+}
+
+// History returns past lock transitions in the order they happened.
+func (m *Manager) History(ctx context.Context, config *HistoryConfig) ([]*Event, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.locks.History",
+		trace.WithAttributes(
+			attribute.StringSlice("windshift.lock.names", config.Names),
+		),
+	)
+	defer span.End()
+
+	filters, err := eventFilters(config.Names)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if !config.After.IsZero() && config.AfterID > 0 {
+		span.SetStatus(codes.Error, "after and after ID can not both be set")
+		return nil, newValidationError("after and after ID can not both be set")
+	}
+
+	err = m.ensureEventStream(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get event stream")
+		return nil, err
+	}
+
+	consumerConfig := jetstream.OrderedConsumerConfig{
+		FilterSubjects: filters,
+		DeliverPolicy:  jetstream.DeliverAllPolicy,
+	}
+
+	if config.AfterID > 0 {
+		// Sequences are unique, so paging by them never skips or repeats
+		// events that share a timestamp
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		consumerConfig.OptStartSeq = config.AfterID + 1
+	} else if !config.After.IsZero() {
+		consumerConfig.DeliverPolicy = jetstream.DeliverByStartTimePolicy
+		consumerConfig.OptStartTime = &config.After
+	}
+
+	consumer, err := m.js.OrderedConsumer(ctx, EventStreamName, consumerConfig)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to create consumer")
+		return nil, errors.Wrap(err, "could not create lock event consumer")
+	}
+
+	result := make([]*Event, 0)
+	for {
+		batch, err := consumer.FetchNoWait(historyBatchSize)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to fetch events")
+			return nil, errors.Wrap(err, "could not fetch lock events")
+		}
+
+		received := 0
+		caughtUp := false
+		for msg := range batch.Messages() {
+			received++
+
+			event, err := decodeEvent(msg)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to decode event")
+				return nil, err
+			}
+
+			if event.Timestamp.After(config.After) {
+				result = append(result, event)
+				if config.Limit > 0 && uint(len(result)) >= config.Limit {
+					span.SetStatus(codes.Ok, "")
+					return result, nil
+				}
+			}
+
+			metadata, err := msg.Metadata()
+			if err == nil && metadata.NumPending == 0 {
+				caughtUp = true
+			}
+		}
+
+		if batch.Error() != nil {
+			span.RecordError(batch.Error())
+			span.SetStatus(codes.Error, "failed to fetch events")
+			return nil, errors.Wrap(batch.Error(), "could not fetch lock events")
+		}
+
+		if received == 0 || caughtUp {
+			span.SetStatus(codes.Ok, "")
+			return result, nil
+		}
+	}
+}
+
+// eventFilters validates lock names and turns them into subject filters.
+func eventFilters(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return []string{eventSubjectPrefix + ">"}, nil
+	}
+
+	seen := make(map[string]struct{}, len(names))
+	filters := make([]string, 0, len(names))
+	for _, name := range names {
+		if !IsValidLockName(name) {
+			return nil, newValidationError("invalid lock name: " + name)
+		}
+
+		if _, ok := seen[name]; ok {
+			// JetStream does not allow overlapping filters
+			continue
+		}
+
+		seen[name] = struct{}{}
+		filters = append(filters, eventSubjectPrefix+name)
+	}
+	return filters, nil
+}
+
+func decodeEvent(msg jetstream.Msg) (*Event, error) {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get lock event metadata")
+	}
+
+	var data eventData
+	err = json.Unmarshal(msg.Data(), &data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal lock event")
+	}
+
+	event := &Event{
+		ID:        metadata.Sequence.Stream,
+		Type:      data.Type,
+		Timestamp: metadata.Timestamp,
+		Name:      msg.Subject()[len(eventSubjectPrefix):],
+		Owner:     data.Owner,
+	}
+
+	if data.Expires != nil {
+		event.Expires = *data.Expires
+	}
+
+	return event, nil
+}
@@ -0,0 +1,191 @@
+package locks_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/locks"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("History", func() {
+	var manager *locks.Manager
+
+	BeforeEach(func() {
+		manager, _ = createManagerAndJetStream()
+	})
+
+	acquireAndRelease := func(ctx context.Context, name string) {
+		lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    name,
+			Owner:   "owner",
+			Expires: time.Now().Add(time.Minute),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.Release(ctx, name, lock.Token)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("empty history returns no events", func(ctx context.Context) {
+		events, err := manager.History(ctx, &locks.HistoryConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+
+	It("records acquire, extend and release", func(ctx context.Context) {
+		lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    "test",
+			Owner:   "owner",
+			Expires: time.Now().Add(time.Minute),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.Extend(ctx, "test", lock.Token, time.Now().Add(2*time.Minute))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.Release(ctx, "test", lock.Token)
+		Expect(err).ToNot(HaveOccurred())
+
+		events, err := manager.History(ctx, &locks.HistoryConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+		Expect(events[0].Type).To(Equal(locks.EventAcquired))
+		Expect(events[0].Name).To(Equal("test"))
+		Expect(events[0].Owner).To(Equal("owner"))
+		Expect(events[1].Type).To(Equal(locks.EventExtended))
+		Expect(events[2].Type).To(Equal(locks.EventReleased))
+	})
+
+	It("records expiry of locks when taken over", func(ctx context.Context) {
+		_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    "test",
+			Owner:   "first",
+			Expires: time.Now().Add(100 * time.Millisecond),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		time.Sleep(150 * time.Millisecond)
+
+		_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    "test",
+			Owner:   "second",
+			Expires: time.Now().Add(time.Minute),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		events, err := manager.History(ctx, &locks.HistoryConfig{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+		Expect(events[1].Type).To(Equal(locks.EventExpired))
+		Expect(events[1].Owner).To(Equal("first"))
+		Expect(events[2].Type).To(Equal(locks.EventAcquired))
+		Expect(events[2].Owner).To(Equal("second"))
+	})
+
+	It("can filter by name", func(ctx context.Context) {
+		acquireAndRelease(ctx, "a")
+		acquireAndRelease(ctx, "b")
+
+		events, err := manager.History(ctx, &locks.HistoryConfig{
+			Names: []string{"b"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Name).To(Equal("b"))
+		Expect(events[1].Name).To(Equal("b"))
+	})
+
+	It("can limit number of events", func(ctx context.Context) {
+		acquireAndRelease(ctx, "a")
+		acquireAndRelease(ctx, "b")
+
+		events, err := manager.History(ctx, &locks.HistoryConfig{
+			Limit: 3,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(events).To(HaveLen(3))
+	})
+
+	It("can page using after ID", func(ctx context.Context) {
+		acquireAndRelease(ctx, "a")
+		acquireAndRelease(ctx, "b")
+
+		page1, err := manager.History(ctx, &locks.HistoryConfig{
+			Limit: 3,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page1).To(HaveLen(3))
+
+		page2, err := manager.History(ctx, &locks.HistoryConfig{
+			AfterID: page1[2].ID,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(page2).To(HaveLen(1))
+		Expect(page2[0].Name).To(Equal("b"))
+		Expect(page2[0].Type).To(Equal(locks.EventReleased))
+	})
+
+	It("can not combine after and after ID", func(ctx context.Context) {
+		_, err := manager.History(ctx, &locks.HistoryConfig{
+			After:   time.Now(),
+			AfterID: 1,
+		})
+		Expect(locks.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("records expiry of locks that are not acquired again", func(ctx context.Context) {
+		stop, err := manager.StartExpiry(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+
+		_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    "test",
+			Owner:   "owner",
+			Expires: time.Now().Add(100 * time.Millisecond),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func(g Gomega) {
+			events, err := manager.History(ctx, &locks.HistoryConfig{})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(events).To(HaveLen(2))
+			g.Expect(events[1].Type).To(Equal(locks.EventExpired))
+			g.Expect(events[1].Owner).To(Equal("owner"))
+		}).WithContext(ctx).WithTimeout(2 * time.Second).Should(Succeed())
+
+		// The lock is free again
+		_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+			Name:    "test",
+			Expires: time.Now().Add(time.Minute),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("invalid name returns validation error", func(ctx context.Context) {
+		_, err := manager.History(ctx, &locks.HistoryConfig{
+			Names: []string{"invalid.name"},
+		})
+		Expect(locks.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("can monitor lock events", func(ctx context.Context) {
+		monitor, err := manager.Monitor(ctx, []string{"test"})
+		Expect(err).ToNot(HaveOccurred())
+		defer monitor.Stop()
+
+		acquireAndRelease(ctx, "other")
+		acquireAndRelease(ctx, "test")
+
+		var event *locks.Event
+		Eventually(monitor.Events()).Should(Receive(&event))
+		Expect(event.Type).To(Equal(locks.EventAcquired))
+		Expect(event.Name).To(Equal("test"))
+
+		Eventually(monitor.Events()).Should(Receive(&event))
+		Expect(event.Type).To(Equal(locks.EventReleased))
+		Expect(event.Name).To(Equal("test"))
+	})
+})
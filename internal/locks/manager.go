@@ -0,0 +1,431 @@
+package locks
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// BucketName is the name of the JetStream KeyValue bucket used to store
+// locks.
+const BucketName = "windshift-locks"
+
+// Manager provides distributed locks backed by a JetStream KeyValue bucket.
+// Every lock is stored as a single key, and all changes are performed using
+// compare-and-set on the revision of the key so that only a single client can
+// hold a lock at a time, regardless of which replica it is connected to.
+type Manager struct {
+	logger *zap.Logger
+	tracer trace.Tracer
+
+	js     jetstream.JetStream
+	config *Config
+
+	bucketMu sync.Mutex
+	bucket   jetstream.KeyValue
+
+	eventStreamMu    sync.Mutex
+	eventStreamReady bool
+}
+
+// AcquireConfig is the configuration used to acquire a lock.
+type AcquireConfig struct {
+	// Name is the name of the lock to acquire.
+	Name string
+	// Owner is a human-readable identifier of who is holding the lock.
+	Owner string
+	// Expires is when the lock expires if it is not extended or released.
+	Expires time.Time
+	// Timeout is the maximum time to wait for the lock to become available.
+	// If zero the lock will only be acquired if it is currently free.
+	Timeout time.Duration
+}
+
+// Lock is a lock that has been acquired.
+type Lock struct {
+	// Name is the name of the lock.
+	Name string
+	// Owner is the owner of the lock as provided when acquiring it.
+	Owner string
+	// Token is used to extend or release the lock.
+	Token string
+	// Expires is when the lock expires.
+	Expires time.Time
+}
+
+// lockData is what is stored in the bucket for every lock.
+type lockData struct {
+	Token   string    `json:"token"`
+	Owner   string    `json:"owner,omitempty"`
+	Expires time.Time `json:"expires"`
+}
+
+// NewManager creates a new lock manager.
+func NewManager(
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	js jetstream.JetStream,
+	config *Config,
+) (*Manager, error) {
+	return &Manager{
+		logger: logger,
+		tracer: tracer,
+
+		js:     js,
+		config: config,
+	}, nil
+}
+
+// getBucket returns the bucket used for locks, creating it if needed.
+func (m *Manager) getBucket(ctx context.Context) (jetstream.KeyValue, error) {
+	m.bucketMu.Lock()
+	defer m.bucketMu.Unlock()
+
+	if m.bucket != nil {
+		return m.bucket, nil
+	}
+
+	bucket, err := m.js.KeyValue(ctx, BucketName)
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		bucket, err = m.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+			Bucket:      BucketName,
+			Description: "Distributed locks managed by Windshift",
+		})
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			// Another replica created the bucket at the same time
+			bucket, err = m.js.KeyValue(ctx, BucketName)
+		}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get lock bucket")
+	}
+
+	m.bucket = bucket
+	return bucket, nil
+}
+
+// Acquire acquires a lock. If the lock is held by someone else and no timeout
+// is set a *HeldError is returned. If a timeout is set this will wait for the
+// lock to become available, either by being released or by expiring.
+func (m *Manager) Acquire(ctx context.Context, config *AcquireConfig) (*Lock, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.locks.Acquire",
+		trace.WithAttributes(
+			attribute.String("windshift.lock.name", config.Name),
+			attribute.String("windshift.lock.owner", config.Owner),
+		),
+	)
+	defer span.End()
+
+	if !IsValidLockName(config.Name) {
+		span.SetStatus(codes.Error, "invalid lock name")
+		return nil, newValidationError("invalid lock name: " + config.Name)
+	}
+
+	if !config.Expires.After(time.Now()) {
+		span.SetStatus(codes.Error, "expiry time must be in the future")
+		return nil, newValidationError("expiry time must be in the future")
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	lock := &Lock{
+		Name:    config.Name,
+		Owner:   config.Owner,
+		Token:   uuid.NewString(),
+		Expires: config.Expires,
+	}
+
+	current := &lockData{
+		Token:   lock.Token,
+		Owner:   lock.Owner,
+		Expires: lock.Expires,
+	}
+	data, err := json.Marshal(current)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to marshal lock")
+		return nil, errors.Wrap(err, "failed to marshal lock")
+	}
+
+	deadline := time.Now().Add(config.Timeout)
+	var watcher jetstream.KeyWatcher
+	defer func() {
+		if watcher != nil {
+			_ = watcher.Stop()
+		}
+	}()
+
+	for {
+		var previous lockData
+		previous, err = m.tryAcquire(ctx, bucket, config.Name, data)
+		if err == nil {
+			if previous.Token != "" {
+				// The previous hold expired without being released
+				m.appendEvent(ctx, EventExpired, config.Name, &previous)
+			}
+
+			m.appendEvent(ctx, EventAcquired, config.Name, current)
+			span.SetStatus(codes.Ok, "")
+			return lock, nil
+		}
+
+		if errors.Is(err, errRevisionConflict) {
+			// Someone else changed the lock while we were trying to acquire
+			// it, try again right away
+			continue
+		}
+
+		var heldErr *HeldError
+		if !errors.As(err, &heldErr) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to acquire lock")
+			return nil, err
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			span.SetStatus(codes.Error, "lock is held")
+			return nil, err
+		}
+
+		if watcher == nil {
+			// Watch the key so that releases are picked up right away
+			watcher, err = bucket.Watch(ctx, config.Name, jetstream.UpdatesOnly())
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "failed to watch lock")
+				return nil, errors.Wrap(err, "failed to watch lock")
+			}
+
+			// Check again as the lock may have been released before the
+			// watcher was created
+			continue
+		}
+
+		if untilExpiry := time.Until(heldErr.Expires); untilExpiry < wait {
+			wait = untilExpiry
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			span.SetStatus(codes.Error, "context done")
+			return nil, ctx.Err()
+		case <-watcher.Updates():
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// tryAcquire makes a single attempt at acquiring a lock. If the lock was
+// taken over from an expired hold the previous hold is returned, otherwise the
+// returned data is empty.
+func (m *Manager) tryAcquire(ctx context.Context, bucket jetstream.KeyValue, name string, data []byte) (lockData, error) {
+	entry, current, err := m.get(ctx, bucket, name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		_, err = bucket.Create(ctx, name, data)
+		if errors.Is(err, jetstream.ErrKeyExists) || isRevisionMismatch(err) {
+			return lockData{}, errors.WithStack(errRevisionConflict)
+		} else if err != nil {
+			return lockData{}, errors.Wrap(err, "failed to create lock")
+		}
+
+		return lockData{}, nil
+	} else if err != nil {
+		return lockData{}, err
+	}
+
+	if current.Expires.After(time.Now()) {
+		return lockData{}, &HeldError{
+			Name:    name,
+			Expires: current.Expires,
+		}
+	}
+
+	// The previous hold has expired, take over the lock
+	_, err = bucket.Update(ctx, name, data, entry.Revision())
+	if isRevisionMismatch(err) {
+		return lockData{}, errors.WithStack(errRevisionConflict)
+	} else if err != nil {
+		return lockData{}, errors.Wrap(err, "failed to update lock")
+	}
+
+	return *current, nil
+}
+
+// Extend extends a lock that is currently held. If the lock is not held or
+// the token does not match ErrLockNotHeld is returned.
+func (m *Manager) Extend(ctx context.Context, name string, token string, expires time.Time) (*Lock, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.locks.Extend",
+		trace.WithAttributes(
+			attribute.String("windshift.lock.name", name),
+		),
+	)
+	defer span.End()
+
+	if !IsValidLockName(name) {
+		span.SetStatus(codes.Error, "invalid lock name")
+		return nil, newValidationError("invalid lock name: " + name)
+	}
+
+	if !expires.After(time.Now()) {
+		span.SetStatus(codes.Error, "expiry time must be in the future")
+		return nil, newValidationError("expiry time must be in the future")
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	entry, current, err := m.getHeld(ctx, bucket, name, token)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	current.Expires = expires
+	data, err := json.Marshal(current)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to marshal lock")
+		return nil, errors.Wrap(err, "failed to marshal lock")
+	}
+
+	_, err = bucket.Update(ctx, name, data, entry.Revision())
+	if isRevisionMismatch(err) {
+		// The lock changed between reading and writing, which means it
+		// expired and was taken by someone else
+		span.SetStatus(codes.Error, "lock is not held")
+		return nil, errors.WithStack(ErrLockNotHeld)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to extend lock")
+		return nil, errors.Wrap(err, "failed to extend lock")
+	}
+
+	m.appendEvent(ctx, EventExtended, name, current)
+	span.SetStatus(codes.Ok, "")
+	return &Lock{
+		Name:    name,
+		Owner:   current.Owner,
+		Token:   token,
+		Expires: expires,
+	}, nil
+}
+
+// Release releases a lock that is currently held. If the lock is not held or
+// the token does not match ErrLockNotHeld is returned.
+func (m *Manager) Release(ctx context.Context, name string, token string) (*Lock, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.locks.Release",
+		trace.WithAttributes(
+			attribute.String("windshift.lock.name", name),
+		),
+	)
+	defer span.End()
+
+	if !IsValidLockName(name) {
+		span.SetStatus(codes.Error, "invalid lock name")
+		return nil, newValidationError("invalid lock name: " + name)
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	entry, current, err := m.getHeld(ctx, bucket, name, token)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	err = bucket.Delete(ctx, name, jetstream.LastRevision(entry.Revision()))
+	if isRevisionMismatch(err) {
+		span.SetStatus(codes.Error, "lock is not held")
+		return nil, errors.WithStack(ErrLockNotHeld)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to release lock")
+		return nil, errors.Wrap(err, "failed to release lock")
+	}
+
+	m.appendEvent(ctx, EventReleased, name, current)
+	span.SetStatus(codes.Ok, "")
+	return &Lock{
+		Name:    name,
+		Owner:   current.Owner,
+		Token:   token,
+		Expires: current.Expires,
+	}, nil
+}
+
+// getHeld fetches a lock and verifies that it is held with the given token.
+func (m *Manager) getHeld(ctx context.Context, bucket jetstream.KeyValue, name string, token string) (jetstream.KeyValueEntry, *lockData, error) {
+	entry, current, err := m.get(ctx, bucket, name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil, errors.WithStack(ErrLockNotHeld)
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if current.Token != token || !current.Expires.After(time.Now()) {
+		return nil, nil, errors.WithStack(ErrLockNotHeld)
+	}
+
+	return entry, current, nil
+}
+
+// get fetches the current data of a lock.
+func (m *Manager) get(ctx context.Context, bucket jetstream.KeyValue, name string) (jetstream.KeyValueEntry, *lockData, error) {
+	entry, err := bucket.Get(ctx, name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil, errors.WithStack(jetstream.ErrKeyNotFound)
+	} else if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get lock")
+	}
+
+	var data lockData
+	err = json.Unmarshal(entry.Value(), &data)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal lock")
+	}
+
+	return entry, &data, nil
+}
+
+func isRevisionMismatch(err error) bool {
+	var apiError *jetstream.APIError
+	if errors.As(err, &apiError) {
+		return apiError.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence
+	}
+
+	return false
+}
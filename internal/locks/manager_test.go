@@ -0,0 +1,220 @@
+package locks_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/locks"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Locks", func() {
+	var manager *locks.Manager
+
+	BeforeEach(func() {
+		manager, _ = createManagerAndJetStream()
+	})
+
+	Describe("Acquire", func() {
+		It("can acquire a free lock", func(ctx context.Context) {
+			lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Owner:   "owner",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lock.Name).To(Equal("test"))
+			Expect(lock.Owner).To(Equal("owner"))
+			Expect(lock.Token).ToNot(BeEmpty())
+		})
+
+		It("can not acquire a held lock", func(ctx context.Context) {
+			expires := time.Now().Add(time.Minute)
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: expires,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).To(MatchError(locks.ErrLockHeld))
+
+			var heldErr *locks.HeldError
+			Expect(err).To(BeAssignableToTypeOf(heldErr))
+			heldErr = err.(*locks.HeldError)
+			Expect(heldErr.Expires).To(BeTemporally("~", expires, time.Millisecond))
+		})
+
+		It("can acquire a lock that has expired", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(100 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			time.Sleep(150 * time.Millisecond)
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can wait for a lock to be released", func(ctx context.Context) {
+			lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			go func() {
+				defer GinkgoRecover()
+
+				time.Sleep(100 * time.Millisecond)
+				_, err2 := manager.Release(ctx, "test", lock.Token)
+				Expect(err2).ToNot(HaveOccurred())
+			}()
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+				Timeout: 5 * time.Second,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can wait for a lock to expire", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(200 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+				Timeout: 5 * time.Second,
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("gives up waiting after timeout", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+				Timeout: 100 * time.Millisecond,
+			})
+			Expect(err).To(MatchError(locks.ErrLockHeld))
+		})
+
+		It("invalid name returns validation error", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "invalid.name",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(locks.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("expiry in the past returns validation error", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(-time.Minute),
+			})
+			Expect(locks.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Extend", func() {
+		It("can extend a held lock", func(ctx context.Context) {
+			lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(200 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			expires := time.Now().Add(time.Minute)
+			extended, err := manager.Extend(ctx, "test", lock.Token, expires)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extended.Expires).To(Equal(expires))
+
+			time.Sleep(250 * time.Millisecond)
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).To(MatchError(locks.ErrLockHeld))
+		})
+
+		It("can not extend with wrong token", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Extend(ctx, "test", "invalid", time.Now().Add(time.Minute))
+			Expect(err).To(MatchError(locks.ErrLockNotHeld))
+		})
+
+		It("can not extend an expired lock", func(ctx context.Context) {
+			lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(100 * time.Millisecond),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			time.Sleep(150 * time.Millisecond)
+
+			_, err = manager.Extend(ctx, "test", lock.Token, time.Now().Add(time.Minute))
+			Expect(err).To(MatchError(locks.ErrLockNotHeld))
+		})
+	})
+
+	Describe("Release", func() {
+		It("can release a held lock", func(ctx context.Context) {
+			lock, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Release(ctx, "test", lock.Token)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can not release with wrong token", func(ctx context.Context) {
+			_, err := manager.Acquire(ctx, &locks.AcquireConfig{
+				Name:    "test",
+				Expires: time.Now().Add(time.Minute),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Release(ctx, "test", "invalid")
+			Expect(err).To(MatchError(locks.ErrLockNotHeld))
+		})
+
+		It("can not release a lock that is not held", func(ctx context.Context) {
+			_, err := manager.Release(ctx, "test", "invalid")
+			Expect(err).To(MatchError(locks.ErrLockNotHeld))
+		})
+	})
+})
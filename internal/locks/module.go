@@ -0,0 +1,44 @@
+package locks
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/sprout-go"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"locks",
+	fx.Provide(sprout.Logger("locks"), fx.Private),
+	fx.Provide(sprout.ServiceTracer(), fx.Private),
+	fx.Provide(sprout.Config("LOCKS", &Config{}), fx.Private),
+	fx.Provide(NewManager),
+	fx.Invoke(startExpiry),
+)
+
+type Config struct {
+	// HistoryMaxAge is how long lock events are kept in the event log.
+	HistoryMaxAge time.Duration `env:"HISTORY_MAX_AGE" envDefault:"168h"`
+	// ExpiryCheckInterval is how often locks are checked for having expired
+	// without being extended or released.
+	ExpiryCheckInterval time.Duration `env:"EXPIRY_CHECK_INTERVAL" envDefault:"1s"`
+}
+
+// startExpiry removes expired locks while the application is running.
+func startExpiry(lifecycle fx.Lifecycle, manager *Manager) {
+	var stop func()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			stop, err = manager.StartExpiry(ctx)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			if stop != nil {
+				stop()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package locks_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/locks"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap/zaptest"
+)
+
+func GetNATS() *nats.Conn {
+	tempDir, err := os.MkdirTemp("", "nats")
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	ns, err := server.NewServer(&server.Options{
+		Port:       -1,
+		JetStream:  true,
+		StoreDir:   tempDir,
+		DontListen: true,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		ns.Shutdown()
+		ns.WaitForShutdown()
+	})
+
+	go ns.Start()
+	if !ns.ReadyForConnections(4 * time.Second) {
+		Fail("unable to start nats server")
+	}
+
+	natsConn, err := nats.Connect(ns.ClientURL(), nats.InProcessServer(ns))
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		natsConn.Close()
+	})
+	return natsConn
+}
+
+func createManagerAndJetStream() (*locks.Manager, jetstream.JetStream) {
+	natsConn := GetNATS()
+
+	js, err := jetstream.New(natsConn)
+	Expect(err).ToNot(HaveOccurred())
+
+	manager, err := locks.NewManager(
+		zaptest.NewLogger(GinkgoT()),
+		otel.Tracer("tests"),
+		js,
+		&locks.Config{
+			HistoryMaxAge:       time.Hour,
+			ExpiryCheckInterval: 50 * time.Millisecond,
+		},
+	)
+	Expect(err).ToNot(HaveOccurred())
+
+	return manager, js
+}
@@ -0,0 +1,10 @@
+package locks
+
+import "github.com/levelfourab/windshift-server/internal/events"
+
+// IsValidLockName checks if the lock name is valid. Lock names follow the
+// same rules as stream names and allow only the characters `a`-`z`, `A`-`Z`,
+// `0`-`9`, `_`, and `-`.
+func IsValidLockName(name string) bool {
+	return events.IsValidStreamName(name)
+}
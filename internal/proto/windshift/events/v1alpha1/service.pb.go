@@ -23,6 +23,134 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How events are delivered as CloudEvents.
+//
+// The attributes of delivered CloudEvents are:
+//
+//   - `id` is the idempotency key of the event, or its id if it was published
+//     without one.
+//   - `source` is the custom header `source`, or `/windshift/streams/<stream>`.
+//   - `type` is the full name of the Protobuf message of the data.
+//   - `subject` is the subject of the event.
+//   - `time` is the timestamp of the event.
+//   - `datacontenttype` is `application/protobuf` or `application/json`
+//     depending on the encoding.
+//   - `dataschema` is the type URL of the data.
+//   - `traceparent` and `tracestate` contain the trace context of the event.
+//   - Custom headers with names that are valid attribute names, that is only
+//     containing `a` to `z` and `0` to `9`, are added as extension attributes.
+type CloudEventsMode int32
+
+const (
+	// Unspecified mode, events are not sent as CloudEvents.
+	CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED CloudEventsMode = 0
+	// Events are sent in structured mode using the Protobuf format, in
+	// structured_cloud_event.
+	CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED CloudEventsMode = 1
+	// Events are sent in structured mode using the JSON format, in
+	// structured_json_cloud_event.
+	CloudEventsMode_CLOUD_EVENTS_MODE_STRUCTURED_JSON CloudEventsMode = 2
+	// Events are sent in binary mode, in binary_cloud_event.
+	CloudEventsMode_CLOUD_EVENTS_MODE_BINARY CloudEventsMode = 3
+)
+
+// Enum value maps for CloudEventsMode.
+var (
+	CloudEventsMode_name = map[int32]string{
+		0: "CLOUD_EVENTS_MODE_UNSPECIFIED",
+		1: "CLOUD_EVENTS_MODE_STRUCTURED",
+		2: "CLOUD_EVENTS_MODE_STRUCTURED_JSON",
+		3: "CLOUD_EVENTS_MODE_BINARY",
+	}
+	CloudEventsMode_value = map[string]int32{
+		"CLOUD_EVENTS_MODE_UNSPECIFIED":     0,
+		"CLOUD_EVENTS_MODE_STRUCTURED":      1,
+		"CLOUD_EVENTS_MODE_STRUCTURED_JSON": 2,
+		"CLOUD_EVENTS_MODE_BINARY":          3,
+	}
+)
+
+func (x CloudEventsMode) Enum() *CloudEventsMode {
+	p := new(CloudEventsMode)
+	*p = x
+	return p
+}
+
+func (x CloudEventsMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CloudEventsMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (CloudEventsMode) Type() protoreflect.EnumType {
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[0]
+}
+
+func (x CloudEventsMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CloudEventsMode.Descriptor instead.
+func (CloudEventsMode) EnumDescriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0}
+}
+
+// Encoding of the data of events.
+type Encoding int32
+
+const (
+	// Unspecified encoding, will default to Protobuf.
+	Encoding_ENCODING_UNSPECIFIED Encoding = 0
+	// Data is returned as `google.protobuf.Any` in the data field.
+	Encoding_ENCODING_PROTOBUF Encoding = 1
+	// Data is returned as JSON in the json_data field, using the types
+	// registered with the SchemaService.
+	Encoding_ENCODING_JSON Encoding = 2
+)
+
+// Enum value maps for Encoding.
+var (
+	Encoding_name = map[int32]string{
+		0: "ENCODING_UNSPECIFIED",
+		1: "ENCODING_PROTOBUF",
+		2: "ENCODING_JSON",
+	}
+	Encoding_value = map[string]int32{
+		"ENCODING_UNSPECIFIED": 0,
+		"ENCODING_PROTOBUF":    1,
+		"ENCODING_JSON":        2,
+	}
+)
+
+func (x Encoding) Enum() *Encoding {
+	p := new(Encoding)
+	*p = x
+	return p
+}
+
+func (x Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Encoding) Type() protoreflect.EnumType {
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[1]
+}
+
+func (x Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Encoding.Descriptor instead.
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{1}
+}
+
 // Policy to use when discarding events when the stream is full.
 type EnsureStreamRequest_DiscardPolicy int32
 
@@ -59,11 +187,11 @@ func (x EnsureStreamRequest_DiscardPolicy) String() string {
 }
 
 func (EnsureStreamRequest_DiscardPolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_windshift_events_v1alpha1_service_proto_enumTypes[0].Descriptor()
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[2].Descriptor()
 }
 
 func (EnsureStreamRequest_DiscardPolicy) Type() protoreflect.EnumType {
-	return &file_windshift_events_v1alpha1_service_proto_enumTypes[0]
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[2]
 }
 
 func (x EnsureStreamRequest_DiscardPolicy) Number() protoreflect.EnumNumber {
@@ -112,11 +240,11 @@ func (x EnsureStreamRequest_StorageType) String() string {
 }
 
 func (EnsureStreamRequest_StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_windshift_events_v1alpha1_service_proto_enumTypes[1].Descriptor()
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[3].Descriptor()
 }
 
 func (EnsureStreamRequest_StorageType) Type() protoreflect.EnumType {
-	return &file_windshift_events_v1alpha1_service_proto_enumTypes[1]
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[3]
 }
 
 func (x EnsureStreamRequest_StorageType) Number() protoreflect.EnumNumber {
@@ -128,6 +256,123 @@ func (EnsureStreamRequest_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 1}
 }
 
+// Why the event was dead-lettered.
+type DeadLetter_Reason int32
+
+const (
+	DeadLetter_REASON_UNSPECIFIED DeadLetter_Reason = 0
+	// The event was delivered the maximum number of times.
+	DeadLetter_REASON_MAX_DELIVERIES DeadLetter_Reason = 1
+	// The event was permanently rejected.
+	DeadLetter_REASON_REJECTED DeadLetter_Reason = 2
+)
+
+// Enum value maps for DeadLetter_Reason.
+var (
+	DeadLetter_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_MAX_DELIVERIES",
+		2: "REASON_REJECTED",
+	}
+	DeadLetter_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":    0,
+		"REASON_MAX_DELIVERIES": 1,
+		"REASON_REJECTED":       2,
+	}
+)
+
+func (x DeadLetter_Reason) Enum() *DeadLetter_Reason {
+	p := new(DeadLetter_Reason)
+	*p = x
+	return p
+}
+
+func (x DeadLetter_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetter_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (DeadLetter_Reason) Type() protoreflect.EnumType {
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[4]
+}
+
+func (x DeadLetter_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetter_Reason.Descriptor instead.
+func (DeadLetter_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{22, 0}
+}
+
+// Code of a publish error.
+type PublishError_Code int32
+
+const (
+	PublishError_CODE_UNSPECIFIED PublishError_Code = 0
+	// The event was invalid, such as having an invalid subject.
+	PublishError_CODE_INVALID_ARGUMENT PublishError_Code = 1
+	// The subject of the event is not bound to a stream.
+	PublishError_CODE_UNBOUND_SUBJECT PublishError_Code = 2
+	// The expected last id did not match.
+	PublishError_CODE_WRONG_SEQUENCE PublishError_Code = 3
+	// The event was not confirmed in time, it may or may not have been
+	// published.
+	PublishError_CODE_TIMEOUT PublishError_Code = 4
+	// An unexpected error occurred.
+	PublishError_CODE_INTERNAL PublishError_Code = 5
+)
+
+// Enum value maps for PublishError_Code.
+var (
+	PublishError_Code_name = map[int32]string{
+		0: "CODE_UNSPECIFIED",
+		1: "CODE_INVALID_ARGUMENT",
+		2: "CODE_UNBOUND_SUBJECT",
+		3: "CODE_WRONG_SEQUENCE",
+		4: "CODE_TIMEOUT",
+		5: "CODE_INTERNAL",
+	}
+	PublishError_Code_value = map[string]int32{
+		"CODE_UNSPECIFIED":      0,
+		"CODE_INVALID_ARGUMENT": 1,
+		"CODE_UNBOUND_SUBJECT":  2,
+		"CODE_WRONG_SEQUENCE":   3,
+		"CODE_TIMEOUT":          4,
+		"CODE_INTERNAL":         5,
+	}
+)
+
+func (x PublishError_Code) Enum() *PublishError_Code {
+	p := new(PublishError_Code)
+	*p = x
+	return p
+}
+
+func (x PublishError_Code) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishError_Code) Descriptor() protoreflect.EnumDescriptor {
+	return file_windshift_events_v1alpha1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PublishError_Code) Type() protoreflect.EnumType {
+	return &file_windshift_events_v1alpha1_service_proto_enumTypes[5]
+}
+
+func (x PublishError_Code) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishError_Code.Descriptor instead.
+func (PublishError_Code) EnumDescriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{39, 0}
+}
+
 // Request that creates or updates a stream. Commonly called at the start of
 // a program to ensure that the stream exists, or in a declarative way by the
 // admin to ensure that the stream is configured correctly.
@@ -164,7 +409,9 @@ type EnsureStreamRequest struct {
 	//
 	// Defaults to 2 minutes if not provided.
 	DeduplicationWindow *durationpb.Duration `protobuf:"bytes,7,opt,name=deduplication_window,json=deduplicationWindow,proto3,oneof" json:"deduplication_window,omitempty"`
-	// The maximum size of an event in bytes.
+	// The maximum size of an event in bytes. Events with data that is
+	// offloaded to the object store only count the size of the reference to
+	// the data.
 	//
 	// Defaults to 1 MiB if not provided.
 	MaxEventSize *uint32 `protobuf:"varint,8,opt,name=max_event_size,json=maxEventSize,proto3,oneof" json:"max_event_size,omitempty"`
@@ -295,6 +542,9 @@ type EnsureStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The stream as it was created or updated.
+	Stream *StreamInfo `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
 func (x *EnsureStreamResponse) Reset() {
@@ -329,73 +579,25 @@ func (*EnsureStreamResponse) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{1}
 }
 
-// Request to create or update a consumer. Consumers are managed by the programs
-// that use them, and this event is commonly sent at the start of a program to
-// ensure that the consumer exists.
-type EnsureConsumerRequest struct {
+func (x *EnsureStreamResponse) GetStream() *StreamInfo {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// Request to get information about a stream.
+type GetStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The event stream to consume events from. The stream must already exist.
-	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	// The name of the consumer, should be unique to stream if provided,
-	// providing one creates a durable consumer which supports resumption and
-	// shared processing of events.
-	//
-	// If not provided the consumer will be ephemeral and will not be able to
-	// resume or share processing of events.
-	//
-	// When a name is specified it is case-sensitive and should only
-	// contain the following characters:
-	//
-	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
-	//   - `_` and `-` are allowed for separating words, but the use of camelCase
-	//     is recommended.
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// The subjects to subscribe to. Subjects are case-sensitive and should only
-	// contain the following characters:
-	//
-	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
-	//
-	//   - `_` and `-` are allowed for separating words, but the use of camelCase
-	//     is recommended.
-	//
-	//   - `.` is allowed and used as a hierarchy separator, such as
-	//     `time.us.east` and `time.eu.sweden`, which share the `time`
-	//     prefix.
-	//
-	//   - `*` matches a single token, at any level of the subject. Such as
-	//     `time.*.east` will match `time.us.east` and `time.eu.east` but not
-	//     `time.us.west` or `time.us.central.east`. Similarly `time.us.*` will
-	//     match `time.us.east` but not `time.us.east.atlanta`.
-	//
-	//     The `*` wildcard can be used multiple times in a subject, such as
-	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
-	//     `time.us.east.atlanta`.
-	//   - `>` matches one or more tokens at the tail of a subject, and can only
-	//     be used as the last token. Such as `time.us.>` will match
-	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
-	//
-	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
-	Subjects []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
-	// The pointer to start receiving events from. Used to control how this
-	// subscriber will receive old events.
-	//
-	// Defaults to new events if not provided.
-	From *StreamPointer `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	// The timeout for events, after which they will be resent.
-	//
-	// Events will need to be acknowledged, rejected or pinged before the
-	// timeout expires, otherwise they will be marked as failed and queued
-	// for delivery again.
-	//
-	// Defaults to 30 seconds if not provided.
-	ProcessingTimeout *durationpb.Duration `protobuf:"bytes,5,opt,name=processing_timeout,json=processingTimeout,proto3,oneof" json:"processing_timeout,omitempty"`
+	// Name of the stream to get.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *EnsureConsumerRequest) Reset() {
-	*x = EnsureConsumerRequest{}
+func (x *GetStreamRequest) Reset() {
+	*x = GetStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,13 +605,13 @@ func (x *EnsureConsumerRequest) Reset() {
 	}
 }
 
-func (x *EnsureConsumerRequest) String() string {
+func (x *GetStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureConsumerRequest) ProtoMessage() {}
+func (*GetStreamRequest) ProtoMessage() {}
 
-func (x *EnsureConsumerRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,58 +623,30 @@ func (x *EnsureConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureConsumerRequest.ProtoReflect.Descriptor instead.
-func (*EnsureConsumerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamRequest) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *EnsureConsumerRequest) GetStream() string {
+func (x *GetStreamRequest) GetName() string {
 	if x != nil {
-		return x.Stream
-	}
-	return ""
-}
-
-func (x *EnsureConsumerRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Name
 	}
 	return ""
 }
 
-func (x *EnsureConsumerRequest) GetSubjects() []string {
-	if x != nil {
-		return x.Subjects
-	}
-	return nil
-}
-
-func (x *EnsureConsumerRequest) GetFrom() *StreamPointer {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *EnsureConsumerRequest) GetProcessingTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ProcessingTimeout
-	}
-	return nil
-}
-
-// Response to creating or updating a consumer.
-type EnsureConsumerResponse struct {
+// Response with information about a stream.
+type GetStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The id of the consumer.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The stream.
+	Stream *StreamInfo `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *EnsureConsumerResponse) Reset() {
-	*x = EnsureConsumerResponse{}
+func (x *GetStreamResponse) Reset() {
+	*x = GetStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,13 +654,13 @@ func (x *EnsureConsumerResponse) Reset() {
 	}
 }
 
-func (x *EnsureConsumerResponse) String() string {
+func (x *GetStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureConsumerResponse) ProtoMessage() {}
+func (*GetStreamResponse) ProtoMessage() {}
 
-func (x *EnsureConsumerResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,33 +672,37 @@ func (x *EnsureConsumerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureConsumerResponse.ProtoReflect.Descriptor instead.
-func (*EnsureConsumerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamResponse) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EnsureConsumerResponse) GetId() string {
+func (x *GetStreamResponse) GetStream() *StreamInfo {
 	if x != nil {
-		return x.Id
+		return x.Stream
 	}
-	return ""
+	return nil
 }
 
-// Request to delete a consumer.
-type DeleteConsumerRequest struct {
+// Request to list streams.
+type ListStreamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The event stream to delete the consumer from. The stream must already
-	// exist.
-	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	// The id of the consumer to delete. The consumer must already exist.
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of streams to return.
+	//
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *DeleteConsumerRequest) Reset() {
-	*x = DeleteConsumerRequest{}
+func (x *ListStreamsRequest) Reset() {
+	*x = ListStreamsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +710,13 @@ func (x *DeleteConsumerRequest) Reset() {
 	}
 }
 
-func (x *DeleteConsumerRequest) String() string {
+func (x *ListStreamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConsumerRequest) ProtoMessage() {}
+func (*ListStreamsRequest) ProtoMessage() {}
 
-func (x *DeleteConsumerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListStreamsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,34 +728,40 @@ func (x *DeleteConsumerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConsumerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConsumerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListStreamsRequest.ProtoReflect.Descriptor instead.
+func (*ListStreamsRequest) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DeleteConsumerRequest) GetStream() string {
-	if x != nil {
-		return x.Stream
+func (x *ListStreamsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteConsumerRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListStreamsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
 	return ""
 }
 
-// Response to deleting a consumer.
-type DeleteConsumerResponse struct {
+// Response to listing streams.
+type ListStreamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The streams in this page, ordered by name.
+	Streams []*StreamInfo `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
 }
 
-func (x *DeleteConsumerResponse) Reset() {
-	*x = DeleteConsumerResponse{}
+func (x *ListStreamsResponse) Reset() {
+	*x = ListStreamsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,13 +769,13 @@ func (x *DeleteConsumerResponse) Reset() {
 	}
 }
 
-func (x *DeleteConsumerResponse) String() string {
+func (x *ListStreamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConsumerResponse) ProtoMessage() {}
+func (*ListStreamsResponse) ProtoMessage() {}
 
-func (x *DeleteConsumerResponse) ProtoReflect() protoreflect.Message {
+func (x *ListStreamsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,59 +787,37 @@ func (x *DeleteConsumerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConsumerResponse.ProtoReflect.Descriptor instead.
-func (*DeleteConsumerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListStreamsResponse.ProtoReflect.Descriptor instead.
+func (*ListStreamsResponse) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{5}
 }
 
-// Request to publish an event.
-type PublishEventRequest struct {
+func (x *ListStreamsResponse) GetStreams() []*StreamInfo {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *ListStreamsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+// Request to delete a stream.
+type DeleteStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The subject of the event. Subjects are case-sensitive and should only
-	// contain the following characters:
-	//
-	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
-	//   - `_` and `-` are allowed for separating words, but the use of camelCase
-	//     is recommended.
-	//   - `.` is allowed and used as a hierarchy separator, such as
-	//     `time.us.east` and `time.eu.sweden`, which share the `time` prefix.
-	//
-	// If the subject is not bound to a stream the event will be rejected.
-	//
-	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
-	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	// The event data. The data is dynamic and it is up to the publisher
-	// and subscriber to agree on the Protobuf messages to support.
-	//
-	// Each subject can support several different types of data, which can be
-	// useful to support different versions of the data. Subscribers should
-	// ignore data they do not understand.
-	Data *anypb.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// Timestamp of the event.
-	//
-	// Defaults to the current time of the server if not provided.
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"`
-	// Key used to prevent duplicate events from being stored if a retry is
-	// needed. Should be set to a unique value for each publish request of a
-	// specific event.
-	//
-	// No default, events will not be deduplicated if not provided.
-	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	// The id of the event that is expected to be in the last published event in
-	// the stream.
-	//
-	// Used for optimistic concurrency control, if the specified event id does
-	// not match the current last event id, the publish will fail.
-	//
-	// No default, publish will not check the last event if not provided.
-	ExpectedLastId *uint64 `protobuf:"varint,5,opt,name=expected_last_id,json=expectedLastId,proto3,oneof" json:"expected_last_id,omitempty"`
+	// Name of the stream to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *PublishEventRequest) Reset() {
-	*x = PublishEventRequest{}
+func (x *DeleteStreamRequest) Reset() {
+	*x = DeleteStreamRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,13 +825,13 @@ func (x *PublishEventRequest) Reset() {
 	}
 }
 
-func (x *PublishEventRequest) String() string {
+func (x *DeleteStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishEventRequest) ProtoMessage() {}
+func (*DeleteStreamRequest) ProtoMessage() {}
 
-func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,58 +843,27 @@ func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
-func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteStreamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStreamRequest) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PublishEventRequest) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *PublishEventRequest) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-func (x *PublishEventRequest) GetTimestamp() *timestamppb.Timestamp {
+func (x *DeleteStreamRequest) GetName() string {
 	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-func (x *PublishEventRequest) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
+		return x.Name
 	}
 	return ""
 }
 
-func (x *PublishEventRequest) GetExpectedLastId() uint64 {
-	if x != nil && x.ExpectedLastId != nil {
-		return *x.ExpectedLastId
-	}
-	return 0
-}
-
-// Response to publish an event.
-type PublishEventResponse struct {
+// Response to deleting a stream.
+type DeleteStreamResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The id of the published event.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PublishEventResponse) Reset() {
-	*x = PublishEventResponse{}
+func (x *DeleteStreamResponse) Reset() {
+	*x = DeleteStreamResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,13 +871,13 @@ func (x *PublishEventResponse) Reset() {
 	}
 }
 
-func (x *PublishEventResponse) String() string {
+func (x *DeleteStreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishEventResponse) ProtoMessage() {}
+func (*DeleteStreamResponse) ProtoMessage() {}
 
-func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteStreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -758,41 +889,43 @@ func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
-func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteStreamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStreamResponse) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PublishEventResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-// Request to subscribe to events or to acknowledge, reject or ping events.
-// The first message sent on the stream must be a Subscribe message, after
-// which the client can send Ack, Reject or Ping messages.
-//
-// Events should be either acknowledged or rejected, otherwise they will be
-// resent after a timeout. If processing takes of an event takes a while pings
-// should be sent to indicate that the event is still being processed.
-type EventsRequest struct {
+// Information about a stream, its effective configuration and current state.
+type StreamInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Request:
+	// Name of the stream.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Timestamp of when the stream was created.
+	Created *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Retention policy of the stream.
+	RetentionPolicy *EnsureStreamRequest_RetentionPolicy `protobuf:"bytes,3,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	// Source of events for the stream.
 	//
-	//	*EventsRequest_Subscribe_
-	//	*EventsRequest_Ack_
-	//	*EventsRequest_Reject_
-	//	*EventsRequest_Ping_
-	Request isEventsRequest_Request `protobuf_oneof:"request"`
-}
-
-func (x *EventsRequest) Reset() {
-	*x = EventsRequest{}
+	// Types that are assignable to Source:
+	//
+	//	*StreamInfo_Subjects
+	//	*StreamInfo_Mirror
+	//	*StreamInfo_Aggregate
+	Source isStreamInfo_Source `protobuf_oneof:"source"`
+	// How the stream is stored.
+	Storage *EnsureStreamRequest_Storage `protobuf:"bytes,7,opt,name=storage,proto3" json:"storage,omitempty"`
+	// The amount of time duplicate detection is enabled for.
+	DeduplicationWindow *durationpb.Duration `protobuf:"bytes,8,opt,name=deduplication_window,json=deduplicationWindow,proto3" json:"deduplication_window,omitempty"`
+	// The maximum size of an event in bytes, zero if not limited.
+	MaxEventSize uint32 `protobuf:"varint,9,opt,name=max_event_size,json=maxEventSize,proto3" json:"max_event_size,omitempty"`
+	// Current state of the stream.
+	State *StreamInfo_State `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +933,13 @@ func (x *EventsRequest) Reset() {
 	}
 }
 
-func (x *EventsRequest) String() string {
+func (x *StreamInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsRequest) ProtoMessage() {}
+func (*StreamInfo) ProtoMessage() {}
 
-func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,119 +951,216 @@ func (x *EventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
-func (*EventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *EventsRequest) GetRequest() isEventsRequest_Request {
+func (x *StreamInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *StreamInfo) GetRetentionPolicy() *EnsureStreamRequest_RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetSource() isStreamInfo_Source {
 	if m != nil {
-		return m.Request
+		return m.Source
 	}
 	return nil
 }
 
-func (x *EventsRequest) GetSubscribe() *EventsRequest_Subscribe {
-	if x, ok := x.GetRequest().(*EventsRequest_Subscribe_); ok {
-		return x.Subscribe
+func (x *StreamInfo) GetSubjects() *EnsureStreamRequest_Subjects {
+	if x, ok := x.GetSource().(*StreamInfo_Subjects); ok {
+		return x.Subjects
 	}
 	return nil
 }
 
-func (x *EventsRequest) GetAck() *EventsRequest_Ack {
-	if x, ok := x.GetRequest().(*EventsRequest_Ack_); ok {
-		return x.Ack
+func (x *StreamInfo) GetMirror() *EnsureStreamRequest_StreamSource {
+	if x, ok := x.GetSource().(*StreamInfo_Mirror); ok {
+		return x.Mirror
 	}
 	return nil
 }
 
-func (x *EventsRequest) GetReject() *EventsRequest_Reject {
-	if x, ok := x.GetRequest().(*EventsRequest_Reject_); ok {
-		return x.Reject
+func (x *StreamInfo) GetAggregate() *EnsureStreamRequest_StreamSources {
+	if x, ok := x.GetSource().(*StreamInfo_Aggregate); ok {
+		return x.Aggregate
 	}
 	return nil
 }
 
-func (x *EventsRequest) GetPing() *EventsRequest_Ping {
-	if x, ok := x.GetRequest().(*EventsRequest_Ping_); ok {
-		return x.Ping
+func (x *StreamInfo) GetStorage() *EnsureStreamRequest_Storage {
+	if x != nil {
+		return x.Storage
 	}
 	return nil
 }
 
-type isEventsRequest_Request interface {
-	isEventsRequest_Request()
+func (x *StreamInfo) GetDeduplicationWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DeduplicationWindow
+	}
+	return nil
 }
 
-type EventsRequest_Subscribe_ struct {
-	// Subscribe to events, must be sent as the first message in the stream
-	// to establish what events to listen to.
-	//
-	// A ConsumeResponse.Subscribed message will be sent in response to
-	// this message. If the subscription fails, the stream will be closed.
-	Subscribe *EventsRequest_Subscribe `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+func (x *StreamInfo) GetMaxEventSize() uint32 {
+	if x != nil {
+		return x.MaxEventSize
+	}
+	return 0
 }
 
-type EventsRequest_Ack_ struct {
-	// Acknowledge that some events have been successfully processed. When
-	// an event is acknowledged a ConsumeResponse.AckConfirmation message
-	// will be sent in response.
-	Ack *EventsRequest_Ack `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+func (x *StreamInfo) GetState() *StreamInfo_State {
+	if x != nil {
+		return x.State
+	}
+	return nil
 }
 
-type EventsRequest_Reject_ struct {
-	// Acknowledge that some events have failed to be processed. When an
-	// event is rejected a ConsumeResponse.RejectConfirmation message will
-	// be sent in response.
-	Reject *EventsRequest_Reject `protobuf:"bytes,3,opt,name=reject,proto3,oneof"`
+type isStreamInfo_Source interface {
+	isStreamInfo_Source()
 }
 
-type EventsRequest_Ping_ struct {
-	// Ping events to indicate that they are still being processed. When
-	// an event is pinged a ConsumeResponse.PingConfirmation message will
-	// be sent in response.
-	Ping *EventsRequest_Ping `protobuf:"bytes,4,opt,name=ping,proto3,oneof"`
+type StreamInfo_Subjects struct {
+	// Subjects events are collected for.
+	Subjects *EnsureStreamRequest_Subjects `protobuf:"bytes,4,opt,name=subjects,proto3,oneof"`
 }
 
-func (*EventsRequest_Subscribe_) isEventsRequest_Request() {}
+type StreamInfo_Mirror struct {
+	// Stream that is mirrored.
+	Mirror *EnsureStreamRequest_StreamSource `protobuf:"bytes,5,opt,name=mirror,proto3,oneof"`
+}
 
-func (*EventsRequest_Ack_) isEventsRequest_Request() {}
+type StreamInfo_Aggregate struct {
+	// Streams events are received from.
+	Aggregate *EnsureStreamRequest_StreamSources `protobuf:"bytes,6,opt,name=aggregate,proto3,oneof"`
+}
 
-func (*EventsRequest_Reject_) isEventsRequest_Request() {}
+func (*StreamInfo_Subjects) isStreamInfo_Source() {}
 
-func (*EventsRequest_Ping_) isEventsRequest_Request() {}
+func (*StreamInfo_Mirror) isStreamInfo_Source() {}
 
-type EventsResponse struct {
+func (*StreamInfo_Aggregate) isStreamInfo_Source() {}
+
+// Request to create or update a consumer. Consumers are managed by the programs
+// that use them, and this event is commonly sent at the start of a program to
+// ensure that the consumer exists.
+type EnsureConsumerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
+	// The event stream to consume events from. The stream must already exist.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The name of the consumer, should be unique to stream if provided,
+	// providing one creates a durable consumer which supports resumption and
+	// shared processing of events.
 	//
-	//	*EventsResponse_Event
-	//	*EventsResponse_Subscribed_
-	//	*EventsResponse_AckConfirmation_
-	//	*EventsResponse_RejectConfirmation_
-	//	*EventsResponse_PingConfirmation_
-	Response isEventsResponse_Response `protobuf_oneof:"response"`
-}
-
-func (x *EventsResponse) Reset() {
-	*x = EventsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EventsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventsResponse) ProtoMessage() {}
-
-func (x *EventsResponse) ProtoReflect() protoreflect.Message {
+	// If not provided the consumer will be ephemeral and will not be able to
+	// resume or share processing of events.
+	//
+	// When a name is specified it is case-sensitive and should only
+	// contain the following characters:
+	//
+	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
+	//   - `_` and `-` are allowed for separating words, but the use of camelCase
+	//     is recommended.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// The subjects to subscribe to. Subjects are case-sensitive and should only
+	// contain the following characters:
+	//
+	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
+	//
+	//   - `_` and `-` are allowed for separating words, but the use of camelCase
+	//     is recommended.
+	//
+	//   - `.` is allowed and used as a hierarchy separator, such as
+	//     `time.us.east` and `time.eu.sweden`, which share the `time`
+	//     prefix.
+	//
+	//   - `*` matches a single token, at any level of the subject. Such as
+	//     `time.*.east` will match `time.us.east` and `time.eu.east` but not
+	//     `time.us.west` or `time.us.central.east`. Similarly `time.us.*` will
+	//     match `time.us.east` but not `time.us.east.atlanta`.
+	//
+	//     The `*` wildcard can be used multiple times in a subject, such as
+	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
+	//     `time.us.east.atlanta`.
+	//   - `>` matches one or more tokens at the tail of a subject, and can only
+	//     be used as the last token. Such as `time.us.>` will match
+	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
+	//
+	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
+	Subjects []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// The pointer to start receiving events from. Used to control how this
+	// subscriber will receive old events.
+	//
+	// Defaults to new events if not provided.
+	From *StreamPointer `protobuf:"bytes,4,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// The timeout for events, after which they will be resent.
+	//
+	// Events will need to be acknowledged, rejected or pinged before the
+	// timeout expires, otherwise they will be marked as failed and queued
+	// for delivery again.
+	//
+	// Defaults to 30 seconds if not provided.
+	ProcessingTimeout *durationpb.Duration `protobuf:"bytes,5,opt,name=processing_timeout,json=processingTimeout,proto3,oneof" json:"processing_timeout,omitempty"`
+	// Subject that events are copied to when they can not be processed,
+	// either because they were permanently rejected or delivered the maximum
+	// number of times. The subject must be bound to a stream.
+	//
+	// Dead-lettered events keep their data and headers, and can be listed
+	// and requeued using ListDeadLetters and RequeueDeadLetters.
+	//
+	// No default, if not provided events that fail processing are dropped.
+	DeadLetterSubject *string `protobuf:"bytes,6,opt,name=dead_letter_subject,json=deadLetterSubject,proto3,oneof" json:"dead_letter_subject,omitempty"`
+	// The maximum number of times an event is delivered. When reached the
+	// event is considered failed and will not be delivered again.
+	//
+	// No default, if not provided events are delivered until processed.
+	MaxDeliveryAttempts *uint32 `protobuf:"varint,7,opt,name=max_delivery_attempts,json=maxDeliveryAttempts,proto3,oneof" json:"max_delivery_attempts,omitempty"`
+	// Backoff to use when redelivering events, both for rejected events and
+	// events that are not processed within the processing timeout. Delays are
+	// used as the processing timeout of redeliveries, so every delay must be
+	// at least the processing timeout.
+	//
+	// No default, if not provided events that time out are redelivered after
+	// the processing timeout and rejected events are redelivered directly.
+	RedeliveryBackoff *EnsureConsumerRequest_RedeliveryBackoff `protobuf:"bytes,8,opt,name=redelivery_backoff,json=redeliveryBackoff,proto3,oneof" json:"redelivery_backoff,omitempty"`
+}
+
+func (x *EnsureConsumerRequest) Reset() {
+	*x = EnsureConsumerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureConsumerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureConsumerRequest) ProtoMessage() {}
+
+func (x *EnsureConsumerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -942,125 +1172,145 @@ func (x *EventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
-func (*EventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnsureConsumerRequest.ProtoReflect.Descriptor instead.
+func (*EnsureConsumerRequest) Descriptor() ([]byte, []int) {
 	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *EventsResponse) GetResponse() isEventsResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *EnsureConsumerRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-func (x *EventsResponse) GetEvent() *Event {
-	if x, ok := x.GetResponse().(*EventsResponse_Event); ok {
-		return x.Event
+func (x *EnsureConsumerRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *EventsResponse) GetSubscribed() *EventsResponse_Subscribed {
-	if x, ok := x.GetResponse().(*EventsResponse_Subscribed_); ok {
-		return x.Subscribed
+func (x *EnsureConsumerRequest) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
 	}
 	return nil
 }
 
-func (x *EventsResponse) GetAckConfirmation() *EventsResponse_AckConfirmation {
-	if x, ok := x.GetResponse().(*EventsResponse_AckConfirmation_); ok {
-		return x.AckConfirmation
+func (x *EnsureConsumerRequest) GetFrom() *StreamPointer {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-func (x *EventsResponse) GetRejectConfirmation() *EventsResponse_RejectConfirmation {
-	if x, ok := x.GetResponse().(*EventsResponse_RejectConfirmation_); ok {
-		return x.RejectConfirmation
+func (x *EnsureConsumerRequest) GetProcessingTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProcessingTimeout
 	}
 	return nil
 }
 
-func (x *EventsResponse) GetPingConfirmation() *EventsResponse_PingConfirmation {
-	if x, ok := x.GetResponse().(*EventsResponse_PingConfirmation_); ok {
-		return x.PingConfirmation
+func (x *EnsureConsumerRequest) GetDeadLetterSubject() string {
+	if x != nil && x.DeadLetterSubject != nil {
+		return *x.DeadLetterSubject
 	}
-	return nil
+	return ""
 }
 
-type isEventsResponse_Response interface {
-	isEventsResponse_Response()
+func (x *EnsureConsumerRequest) GetMaxDeliveryAttempts() uint32 {
+	if x != nil && x.MaxDeliveryAttempts != nil {
+		return *x.MaxDeliveryAttempts
+	}
+	return 0
 }
 
-type EventsResponse_Event struct {
-	// An event that was published.
-	Event *Event `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
+func (x *EnsureConsumerRequest) GetRedeliveryBackoff() *EnsureConsumerRequest_RedeliveryBackoff {
+	if x != nil {
+		return x.RedeliveryBackoff
+	}
+	return nil
 }
 
-type EventsResponse_Subscribed_ struct {
-	// Confirmation that the client has subscribed to events.
-	Subscribed *EventsResponse_Subscribed `protobuf:"bytes,2,opt,name=subscribed,proto3,oneof"`
-}
+// Response to creating or updating a consumer.
+type EnsureConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type EventsResponse_AckConfirmation_ struct {
-	// Confirmation that some events were acknowledged.
-	AckConfirmation *EventsResponse_AckConfirmation `protobuf:"bytes,3,opt,name=ack_confirmation,json=ackConfirmation,proto3,oneof"`
+	// The id of the consumer.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-type EventsResponse_RejectConfirmation_ struct {
-	// Confirmation that some events were rejected.
-	RejectConfirmation *EventsResponse_RejectConfirmation `protobuf:"bytes,4,opt,name=reject_confirmation,json=rejectConfirmation,proto3,oneof"`
+func (x *EnsureConsumerResponse) Reset() {
+	*x = EnsureConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type EventsResponse_PingConfirmation_ struct {
-	// Confirmation that a ping was received.
-	PingConfirmation *EventsResponse_PingConfirmation `protobuf:"bytes,5,opt,name=ping_confirmation,json=pingConfirmation,proto3,oneof"`
+func (x *EnsureConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse_Event) isEventsResponse_Response() {}
-
-func (*EventsResponse_Subscribed_) isEventsResponse_Response() {}
+func (*EnsureConsumerResponse) ProtoMessage() {}
 
-func (*EventsResponse_AckConfirmation_) isEventsResponse_Response() {}
+func (x *EnsureConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*EventsResponse_RejectConfirmation_) isEventsResponse_Response() {}
+// Deprecated: Use EnsureConsumerResponse.ProtoReflect.Descriptor instead.
+func (*EnsureConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{10}
+}
 
-func (*EventsResponse_PingConfirmation_) isEventsResponse_Response() {}
+func (x *EnsureConsumerResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-// A pointer to a position in a stream. Used to determine where to start
-// consuming events from.
-type StreamPointer struct {
+// Request to get information about a consumer.
+type GetConsumerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Pointer:
-	//
-	//	*StreamPointer_Start
-	//	*StreamPointer_End
-	//	*StreamPointer_Time
-	//	*StreamPointer_Offset
-	Pointer isStreamPointer_Pointer `protobuf_oneof:"pointer"`
+	// The event stream the consumer belongs to.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer to get.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *StreamPointer) Reset() {
-	*x = StreamPointer{}
+func (x *GetConsumerRequest) Reset() {
+	*x = GetConsumerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[10]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamPointer) String() string {
+func (x *GetConsumerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPointer) ProtoMessage() {}
+func (*GetConsumerRequest) ProtoMessage() {}
 
-func (x *StreamPointer) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[10]
+func (x *GetConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1071,121 +1321,110 @@ func (x *StreamPointer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPointer.ProtoReflect.Descriptor instead.
-func (*StreamPointer) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetConsumerRequest.ProtoReflect.Descriptor instead.
+func (*GetConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (m *StreamPointer) GetPointer() isStreamPointer_Pointer {
-	if m != nil {
-		return m.Pointer
+func (x *GetConsumerRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamPointer) GetStart() bool {
-	if x, ok := x.GetPointer().(*StreamPointer_Start); ok {
-		return x.Start
+func (x *GetConsumerRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *StreamPointer) GetEnd() bool {
-	if x, ok := x.GetPointer().(*StreamPointer_End); ok {
-		return x.End
-	}
-	return false
+// Response with information about a consumer.
+type GetConsumerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The consumer.
+	Consumer *ConsumerInfo `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
 }
 
-func (x *StreamPointer) GetTime() *timestamppb.Timestamp {
-	if x, ok := x.GetPointer().(*StreamPointer_Time); ok {
-		return x.Time
+func (x *GetConsumerResponse) Reset() {
+	*x = GetConsumerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StreamPointer) GetOffset() uint64 {
-	if x, ok := x.GetPointer().(*StreamPointer_Offset); ok {
-		return x.Offset
+func (x *GetConsumerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsumerResponse) ProtoMessage() {}
+
+func (x *GetConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type isStreamPointer_Pointer interface {
-	isStreamPointer_Pointer()
+// Deprecated: Use GetConsumerResponse.ProtoReflect.Descriptor instead.
+func (*GetConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{12}
 }
 
-type StreamPointer_Start struct {
-	// Receive all events currently available.
-	Start bool `protobuf:"varint,1,opt,name=start,proto3,oneof"`
-}
-
-type StreamPointer_End struct {
-	// Receive only future events.
-	End bool `protobuf:"varint,2,opt,name=end,proto3,oneof"`
-}
-
-type StreamPointer_Time struct {
-	// The time to start receiving events from.
-	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3,oneof"`
-}
-
-type StreamPointer_Offset struct {
-	// The id of the event to start receiving events from.
-	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3,oneof"`
+func (x *GetConsumerResponse) GetConsumer() *ConsumerInfo {
+	if x != nil {
+		return x.Consumer
+	}
+	return nil
 }
 
-func (*StreamPointer_Start) isStreamPointer_Pointer() {}
-
-func (*StreamPointer_End) isStreamPointer_Pointer() {}
-
-func (*StreamPointer_Time) isStreamPointer_Pointer() {}
-
-func (*StreamPointer_Offset) isStreamPointer_Pointer() {}
-
-// Event that was published and sent to a subscriber.
-type Event struct {
+// Request to list the consumers of a stream.
+type ListConsumersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Identifier of the event, used to acknowledge, reject or ping the event.
-	// Can also be used to resume processing of events if using an ephemeral
-	// subscriber.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The subject of this event.
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	// Headers of the event.
-	Headers *Headers `protobuf:"bytes,3,opt,name=headers,proto3" json:"headers,omitempty"`
-	// Data of the event. The data is dynamic and it is up to the publisher
-	// and subscriber to agree on the Protobuf messages to support.
+	// The event stream to list consumers for.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// Maximum number of consumers to return.
 	//
-	// Each subject can support several different types of data, which can be
-	// useful to support different versions of the data. Subscribers should
-	// ignore data they do not understand.
-	Data *anypb.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	// The delivery attempt this is. Starts at 1 and increases for each
-	// redelivery of the event.
-	DeliveryAttempt uint64 `protobuf:"varint,5,opt,name=delivery_attempt,json=deliveryAttempt,proto3" json:"delivery_attempt,omitempty"`
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *ListConsumersRequest) Reset() {
+	*x = ListConsumersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[11]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event) String() string {
+func (x *ListConsumersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ListConsumersRequest) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[11]
+func (x *ListConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,80 +1435,62 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *Event) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ListConsumersRequest.ProtoReflect.Descriptor instead.
+func (*ListConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Event) GetSubject() string {
+func (x *ListConsumersRequest) GetStream() string {
 	if x != nil {
-		return x.Subject
+		return x.Stream
 	}
 	return ""
 }
 
-func (x *Event) GetHeaders() *Headers {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *Event) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
+func (x *ListConsumersRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetDeliveryAttempt() uint64 {
-	if x != nil {
-		return x.DeliveryAttempt
+func (x *ListConsumersRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
-	return 0
+	return ""
 }
 
-type Headers struct {
+// Response to listing consumers.
+type ListConsumersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Timestamp of the event. This is the time the event occurred, clients
-	// may set this when publishing.
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Key used to prevent duplicate events from being stored if a retry is
-	// needed.
-	IdempotencyKey *string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	// Parent trace id in the W3C trace context format.
-	TraceParent *string `protobuf:"bytes,3,opt,name=trace_parent,json=traceParent,proto3,oneof" json:"trace_parent,omitempty"`
-	// Trace state in the W3C trace context format.
-	TraceState *string `protobuf:"bytes,4,opt,name=trace_state,json=traceState,proto3,oneof" json:"trace_state,omitempty"`
+	// The consumers in this page, ordered by id.
+	Consumers []*ConsumerInfo `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
 }
 
-func (x *Headers) Reset() {
-	*x = Headers{}
+func (x *ListConsumersResponse) Reset() {
+	*x = ListConsumersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[12]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Headers) String() string {
+func (x *ListConsumersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Headers) ProtoMessage() {}
+func (*ListConsumersResponse) ProtoMessage() {}
 
-func (x *Headers) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[12]
+func (x *ListConsumersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1280,95 +1501,72 @@ func (x *Headers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Headers.ProtoReflect.Descriptor instead.
-func (*Headers) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListConsumersResponse.ProtoReflect.Descriptor instead.
+func (*ListConsumersResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Headers) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListConsumersResponse) GetConsumers() []*ConsumerInfo {
 	if x != nil {
-		return x.Timestamp
+		return x.Consumers
 	}
 	return nil
 }
 
-func (x *Headers) GetIdempotencyKey() string {
-	if x != nil && x.IdempotencyKey != nil {
-		return *x.IdempotencyKey
-	}
-	return ""
-}
-
-func (x *Headers) GetTraceParent() string {
-	if x != nil && x.TraceParent != nil {
-		return *x.TraceParent
-	}
-	return ""
-}
-
-func (x *Headers) GetTraceState() string {
-	if x != nil && x.TraceState != nil {
-		return *x.TraceState
+func (x *ListConsumersResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
 	}
 	return ""
 }
 
-// Policy for how events in the stream should be retained.
-type EnsureStreamRequest_RetentionPolicy struct {
+// Information about a consumer, its configuration and current state.
+type ConsumerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Maximum age of events in the stream. Events older than this will be
-	// deleted.
-	//
-	// No default, if not provided events will not be deleted based on age.
-	MaxAge *durationpb.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
-	// Maximum number of events in the stream. Events beyond this limit will
-	// be deleted.
-	//
-	// No default, if not provided events will not be deleted based on
-	// count.
-	MaxEvents *uint64 `protobuf:"varint,2,opt,name=max_events,json=maxEvents,proto3,oneof" json:"max_events,omitempty"`
-	// Maximum number of events per subject in the stream. Events beyond
-	// this limit will be deleted.
-	//
-	// No default, if not provided events will not be deleted based on
-	// count.
-	MaxEventsPerSubject *uint64 `protobuf:"varint,3,opt,name=max_events_per_subject,json=maxEventsPerSubject,proto3,oneof" json:"max_events_per_subject,omitempty"`
-	// Maximum total byte size of all events in the stream. Events beyond
-	// this limit will be deleted.
-	//
-	// No default, if not provided events will not be deleted based on size.
-	MaxBytes *uint64 `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
-	// Policy to use when discarding events the stream is full.
-	//
-	// Defaults to discarding old events if not provided.
-	DiscardPolicy *EnsureStreamRequest_DiscardPolicy `protobuf:"varint,5,opt,name=discard_policy,json=discardPolicy,proto3,enum=windshift.events.v1alpha1.EnsureStreamRequest_DiscardPolicy,oneof" json:"discard_policy,omitempty"`
-	// If discard policy is set to discard new events, settings this to
-	// true will discard new events per subject instead of globally.
-	//
-	// Defaults to false if not provided.
-	DiscardNewPerSubject *bool `protobuf:"varint,6,opt,name=discard_new_per_subject,json=discardNewPerSubject,proto3,oneof" json:"discard_new_per_subject,omitempty"`
-}
-
-func (x *EnsureStreamRequest_RetentionPolicy) Reset() {
-	*x = EnsureStreamRequest_RetentionPolicy{}
+	// The event stream the consumer belongs to.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// If the consumer is durable, as opposed to ephemeral.
+	Durable bool `protobuf:"varint,3,opt,name=durable,proto3" json:"durable,omitempty"`
+	// Timestamp of when the consumer was created.
+	Created *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	// The subjects the consumer receives events for.
+	Subjects []string `protobuf:"bytes,5,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// The pointer the consumer started receiving events from.
+	From *StreamPointer `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	// The timeout for events, after which they will be resent.
+	ProcessingTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=processing_timeout,json=processingTimeout,proto3" json:"processing_timeout,omitempty"`
+	// The maximum number of times an event is delivered, zero if not limited.
+	MaxDeliveryAttempts uint32 `protobuf:"varint,8,opt,name=max_delivery_attempts,json=maxDeliveryAttempts,proto3" json:"max_delivery_attempts,omitempty"`
+	// Current state of the consumer.
+	State *ConsumerInfo_State `protobuf:"bytes,9,opt,name=state,proto3" json:"state,omitempty"`
+	// Subject that events failing processing are copied to.
+	DeadLetterSubject *string `protobuf:"bytes,10,opt,name=dead_letter_subject,json=deadLetterSubject,proto3,oneof" json:"dead_letter_subject,omitempty"`
+	// The delays used before redelivering events.
+	RedeliveryBackoff []*durationpb.Duration `protobuf:"bytes,11,rep,name=redelivery_backoff,json=redeliveryBackoff,proto3" json:"redelivery_backoff,omitempty"`
+}
+
+func (x *ConsumerInfo) Reset() {
+	*x = ConsumerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[13]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) String() string {
+func (x *ConsumerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureStreamRequest_RetentionPolicy) ProtoMessage() {}
+func (*ConsumerInfo) ProtoMessage() {}
 
-func (x *EnsureStreamRequest_RetentionPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[13]
+func (x *ConsumerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1379,104 +1577,118 @@ func (x *EnsureStreamRequest_RetentionPolicy) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureStreamRequest_RetentionPolicy.ProtoReflect.Descriptor instead.
-func (*EnsureStreamRequest_RetentionPolicy) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ConsumerInfo.ProtoReflect.Descriptor instead.
+func (*ConsumerInfo) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetMaxAge() *durationpb.Duration {
+func (x *ConsumerInfo) GetStream() string {
 	if x != nil {
-		return x.MaxAge
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ConsumerInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConsumerInfo) GetDurable() bool {
+	if x != nil {
+		return x.Durable
+	}
+	return false
+}
+
+func (x *ConsumerInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
 	}
 	return nil
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetMaxEvents() uint64 {
-	if x != nil && x.MaxEvents != nil {
-		return *x.MaxEvents
+func (x *ConsumerInfo) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
 	}
-	return 0
+	return nil
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetMaxEventsPerSubject() uint64 {
-	if x != nil && x.MaxEventsPerSubject != nil {
-		return *x.MaxEventsPerSubject
+func (x *ConsumerInfo) GetFrom() *StreamPointer {
+	if x != nil {
+		return x.From
 	}
-	return 0
+	return nil
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetMaxBytes() uint64 {
-	if x != nil && x.MaxBytes != nil {
-		return *x.MaxBytes
+func (x *ConsumerInfo) GetProcessingTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProcessingTimeout
+	}
+	return nil
+}
+
+func (x *ConsumerInfo) GetMaxDeliveryAttempts() uint32 {
+	if x != nil {
+		return x.MaxDeliveryAttempts
 	}
 	return 0
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetDiscardPolicy() EnsureStreamRequest_DiscardPolicy {
-	if x != nil && x.DiscardPolicy != nil {
-		return *x.DiscardPolicy
+func (x *ConsumerInfo) GetState() *ConsumerInfo_State {
+	if x != nil {
+		return x.State
 	}
-	return EnsureStreamRequest_DISCARD_POLICY_UNSPECIFIED
+	return nil
 }
 
-func (x *EnsureStreamRequest_RetentionPolicy) GetDiscardNewPerSubject() bool {
-	if x != nil && x.DiscardNewPerSubject != nil {
-		return *x.DiscardNewPerSubject
+func (x *ConsumerInfo) GetDeadLetterSubject() string {
+	if x != nil && x.DeadLetterSubject != nil {
+		return *x.DeadLetterSubject
 	}
-	return false
+	return ""
 }
 
-// List of subjects that will be collected into the stream.
-type EnsureStreamRequest_Subjects struct {
+func (x *ConsumerInfo) GetRedeliveryBackoff() []*durationpb.Duration {
+	if x != nil {
+		return x.RedeliveryBackoff
+	}
+	return nil
+}
+
+// Request to delete a consumer.
+type DeleteConsumerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of subjects. Subjects are case-sensitive and should only
-	// contain the following characters:
-	//
-	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
-	//
-	//   - `_` and `-` are allowed for separating words, but the use of
-	//     camelCase is recommended.
-	//
-	//   - `.` is allowed and used as a hierarchy separator, such as
-	//     `time.us.east` and `time.eu.sweden`, which share the `time`
-	//     prefix.
-	//
-	//   - `*` matches a single token, at any level of the subject. Such as
-	//     `time.*.east` will match `time.us.east` and `time.eu.east` but
-	//     not `time.us.west` or `time.us.central.east`. Similarly `time.us.*`
-	//     will match `time.us.east` but not `time.us.east.atlanta`.
-	//
-	//     The `*` wildcard can be used multiple times in a subject, such as
-	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
-	//     `time.us.east.atlanta`.
-	//   - `>` matches one or more tokens at the tail of a subject, and can
-	//     only be used as the last token. Such as `time.us.>` will match
-	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
-	//
-	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
-	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// The event stream to delete the consumer from. The stream must already
+	// exist.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer to delete. The consumer must already exist.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *EnsureStreamRequest_Subjects) Reset() {
-	*x = EnsureStreamRequest_Subjects{}
+func (x *DeleteConsumerRequest) Reset() {
+	*x = DeleteConsumerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[14]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsureStreamRequest_Subjects) String() string {
+func (x *DeleteConsumerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureStreamRequest_Subjects) ProtoMessage() {}
+func (*DeleteConsumerRequest) ProtoMessage() {}
 
-func (x *EnsureStreamRequest_Subjects) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[14]
+func (x *DeleteConsumerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,76 +1699,49 @@ func (x *EnsureStreamRequest_Subjects) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureStreamRequest_Subjects.ProtoReflect.Descriptor instead.
-func (*EnsureStreamRequest_Subjects) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use DeleteConsumerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConsumerRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EnsureStreamRequest_Subjects) GetSubjects() []string {
+func (x *DeleteConsumerRequest) GetStream() string {
 	if x != nil {
-		return x.Subjects
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-// Source describing mirroring/aggregation of events from another stream
-// into this stream.
-type EnsureStreamRequest_StreamSource struct {
+func (x *DeleteConsumerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response to deleting a consumer.
+type DeleteConsumerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Stream to receive events from. The stream must already exist.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optionally define where to start receiving events from.
-	//
-	// Defaults to the start of stream if not provided.
-	From *StreamPointer `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
-	// Optionally filter events by subject. Subjects are case-sensitive and
-	// should only contain the following characters:
-	//
-	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
-	//
-	//   - `_` and `-` are allowed for separating words, but the use of
-	//     camelCase is recommended.
-	//
-	//   - `.` is allowed and used as a hierarchy separator, such as
-	//     `time.us.east` and `time.eu.sweden`, which share the `time`
-	//     prefix.
-	//
-	//   - `*` matches a single token, at any level of the subject. Such as
-	//     `time.*.east` will match `time.us.east` and `time.eu.east` but
-	//     not `time.us.west` or `time.us.central.east`. Similarly `time.us.*`
-	//     will match `time.us.east` but not `time.us.east.atlanta`.
-	//
-	//     The `*` wildcard can be used multiple times in a subject, such as
-	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
-	//     `time.us.east.atlanta`.
-	//   - `>` matches one or more tokens at the tail of a subject, and can
-	//     only be used as the last token. Such as `time.us.>` will match
-	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
-	//
-	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
-	FilterSubjects []string `protobuf:"bytes,3,rep,name=filter_subjects,json=filterSubjects,proto3" json:"filter_subjects,omitempty"`
 }
 
-func (x *EnsureStreamRequest_StreamSource) Reset() {
-	*x = EnsureStreamRequest_StreamSource{}
+func (x *DeleteConsumerResponse) Reset() {
+	*x = DeleteConsumerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[15]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsureStreamRequest_StreamSource) String() string {
+func (x *DeleteConsumerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureStreamRequest_StreamSource) ProtoMessage() {}
+func (*DeleteConsumerResponse) ProtoMessage() {}
 
-func (x *EnsureStreamRequest_StreamSource) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[15]
+func (x *DeleteConsumerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,59 +1752,49 @@ func (x *EnsureStreamRequest_StreamSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureStreamRequest_StreamSource.ProtoReflect.Descriptor instead.
-func (*EnsureStreamRequest_StreamSource) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 2}
-}
-
-func (x *EnsureStreamRequest_StreamSource) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EnsureStreamRequest_StreamSource) GetFrom() *StreamPointer {
-	if x != nil {
-		return x.From
-	}
-	return nil
-}
-
-func (x *EnsureStreamRequest_StreamSource) GetFilterSubjects() []string {
-	if x != nil {
-		return x.FilterSubjects
-	}
-	return nil
+// Deprecated: Use DeleteConsumerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConsumerResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{17}
 }
 
-// Multiple streams that can be mirrored into the stream.
-type EnsureStreamRequest_StreamSources struct {
+// Request to list the dead-lettered events of a consumer.
+type ListDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The streams to receive events from.
-	Sources []*EnsureStreamRequest_StreamSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// The event stream the consumer belongs to.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer, must have a dead-letter subject.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Maximum number of dead letters to return.
+	//
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *EnsureStreamRequest_StreamSources) Reset() {
-	*x = EnsureStreamRequest_StreamSources{}
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[16]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsureStreamRequest_StreamSources) String() string {
+func (x *ListDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureStreamRequest_StreamSources) ProtoMessage() {}
+func (*ListDeadLettersRequest) ProtoMessage() {}
 
-func (x *EnsureStreamRequest_StreamSources) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[16]
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1630,51 +1805,69 @@ func (x *EnsureStreamRequest_StreamSources) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureStreamRequest_StreamSources.ProtoReflect.Descriptor instead.
-func (*EnsureStreamRequest_StreamSources) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EnsureStreamRequest_StreamSources) GetSources() []*EnsureStreamRequest_StreamSource {
+func (x *ListDeadLettersRequest) GetStream() string {
 	if x != nil {
-		return x.Sources
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-// Define how the stream should be stored.
-type EnsureStreamRequest_Storage struct {
+func (x *ListDeadLettersRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+// Response to listing dead-lettered events.
+type ListDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The type of storage to use for the stream.
-	//
-	// Defaults to file based storage if not provided.
-	Type *EnsureStreamRequest_StorageType `protobuf:"varint,1,opt,name=type,proto3,enum=windshift.events.v1alpha1.EnsureStreamRequest_StorageType,oneof" json:"type,omitempty"`
-	// Number of replicas to use for the stream.
-	//
-	// Defaults to 1 if not provided.
-	Replicas *uint32 `protobuf:"varint,2,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// The dead letters in this page, oldest first.
+	DeadLetters []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
 }
 
-func (x *EnsureStreamRequest_Storage) Reset() {
-	*x = EnsureStreamRequest_Storage{}
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[17]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnsureStreamRequest_Storage) String() string {
+func (x *ListDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnsureStreamRequest_Storage) ProtoMessage() {}
+func (*ListDeadLettersResponse) ProtoMessage() {}
 
-func (x *EnsureStreamRequest_Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[17]
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1685,65 +1878,56 @@ func (x *EnsureStreamRequest_Storage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnsureStreamRequest_Storage.ProtoReflect.Descriptor instead.
-func (*EnsureStreamRequest_Storage) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 4}
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EnsureStreamRequest_Storage) GetType() EnsureStreamRequest_StorageType {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
 	}
-	return EnsureStreamRequest_STORAGE_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *EnsureStreamRequest_Storage) GetReplicas() uint32 {
-	if x != nil && x.Replicas != nil {
-		return *x.Replicas
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-// Subscribe to events, must be sent as the first message in the stream.
-type EventsRequest_Subscribe struct {
+// Request to requeue dead-lettered events.
+type RequeueDeadLettersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the stream to subscribe to. The stream must already
-	// exist.
+	// The event stream the consumer belongs to.
 	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
-	// The name of the consumer, should be an name previously created using
-	// EnsureConsumer.
+	// The id of the consumer the events were dead-lettered for.
 	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
-	// The maximum number of events to process at once.
-	//
-	// In most cases this should be set to approximately the number of
-	// events that can be processed in parallel plus a small buffer. The
-	// server will ramp up events to this limit, and will only send more
-	// events once the client has acknowledged or rejected events.
-	//
-	// Defaults to 50 if not provided.
-	MaxProcessingEvents *uint64 `protobuf:"varint,4,opt,name=max_processing_events,json=maxProcessingEvents,proto3,oneof" json:"max_processing_events,omitempty"`
+	// The identifiers of the dead letters to requeue.
+	Ids []uint64 `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *EventsRequest_Subscribe) Reset() {
-	*x = EventsRequest_Subscribe{}
+func (x *RequeueDeadLettersRequest) Reset() {
+	*x = RequeueDeadLettersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[18]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsRequest_Subscribe) String() string {
+func (x *RequeueDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsRequest_Subscribe) ProtoMessage() {}
+func (*RequeueDeadLettersRequest) ProtoMessage() {}
 
-func (x *EventsRequest_Subscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[18]
+func (x *RequeueDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,59 +1938,65 @@ func (x *EventsRequest_Subscribe) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsRequest_Subscribe.ProtoReflect.Descriptor instead.
-func (*EventsRequest_Subscribe) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use RequeueDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EventsRequest_Subscribe) GetStream() string {
+func (x *RequeueDeadLettersRequest) GetStream() string {
 	if x != nil {
 		return x.Stream
 	}
 	return ""
 }
 
-func (x *EventsRequest_Subscribe) GetConsumer() string {
+func (x *RequeueDeadLettersRequest) GetConsumer() string {
 	if x != nil {
 		return x.Consumer
 	}
 	return ""
 }
 
-func (x *EventsRequest_Subscribe) GetMaxProcessingEvents() uint64 {
-	if x != nil && x.MaxProcessingEvents != nil {
-		return *x.MaxProcessingEvents
+func (x *RequeueDeadLettersRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-// Ack indicates that some events have been successfully processed.
-type EventsRequest_Ack struct {
+// Response to requeuing dead-lettered events.
+type RequeueDeadLettersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifiers to acknowledge.
+	// The identifiers that were requeued.
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Identifiers that were invalid, such as already being requeued, should
+	// not be retried by the client.
+	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
+	// Identifiers that could not be processed temporarily, should be
+	// retried by the client.
+	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
 }
 
-func (x *EventsRequest_Ack) Reset() {
-	*x = EventsRequest_Ack{}
+func (x *RequeueDeadLettersResponse) Reset() {
+	*x = RequeueDeadLettersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[19]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsRequest_Ack) String() string {
+func (x *RequeueDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsRequest_Ack) ProtoMessage() {}
+func (*RequeueDeadLettersResponse) ProtoMessage() {}
 
-func (x *EventsRequest_Ack) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[19]
+func (x *RequeueDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1817,56 +2007,80 @@ func (x *EventsRequest_Ack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsRequest_Ack.ProtoReflect.Descriptor instead.
-func (*EventsRequest_Ack) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use RequeueDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EventsRequest_Ack) GetIds() []uint64 {
+func (x *RequeueDeadLettersResponse) GetIds() []uint64 {
 	if x != nil {
 		return x.Ids
 	}
 	return nil
 }
 
-// Reject indicates that some events have failed to be processed.
-type EventsRequest_Reject struct {
+func (x *RequeueDeadLettersResponse) GetInvalidIds() []uint64 {
+	if x != nil {
+		return x.InvalidIds
+	}
+	return nil
+}
+
+func (x *RequeueDeadLettersResponse) GetTemporaryFailedIds() []uint64 {
+	if x != nil {
+		return x.TemporaryFailedIds
+	}
+	return nil
+}
+
+// An event that could not be processed by a consumer and that was copied to
+// its dead-letter subject.
+type DeadLetter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifiers to reject.
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	// Permanently reject the events, if not provided the events will be
-	// retried after a timeout. If permanently is set to true, the events
-	// will not be redelivered.
-	//
-	// Can not be combined with delay.
-	Permanently *bool `protobuf:"varint,2,opt,name=permanently,proto3,oneof" json:"permanently,omitempty"`
-	// Optional time to wait before redelivering the events. If not
-	// provided the server will decide how long to wait.
-	//
-	// Can not be combined with permanently.
-	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
-}
-
-func (x *EventsRequest_Reject) Reset() {
-	*x = EventsRequest_Reject{}
+	// Identifier of the dead letter, used to requeue it.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The subject the event was originally published to.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The stream the event was originally stored in.
+	Stream string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The consumer that failed to process the event.
+	Consumer string `protobuf:"bytes,4,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// The identifier of the event in the original stream.
+	EventId uint64 `protobuf:"varint,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The number of times the event was delivered.
+	DeliveryAttempts uint64 `protobuf:"varint,6,opt,name=delivery_attempts,json=deliveryAttempts,proto3" json:"delivery_attempts,omitempty"`
+	// Why the event was dead-lettered.
+	Reason DeadLetter_Reason `protobuf:"varint,7,opt,name=reason,proto3,enum=windshift.events.v1alpha1.DeadLetter_Reason" json:"reason,omitempty"`
+	// Timestamp of when the event was dead-lettered.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Headers of the original event.
+	Headers *Headers `protobuf:"bytes,9,opt,name=headers,proto3" json:"headers,omitempty"`
+	// Data of the original event.
+	Data *anypb.Any `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	// The last failure recorded for the event before it was dead-lettered.
+	Failure *Failure `protobuf:"bytes,11,opt,name=failure,proto3,oneof" json:"failure,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[20]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsRequest_Reject) String() string {
+func (x *DeadLetter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsRequest_Reject) ProtoMessage() {}
+func (*DeadLetter) ProtoMessage() {}
 
-func (x *EventsRequest_Reject) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[20]
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,113 +2091,130 @@ func (x *EventsRequest_Reject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsRequest_Reject.ProtoReflect.Descriptor instead.
-func (*EventsRequest_Reject) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EventsRequest_Reject) GetIds() []uint64 {
+func (x *DeadLetter) GetId() uint64 {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *EventsRequest_Reject) GetPermanently() bool {
-	if x != nil && x.Permanently != nil {
-		return *x.Permanently
+func (x *DeadLetter) GetSubject() string {
+	if x != nil {
+		return x.Subject
 	}
-	return false
+	return ""
 }
 
-func (x *EventsRequest_Reject) GetDelay() *durationpb.Duration {
+func (x *DeadLetter) GetStream() string {
 	if x != nil {
-		return x.Delay
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-// Ping events to indicate that they are still being processed. Pings should
-// be sent regularly to indicate that the events are still being processed.
-// It is recommended to send pings at half the interval of the processing
-// timeout.
-type EventsRequest_Ping struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *DeadLetter) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
 
-	// The identifiers of the events that are currently being processed.
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+func (x *DeadLetter) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
 }
 
-func (x *EventsRequest_Ping) Reset() {
-	*x = EventsRequest_Ping{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DeadLetter) GetDeliveryAttempts() uint64 {
+	if x != nil {
+		return x.DeliveryAttempts
 	}
+	return 0
 }
 
-func (x *EventsRequest_Ping) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DeadLetter) GetReason() DeadLetter_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return DeadLetter_REASON_UNSPECIFIED
 }
 
-func (*EventsRequest_Ping) ProtoMessage() {}
+func (x *DeadLetter) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
 
-func (x *EventsRequest_Ping) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DeadLetter) GetHeaders() *Headers {
+	if x != nil {
+		return x.Headers
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use EventsRequest_Ping.ProtoReflect.Descriptor instead.
-func (*EventsRequest_Ping) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8, 3}
+func (x *DeadLetter) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (x *EventsRequest_Ping) GetIds() []uint64 {
+func (x *DeadLetter) GetFailure() *Failure {
 	if x != nil {
-		return x.Ids
+		return x.Failure
 	}
 	return nil
 }
 
-// Confirmation that the client has subscribed to events.
-type EventsResponse_Subscribed struct {
+// Request to list the failures recorded for a consumer.
+type ListFailuresRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The timeout for events, after which they will be resent if not
-	// acknowledged or rejected. Can be used by the client to implements
-	// features such as pings.
-	ProcessingTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=processing_timeout,json=processingTimeout,proto3" json:"processing_timeout,omitempty"`
+	// The event stream the consumer belongs to.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Only list failures of this event.
+	//
+	// Defaults to failures of all events if not provided.
+	EventId *uint64 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3,oneof" json:"event_id,omitempty"`
+	// Maximum number of failures to return.
+	//
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *EventsResponse_Subscribed) Reset() {
-	*x = EventsResponse_Subscribed{}
+func (x *ListFailuresRequest) Reset() {
+	*x = ListFailuresRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[22]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsResponse_Subscribed) String() string {
+func (x *ListFailuresRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse_Subscribed) ProtoMessage() {}
+func (*ListFailuresRequest) ProtoMessage() {}
 
-func (x *EventsResponse_Subscribed) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[22]
+func (x *ListFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1994,51 +2225,76 @@ func (x *EventsResponse_Subscribed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse_Subscribed.ProtoReflect.Descriptor instead.
-func (*EventsResponse_Subscribed) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ListFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ListFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *EventsResponse_Subscribed) GetProcessingTimeout() *durationpb.Duration {
+func (x *ListFailuresRequest) GetStream() string {
 	if x != nil {
-		return x.ProcessingTimeout
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-// Confirmation that some events were acknowledged.
-type EventsResponse_AckConfirmation struct {
+func (x *ListFailuresRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *ListFailuresRequest) GetEventId() uint64 {
+	if x != nil && x.EventId != nil {
+		return *x.EventId
+	}
+	return 0
+}
+
+func (x *ListFailuresRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFailuresRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+// Response to listing failures.
+type ListFailuresResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifiers that were acknowledged.
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	// Identifiers that were invalid, such as already being acknowledged or
-	// rejected, should not be retried by the client.
-	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
-	// Identifiers that could not be processed temporarily, should be
-	// retried by the client.
-	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+	// The failures in this page, oldest first.
+	Failures []*FailureRecord `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
 }
 
-func (x *EventsResponse_AckConfirmation) Reset() {
-	*x = EventsResponse_AckConfirmation{}
+func (x *ListFailuresResponse) Reset() {
+	*x = ListFailuresResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[23]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsResponse_AckConfirmation) String() string {
+func (x *ListFailuresResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse_AckConfirmation) ProtoMessage() {}
+func (*ListFailuresResponse) ProtoMessage() {}
 
-func (x *EventsResponse_AckConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[23]
+func (x *ListFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,65 +2305,57 @@ func (x *EventsResponse_AckConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse_AckConfirmation.ProtoReflect.Descriptor instead.
-func (*EventsResponse_AckConfirmation) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 1}
-}
-
-func (x *EventsResponse_AckConfirmation) GetIds() []uint64 {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
+// Deprecated: Use ListFailuresResponse.ProtoReflect.Descriptor instead.
+func (*ListFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *EventsResponse_AckConfirmation) GetInvalidIds() []uint64 {
+func (x *ListFailuresResponse) GetFailures() []*FailureRecord {
 	if x != nil {
-		return x.InvalidIds
+		return x.Failures
 	}
 	return nil
 }
 
-func (x *EventsResponse_AckConfirmation) GetTemporaryFailedIds() []uint64 {
-	if x != nil {
-		return x.TemporaryFailedIds
+func (x *ListFailuresResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-// Confirmation that some events were rejected.
-type EventsResponse_RejectConfirmation struct {
+// Why an event could not be processed, as provided by the consumer when
+// rejecting it.
+type Failure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifiers that were rejected.
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	// Identifiers that were invalid, such as already being acknowledged or
-	// rejected, should not be retried by the client.
-	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
-	// Identifiers that could not be processed temporarily, should be
-	// retried by the client.
-	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+	// Human readable reason for the failure.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Error code of the failure, for grouping similar failures.
+	Code *string `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	// Additional details about the failure.
+	Details map[string]string `protobuf:"bytes,3,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *EventsResponse_RejectConfirmation) Reset() {
-	*x = EventsResponse_RejectConfirmation{}
+func (x *Failure) Reset() {
+	*x = Failure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[24]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsResponse_RejectConfirmation) String() string {
+func (x *Failure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse_RejectConfirmation) ProtoMessage() {}
+func (*Failure) ProtoMessage() {}
 
-func (x *EventsResponse_RejectConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[24]
+func (x *Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2118,65 +2366,73 @@ func (x *EventsResponse_RejectConfirmation) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse_RejectConfirmation.ProtoReflect.Descriptor instead.
-func (*EventsResponse_RejectConfirmation) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 2}
+// Deprecated: Use Failure.ProtoReflect.Descriptor instead.
+func (*Failure) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *EventsResponse_RejectConfirmation) GetIds() []uint64 {
+func (x *Failure) GetReason() string {
 	if x != nil {
-		return x.Ids
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *EventsResponse_RejectConfirmation) GetInvalidIds() []uint64 {
-	if x != nil {
-		return x.InvalidIds
+func (x *Failure) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *EventsResponse_RejectConfirmation) GetTemporaryFailedIds() []uint64 {
+func (x *Failure) GetDetails() map[string]string {
 	if x != nil {
-		return x.TemporaryFailedIds
+		return x.Details
 	}
 	return nil
 }
 
-// Confirmation that a ping was received.
-type EventsResponse_PingConfirmation struct {
+// A failure recorded when a consumer rejected an event.
+type FailureRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifiers of the events that are currently being processed.
-	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	// Identifiers that were invalid, such as already being acknowledged or
-	// rejected, should not be retried by the client.
-	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
-	// Identifiers that could not be processed temporarily, should be
-	// retried by the client.
-	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+	// Identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The stream the event is stored in.
+	Stream string `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The consumer that rejected the event.
+	Consumer string `protobuf:"bytes,3,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// The identifier of the event.
+	EventId uint64 `protobuf:"varint,4,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The delivery attempt that failed, the first delivery is 1.
+	DeliveryAttempt uint64 `protobuf:"varint,5,opt,name=delivery_attempt,json=deliveryAttempt,proto3" json:"delivery_attempt,omitempty"`
+	// If the event was permanently rejected.
+	Permanent bool `protobuf:"varint,6,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// Timestamp of when the event was rejected.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Why the event could not be processed.
+	Failure *Failure `protobuf:"bytes,8,opt,name=failure,proto3" json:"failure,omitempty"`
 }
 
-func (x *EventsResponse_PingConfirmation) Reset() {
-	*x = EventsResponse_PingConfirmation{}
+func (x *FailureRecord) Reset() {
+	*x = FailureRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[25]
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventsResponse_PingConfirmation) String() string {
+func (x *FailureRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse_PingConfirmation) ProtoMessage() {}
+func (*FailureRecord) ProtoMessage() {}
 
-func (x *EventsResponse_PingConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[25]
+func (x *FailureRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,509 +2443,6325 @@ func (x *EventsResponse_PingConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse_PingConfirmation.ProtoReflect.Descriptor instead.
-func (*EventsResponse_PingConfirmation) Descriptor() ([]byte, []int) {
-	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 3}
+// Deprecated: Use FailureRecord.ProtoReflect.Descriptor instead.
+func (*FailureRecord) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *EventsResponse_PingConfirmation) GetIds() []uint64 {
+func (x *FailureRecord) GetId() uint64 {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *EventsResponse_PingConfirmation) GetInvalidIds() []uint64 {
+func (x *FailureRecord) GetStream() string {
 	if x != nil {
-		return x.InvalidIds
+		return x.Stream
 	}
-	return nil
+	return ""
 }
 
-func (x *EventsResponse_PingConfirmation) GetTemporaryFailedIds() []uint64 {
+func (x *FailureRecord) GetConsumer() string {
 	if x != nil {
-		return x.TemporaryFailedIds
+		return x.Consumer
 	}
-	return nil
+	return ""
 }
 
-var File_windshift_events_v1alpha1_service_proto protoreflect.FileDescriptor
-
-var file_windshift_events_v1alpha1_service_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x77, 0x69, 0x6e, 0x64, 0x73,
-	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xb7, 0x0e, 0x0a, 0x13, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6e, 0x0a, 0x10,
-	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
-	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x08,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
-	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72,
-	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x67,
-	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
-	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61,
-	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x48, 0x02, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x51, 0x0a, 0x14, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x13, 0x64, 0x65, 0x64, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88,
-	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x1a, 0xe3, 0x03,
-	0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61,
-	0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01,
-	0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02,
-	0x52, 0x13, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x08, 0x6d,
-	0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x69,
-	0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
-	0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x48, 0x04, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f,
-	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x14, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
-	0x4e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
-	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x64, 0x69, 0x73, 0x63,
-	0x61, 0x72, 0x64, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x1a, 0x26, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x0c,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x41, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x66, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x95, 0x01,
-	0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
-	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x01, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x5f, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52,
-	0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52,
-	0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x16,
-	0x0a, 0x12, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
-	0x5f, 0x4e, 0x45, 0x57, 0x10, 0x02, 0x22, 0x5b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
-	0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52,
-	0x59, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x13, 0x0a,
-	0x11, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x17,
-	0x0a, 0x15, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x6e,
-	0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x15, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x48, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
-	0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x15, 0x0a,
-	0x13, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f,
-	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x13, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88,
-	0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0e,
-	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x9c, 0x05, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x52, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
-	0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x40, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63,
-	0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
-	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x92, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x15, 0x6d, 0x61,
-	0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x61, 0x78,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x17, 0x0a,
-	0x03, 0x41, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x1a, 0x91, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74,
-	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d,
-	0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x18, 0x0a, 0x04, 0x50, 0x69,
-	0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xb6, 0x07, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0a,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x34, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x10, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
-	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x63, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6f, 0x0a, 0x13,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x72, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a,
-	0x11, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
-	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x56, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x1a, 0x76, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
-	0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x46,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x73, 0x1a, 0x79, 0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x64,
-	0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66,
-	0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x12, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x49, 0x64, 0x73, 0x1a, 0x77, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a,
-	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
-	0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xc4, 0x01,
-	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x64,
-	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
-	0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x24, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70,
-	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x32, 0xc6, 0x04, 0x0a, 0x0d,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6f, 0x0a,
-	0x0c, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x2e,
-	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75,
-	0x0a, 0x0e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
-	0x12, 0x30, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73,
-	0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
-	0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73,
-	0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0c,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x77,
-	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77,
-	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x63, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x28, 0x01, 0x30, 0x01, 0x42, 0x94, 0x02, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x69, 0x6e,
-	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x66, 0x6f, 0x75, 0x72, 0x61, 0x62, 0x2f, 0x77,
-	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77,
-	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x45, 0x58, 0xaa, 0x02, 0x19,
-	0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x19, 0x57, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x25, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
-	0x74, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b,
-	0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *FailureRecord) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
 }
 
-var (
-	file_windshift_events_v1alpha1_service_proto_rawDescOnce sync.Once
-	file_windshift_events_v1alpha1_service_proto_rawDescData = file_windshift_events_v1alpha1_service_proto_rawDesc
-)
-
-func file_windshift_events_v1alpha1_service_proto_rawDescGZIP() []byte {
-	file_windshift_events_v1alpha1_service_proto_rawDescOnce.Do(func() {
-		file_windshift_events_v1alpha1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_windshift_events_v1alpha1_service_proto_rawDescData)
-	})
-	return file_windshift_events_v1alpha1_service_proto_rawDescData
+func (x *FailureRecord) GetDeliveryAttempt() uint64 {
+	if x != nil {
+		return x.DeliveryAttempt
+	}
+	return 0
 }
 
-var file_windshift_events_v1alpha1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_windshift_events_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
-var file_windshift_events_v1alpha1_service_proto_goTypes = []interface{}{
-	(EnsureStreamRequest_DiscardPolicy)(0),      // 0: windshift.events.v1alpha1.EnsureStreamRequest.DiscardPolicy
-	(EnsureStreamRequest_StorageType)(0),        // 1: windshift.events.v1alpha1.EnsureStreamRequest.StorageType
-	(*EnsureStreamRequest)(nil),                 // 2: windshift.events.v1alpha1.EnsureStreamRequest
-	(*EnsureStreamResponse)(nil),                // 3: windshift.events.v1alpha1.EnsureStreamResponse
-	(*EnsureConsumerRequest)(nil),               // 4: windshift.events.v1alpha1.EnsureConsumerRequest
-	(*EnsureConsumerResponse)(nil),              // 5: windshift.events.v1alpha1.EnsureConsumerResponse
-	(*DeleteConsumerRequest)(nil),               // 6: windshift.events.v1alpha1.DeleteConsumerRequest
-	(*DeleteConsumerResponse)(nil),              // 7: windshift.events.v1alpha1.DeleteConsumerResponse
-	(*PublishEventRequest)(nil),                 // 8: windshift.events.v1alpha1.PublishEventRequest
-	(*PublishEventResponse)(nil),                // 9: windshift.events.v1alpha1.PublishEventResponse
-	(*EventsRequest)(nil),                       // 10: windshift.events.v1alpha1.EventsRequest
-	(*EventsResponse)(nil),                      // 11: windshift.events.v1alpha1.EventsResponse
-	(*StreamPointer)(nil),                       // 12: windshift.events.v1alpha1.StreamPointer
-	(*Event)(nil),                               // 13: windshift.events.v1alpha1.Event
-	(*Headers)(nil),                             // 14: windshift.events.v1alpha1.Headers
-	(*EnsureStreamRequest_RetentionPolicy)(nil), // 15: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy
-	(*EnsureStreamRequest_Subjects)(nil),        // 16: windshift.events.v1alpha1.EnsureStreamRequest.Subjects
-	(*EnsureStreamRequest_StreamSource)(nil),    // 17: windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
-	(*EnsureStreamRequest_StreamSources)(nil),   // 18: windshift.events.v1alpha1.EnsureStreamRequest.StreamSources
-	(*EnsureStreamRequest_Storage)(nil),         // 19: windshift.events.v1alpha1.EnsureStreamRequest.Storage
-	(*EventsRequest_Subscribe)(nil),             // 20: windshift.events.v1alpha1.EventsRequest.Subscribe
-	(*EventsRequest_Ack)(nil),                   // 21: windshift.events.v1alpha1.EventsRequest.Ack
-	(*EventsRequest_Reject)(nil),                // 22: windshift.events.v1alpha1.EventsRequest.Reject
-	(*EventsRequest_Ping)(nil),                  // 23: windshift.events.v1alpha1.EventsRequest.Ping
-	(*EventsResponse_Subscribed)(nil),           // 24: windshift.events.v1alpha1.EventsResponse.Subscribed
-	(*EventsResponse_AckConfirmation)(nil),      // 25: windshift.events.v1alpha1.EventsResponse.AckConfirmation
-	(*EventsResponse_RejectConfirmation)(nil),   // 26: windshift.events.v1alpha1.EventsResponse.RejectConfirmation
-	(*EventsResponse_PingConfirmation)(nil),     // 27: windshift.events.v1alpha1.EventsResponse.PingConfirmation
-	(*durationpb.Duration)(nil),                 // 28: google.protobuf.Duration
-	(*anypb.Any)(nil),                           // 29: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil),               // 30: google.protobuf.Timestamp
+func (x *FailureRecord) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
 }
-var file_windshift_events_v1alpha1_service_proto_depIdxs = []int32{
-	15, // 0: windshift.events.v1alpha1.EnsureStreamRequest.retention_policy:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy
-	16, // 1: windshift.events.v1alpha1.EnsureStreamRequest.subjects:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Subjects
-	17, // 2: windshift.events.v1alpha1.EnsureStreamRequest.mirror:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
-	18, // 3: windshift.events.v1alpha1.EnsureStreamRequest.aggregate:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSources
-	19, // 4: windshift.events.v1alpha1.EnsureStreamRequest.storage:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Storage
-	28, // 5: windshift.events.v1alpha1.EnsureStreamRequest.deduplication_window:type_name -> google.protobuf.Duration
-	12, // 6: windshift.events.v1alpha1.EnsureConsumerRequest.from:type_name -> windshift.events.v1alpha1.StreamPointer
-	28, // 7: windshift.events.v1alpha1.EnsureConsumerRequest.processing_timeout:type_name -> google.protobuf.Duration
-	29, // 8: windshift.events.v1alpha1.PublishEventRequest.data:type_name -> google.protobuf.Any
-	30, // 9: windshift.events.v1alpha1.PublishEventRequest.timestamp:type_name -> google.protobuf.Timestamp
-	20, // 10: windshift.events.v1alpha1.EventsRequest.subscribe:type_name -> windshift.events.v1alpha1.EventsRequest.Subscribe
-	21, // 11: windshift.events.v1alpha1.EventsRequest.ack:type_name -> windshift.events.v1alpha1.EventsRequest.Ack
-	22, // 12: windshift.events.v1alpha1.EventsRequest.reject:type_name -> windshift.events.v1alpha1.EventsRequest.Reject
-	23, // 13: windshift.events.v1alpha1.EventsRequest.ping:type_name -> windshift.events.v1alpha1.EventsRequest.Ping
-	13, // 14: windshift.events.v1alpha1.EventsResponse.event:type_name -> windshift.events.v1alpha1.Event
-	24, // 15: windshift.events.v1alpha1.EventsResponse.subscribed:type_name -> windshift.events.v1alpha1.EventsResponse.Subscribed
-	25, // 16: windshift.events.v1alpha1.EventsResponse.ack_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.AckConfirmation
-	26, // 17: windshift.events.v1alpha1.EventsResponse.reject_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.RejectConfirmation
-	27, // 18: windshift.events.v1alpha1.EventsResponse.ping_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.PingConfirmation
-	30, // 19: windshift.events.v1alpha1.StreamPointer.time:type_name -> google.protobuf.Timestamp
-	14, // 20: windshift.events.v1alpha1.Event.headers:type_name -> windshift.events.v1alpha1.Headers
-	29, // 21: windshift.events.v1alpha1.Event.data:type_name -> google.protobuf.Any
-	30, // 22: windshift.events.v1alpha1.Headers.timestamp:type_name -> google.protobuf.Timestamp
-	28, // 23: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy.max_age:type_name -> google.protobuf.Duration
-	0,  // 24: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy.discard_policy:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.DiscardPolicy
-	12, // 25: windshift.events.v1alpha1.EnsureStreamRequest.StreamSource.from:type_name -> windshift.events.v1alpha1.StreamPointer
-	17, // 26: windshift.events.v1alpha1.EnsureStreamRequest.StreamSources.sources:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
-	1,  // 27: windshift.events.v1alpha1.EnsureStreamRequest.Storage.type:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StorageType
-	28, // 28: windshift.events.v1alpha1.EventsRequest.Reject.delay:type_name -> google.protobuf.Duration
-	28, // 29: windshift.events.v1alpha1.EventsResponse.Subscribed.processing_timeout:type_name -> google.protobuf.Duration
-	2,  // 30: windshift.events.v1alpha1.EventsService.EnsureStream:input_type -> windshift.events.v1alpha1.EnsureStreamRequest
-	4,  // 31: windshift.events.v1alpha1.EventsService.EnsureConsumer:input_type -> windshift.events.v1alpha1.EnsureConsumerRequest
-	6,  // 32: windshift.events.v1alpha1.EventsService.DeleteConsumer:input_type -> windshift.events.v1alpha1.DeleteConsumerRequest
-	8,  // 33: windshift.events.v1alpha1.EventsService.PublishEvent:input_type -> windshift.events.v1alpha1.PublishEventRequest
-	10, // 34: windshift.events.v1alpha1.EventsService.Events:input_type -> windshift.events.v1alpha1.EventsRequest
-	3,  // 35: windshift.events.v1alpha1.EventsService.EnsureStream:output_type -> windshift.events.v1alpha1.EnsureStreamResponse
-	5,  // 36: windshift.events.v1alpha1.EventsService.EnsureConsumer:output_type -> windshift.events.v1alpha1.EnsureConsumerResponse
-	7,  // 37: windshift.events.v1alpha1.EventsService.DeleteConsumer:output_type -> windshift.events.v1alpha1.DeleteConsumerResponse
-	9,  // 38: windshift.events.v1alpha1.EventsService.PublishEvent:output_type -> windshift.events.v1alpha1.PublishEventResponse
-	11, // 39: windshift.events.v1alpha1.EventsService.Events:output_type -> windshift.events.v1alpha1.EventsResponse
-	35, // [35:40] is the sub-list for method output_type
-	30, // [30:35] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+
+func (x *FailureRecord) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
 }
 
-func init() { file_windshift_events_v1alpha1_service_proto_init() }
-func file_windshift_events_v1alpha1_service_proto_init() {
-	if File_windshift_events_v1alpha1_service_proto != nil {
-		return
+func (x *FailureRecord) GetFailure() *Failure {
+	if x != nil {
+		return x.Failure
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_windshift_events_v1alpha1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnsureStreamRequest); i {
+	return nil
+}
+
+// Request to publish an event.
+type PublishEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The subject of the event. Subjects are case-sensitive and should only
+	// contain the following characters:
+	//
+	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
+	//   - `_` and `-` are allowed for separating words, but the use of camelCase
+	//     is recommended.
+	//   - `.` is allowed and used as a hierarchy separator, such as
+	//     `time.us.east` and `time.eu.sweden`, which share the `time` prefix.
+	//
+	// If the subject is not bound to a stream the event will be rejected.
+	//
+	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The event data. The data is dynamic and it is up to the publisher
+	// and subscriber to agree on the Protobuf messages to support.
+	//
+	// Each subject can support several different types of data, which can be
+	// useful to support different versions of the data. Subscribers should
+	// ignore data they do not understand.
+	//
+	// Either data or json_data must be set.
+	Data *anypb.Any `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// The event data encoded as JSON, for clients that can not easily build
+	// Protobuf messages. Uses the JSON format of `google.protobuf.Any`, with
+	// the full type URL in the `@type` field, such as
+	// `{"@type": "type.googleapis.com/orders.v1.OrderCreated", "id": "123"}`.
+	//
+	// The type must be registered with the SchemaService, or be one of the
+	// well-known types. The event is stored as Protobuf, so consumers can
+	// receive it in either encoding.
+	JsonData *string `protobuf:"bytes,7,opt,name=json_data,json=jsonData,proto3,oneof" json:"json_data,omitempty"`
+	// Timestamp of the event.
+	//
+	// Defaults to the current time of the server if not provided.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3,oneof" json:"timestamp,omitempty"`
+	// Key used to prevent duplicate events from being stored if a retry is
+	// needed. Should be set to a unique value for each publish request of a
+	// specific event.
+	//
+	// No default, events will not be deduplicated if not provided.
+	IdempotencyKey *string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	// The id of the event that is expected to be in the last published event in
+	// the stream.
+	//
+	// Used for optimistic concurrency control, if the specified event id does
+	// not match the current last event id, the publish will fail.
+	//
+	// No default, publish will not check the last event if not provided.
+	ExpectedLastId *uint64 `protobuf:"varint,5,opt,name=expected_last_id,json=expectedLastId,proto3,oneof" json:"expected_last_id,omitempty"`
+	// Custom headers to store with the event, such as a tenant, a causation
+	// id or the service that produced the event. Headers are returned to
+	// consumers, so they can be used for routing without decoding the data.
+	//
+	// Header names may only contain `a` to `z`, `A` to `Z`, `0` to `9`, `_`
+	// and `-`, and are case-sensitive. Values may not contain line breaks and
+	// are limited to 4096 characters. At most 64 headers can be set.
+	Headers map[string]string `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Publish the event at this time instead of directly. The event is kept
+	// by the server until it is due, and can be listed and canceled until
+	// then. The idempotency key is kept, both when scheduling and when the
+	// event is published.
+	//
+	// Can not be combined with `delay` or `expected_last_id`. No default,
+	// the event is published directly if neither is provided.
+	DeliverAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deliver_at,json=deliverAt,proto3,oneof" json:"deliver_at,omitempty"`
+	// Publish the event after this delay instead of directly, see
+	// `deliver_at`.
+	Delay *durationpb.Duration `protobuf:"bytes,9,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+}
+
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventRequest) ProtoMessage() {}
+
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PublishEventRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetJsonData() string {
+	if x != nil && x.JsonData != nil {
+		return *x.JsonData
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetExpectedLastId() uint64 {
+	if x != nil && x.ExpectedLastId != nil {
+		return *x.ExpectedLastId
+	}
+	return 0
+}
+
+func (x *PublishEventRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetDeliverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverAt
+	}
+	return nil
+}
+
+func (x *PublishEventRequest) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+// Response to publish an event.
+type PublishEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the published event. Not set if the event was scheduled.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The id of the scheduled event, used to cancel it. Only set if the event
+	// was scheduled.
+	ScheduledId *uint64 `protobuf:"varint,2,opt,name=scheduled_id,json=scheduledId,proto3,oneof" json:"scheduled_id,omitempty"`
+}
+
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventResponse) ProtoMessage() {}
+
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PublishEventResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishEventResponse) GetScheduledId() uint64 {
+	if x != nil && x.ScheduledId != nil {
+		return *x.ScheduledId
+	}
+	return 0
+}
+
+// Request to publish an event in CloudEvents format.
+//
+// Attributes are mapped to the event as follows:
+//
+//   - `id` is used as the idempotency key of the event.
+//   - `source` is stored in the custom header `source`.
+//   - `type` is the full name of the Protobuf message of the data, unless
+//     `dataschema` is set to a type URL such as
+//     `type.googleapis.com/orders.v1.OrderCreated`.
+//   - `subject` is used as the subject of the event, unless subject is set in
+//     the request.
+//   - `time` is used as the timestamp of the event.
+//   - `traceparent` and `tracestate` are used as the parent of the trace of
+//     the event.
+//   - Other extension attributes are stored as custom headers.
+//
+// Data in Protobuf format (`application/protobuf`) is stored as is, and JSON
+// data (`application/json`) is converted to Protobuf using the types
+// registered with the SchemaService.
+type PublishCloudEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*PublishCloudEventRequest_Structured
+	//	*PublishCloudEventRequest_StructuredJson
+	//	*PublishCloudEventRequest_Binary
+	Event isPublishCloudEventRequest_Event `protobuf_oneof:"event"`
+	// The subject to publish the event to. Defaults to the subject attribute
+	// of the event if not provided.
+	Subject *string `protobuf:"bytes,4,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	// The id of the event that is expected to be the last event published to
+	// the subject, used for optimistic concurrency control.
+	//
+	// No default, publish will not check the last event if not provided.
+	ExpectedLastId *uint64 `protobuf:"varint,5,opt,name=expected_last_id,json=expectedLastId,proto3,oneof" json:"expected_last_id,omitempty"`
+}
+
+func (x *PublishCloudEventRequest) Reset() {
+	*x = PublishCloudEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishCloudEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCloudEventRequest) ProtoMessage() {}
+
+func (x *PublishCloudEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCloudEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishCloudEventRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *PublishCloudEventRequest) GetEvent() isPublishCloudEventRequest_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *PublishCloudEventRequest) GetStructured() *CloudEvent {
+	if x, ok := x.GetEvent().(*PublishCloudEventRequest_Structured); ok {
+		return x.Structured
+	}
+	return nil
+}
+
+func (x *PublishCloudEventRequest) GetStructuredJson() string {
+	if x, ok := x.GetEvent().(*PublishCloudEventRequest_StructuredJson); ok {
+		return x.StructuredJson
+	}
+	return ""
+}
+
+func (x *PublishCloudEventRequest) GetBinary() *BinaryCloudEvent {
+	if x, ok := x.GetEvent().(*PublishCloudEventRequest_Binary); ok {
+		return x.Binary
+	}
+	return nil
+}
+
+func (x *PublishCloudEventRequest) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *PublishCloudEventRequest) GetExpectedLastId() uint64 {
+	if x != nil && x.ExpectedLastId != nil {
+		return *x.ExpectedLastId
+	}
+	return 0
+}
+
+type isPublishCloudEventRequest_Event interface {
+	isPublishCloudEventRequest_Event()
+}
+
+type PublishCloudEventRequest_Structured struct {
+	// Event in structured mode, using the Protobuf format.
+	Structured *CloudEvent `protobuf:"bytes,1,opt,name=structured,proto3,oneof"`
+}
+
+type PublishCloudEventRequest_StructuredJson struct {
+	// Event in structured mode, using the JSON format with the media type
+	// `application/cloudevents+json`.
+	StructuredJson string `protobuf:"bytes,2,opt,name=structured_json,json=structuredJson,proto3,oneof"`
+}
+
+type PublishCloudEventRequest_Binary struct {
+	// Event in binary mode.
+	Binary *BinaryCloudEvent `protobuf:"bytes,3,opt,name=binary,proto3,oneof"`
+}
+
+func (*PublishCloudEventRequest_Structured) isPublishCloudEventRequest_Event() {}
+
+func (*PublishCloudEventRequest_StructuredJson) isPublishCloudEventRequest_Event() {}
+
+func (*PublishCloudEventRequest_Binary) isPublishCloudEventRequest_Event() {}
+
+// Response to publishing an event in CloudEvents format.
+type PublishCloudEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the published event.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PublishCloudEventResponse) Reset() {
+	*x = PublishCloudEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishCloudEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishCloudEventResponse) ProtoMessage() {}
+
+func (x *PublishCloudEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishCloudEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishCloudEventResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublishCloudEventResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Request to list scheduled events.
+type ListScheduledEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list events that will be published to subjects matching this
+	// subject, which may contain wildcards.
+	//
+	// Defaults to all scheduled events if not provided.
+	Subject *string `protobuf:"bytes,1,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	// Maximum number of events to return.
+	//
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListScheduledEventsRequest) Reset() {
+	*x = ListScheduledEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledEventsRequest) ProtoMessage() {}
+
+func (x *ListScheduledEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListScheduledEventsRequest) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *ListScheduledEventsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduledEventsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+// Response to listing scheduled events.
+type ListScheduledEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scheduled events in this page, in the order they were scheduled.
+	Events []*ScheduledEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+}
+
+func (x *ListScheduledEventsResponse) Reset() {
+	*x = ListScheduledEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledEventsResponse) ProtoMessage() {}
+
+func (x *ListScheduledEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListScheduledEventsResponse) GetEvents() []*ScheduledEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListScheduledEventsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+// Request to cancel a scheduled event.
+type CancelScheduledEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the scheduled event, as returned when it was scheduled.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelScheduledEventRequest) Reset() {
+	*x = CancelScheduledEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledEventRequest) ProtoMessage() {}
+
+func (x *CancelScheduledEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledEventRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelScheduledEventRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Response to canceling a scheduled event.
+type CancelScheduledEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelScheduledEventResponse) Reset() {
+	*x = CancelScheduledEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledEventResponse) ProtoMessage() {}
+
+func (x *CancelScheduledEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledEventResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{34}
+}
+
+// An event waiting to be published.
+type ScheduledEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the scheduled event, used to cancel it.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The subject the event will be published to.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// When the event will be published.
+	DeliverAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deliver_at,json=deliverAt,proto3" json:"deliver_at,omitempty"`
+	// Headers the event will be published with.
+	Headers *Headers `protobuf:"bytes,4,opt,name=headers,proto3" json:"headers,omitempty"`
+	// Data of the event.
+	Data *anypb.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ScheduledEvent) Reset() {
+	*x = ScheduledEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledEvent) ProtoMessage() {}
+
+func (x *ScheduledEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledEvent.ProtoReflect.Descriptor instead.
+func (*ScheduledEvent) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ScheduledEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ScheduledEvent) GetDeliverAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliverAt
+	}
+	return nil
+}
+
+func (x *ScheduledEvent) GetHeaders() *Headers {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *ScheduledEvent) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request to publish several events.
+type PublishEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events to publish, at most 1000 events can be published at once.
+	Events []*PublishEventRequest `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *PublishEventsRequest) Reset() {
+	*x = PublishEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventsRequest) ProtoMessage() {}
+
+func (x *PublishEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventsRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PublishEventsRequest) GetEvents() []*PublishEventRequest {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Response to publishing several events.
+type PublishEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The result of every event, in the same order as in the request.
+	Results []*PublishResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *PublishEventsResponse) Reset() {
+	*x = PublishEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventsResponse) ProtoMessage() {}
+
+func (x *PublishEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventsResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PublishEventsResponse) GetResults() []*PublishResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Result of publishing a single event as part of PublishEvents or
+// PublishStream.
+type PublishResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the event this result is for. For PublishEvents this is
+	// the index in the request, for PublishStream it is the number of events
+	// sent before this event on the stream.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*PublishResult_Id
+	//	*PublishResult_Error
+	//	*PublishResult_ScheduledId
+	Result isPublishResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *PublishResult) Reset() {
+	*x = PublishResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishResult) ProtoMessage() {}
+
+func (x *PublishResult) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishResult.ProtoReflect.Descriptor instead.
+func (*PublishResult) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PublishResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (m *PublishResult) GetResult() isPublishResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *PublishResult) GetId() uint64 {
+	if x, ok := x.GetResult().(*PublishResult_Id); ok {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PublishResult) GetError() *PublishError {
+	if x, ok := x.GetResult().(*PublishResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *PublishResult) GetScheduledId() uint64 {
+	if x, ok := x.GetResult().(*PublishResult_ScheduledId); ok {
+		return x.ScheduledId
+	}
+	return 0
+}
+
+type isPublishResult_Result interface {
+	isPublishResult_Result()
+}
+
+type PublishResult_Id struct {
+	// The id of the published event.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3,oneof"`
+}
+
+type PublishResult_Error struct {
+	// Why the event could not be published.
+	Error *PublishError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+type PublishResult_ScheduledId struct {
+	// The id of the scheduled event, if the event was scheduled.
+	ScheduledId uint64 `protobuf:"varint,4,opt,name=scheduled_id,json=scheduledId,proto3,oneof"`
+}
+
+func (*PublishResult_Id) isPublishResult_Result() {}
+
+func (*PublishResult_Error) isPublishResult_Result() {}
+
+func (*PublishResult_ScheduledId) isPublishResult_Result() {}
+
+// Error that occurred when publishing an event.
+type PublishError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The code of the error.
+	Code PublishError_Code `protobuf:"varint,1,opt,name=code,proto3,enum=windshift.events.v1alpha1.PublishError_Code" json:"code,omitempty"`
+	// Human readable description of the error.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PublishError) Reset() {
+	*x = PublishError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishError) ProtoMessage() {}
+
+func (x *PublishError) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishError.ProtoReflect.Descriptor instead.
+func (*PublishError) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PublishError) GetCode() PublishError_Code {
+	if x != nil {
+		return x.Code
+	}
+	return PublishError_CODE_UNSPECIFIED
+}
+
+func (x *PublishError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to subscribe to events or to acknowledge, reject or ping events.
+// The first message sent on the stream must be a Subscribe message, after
+// which the client can send Ack, Reject or Ping messages.
+//
+// Events should be either acknowledged or rejected, otherwise they will be
+// resent after a timeout. If processing takes of an event takes a while pings
+// should be sent to indicate that the event is still being processed.
+type EventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//
+	//	*EventsRequest_Subscribe_
+	//	*EventsRequest_Ack_
+	//	*EventsRequest_Reject_
+	//	*EventsRequest_Ping_
+	Request isEventsRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest) ProtoMessage() {}
+
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (m *EventsRequest) GetRequest() isEventsRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetSubscribe() *EventsRequest_Subscribe {
+	if x, ok := x.GetRequest().(*EventsRequest_Subscribe_); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetAck() *EventsRequest_Ack {
+	if x, ok := x.GetRequest().(*EventsRequest_Ack_); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetReject() *EventsRequest_Reject {
+	if x, ok := x.GetRequest().(*EventsRequest_Reject_); ok {
+		return x.Reject
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetPing() *EventsRequest_Ping {
+	if x, ok := x.GetRequest().(*EventsRequest_Ping_); ok {
+		return x.Ping
+	}
+	return nil
+}
+
+type isEventsRequest_Request interface {
+	isEventsRequest_Request()
+}
+
+type EventsRequest_Subscribe_ struct {
+	// Subscribe to events, must be sent as the first message in the stream
+	// to establish what events to listen to.
+	//
+	// A ConsumeResponse.Subscribed message will be sent in response to
+	// this message. If the subscription fails, the stream will be closed.
+	Subscribe *EventsRequest_Subscribe `protobuf:"bytes,1,opt,name=subscribe,proto3,oneof"`
+}
+
+type EventsRequest_Ack_ struct {
+	// Acknowledge that some events have been successfully processed. When
+	// an event is acknowledged a ConsumeResponse.AckConfirmation message
+	// will be sent in response.
+	Ack *EventsRequest_Ack `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+}
+
+type EventsRequest_Reject_ struct {
+	// Acknowledge that some events have failed to be processed. When an
+	// event is rejected a ConsumeResponse.RejectConfirmation message will
+	// be sent in response.
+	Reject *EventsRequest_Reject `protobuf:"bytes,3,opt,name=reject,proto3,oneof"`
+}
+
+type EventsRequest_Ping_ struct {
+	// Ping events to indicate that they are still being processed. When
+	// an event is pinged a ConsumeResponse.PingConfirmation message will
+	// be sent in response.
+	Ping *EventsRequest_Ping `protobuf:"bytes,4,opt,name=ping,proto3,oneof"`
+}
+
+func (*EventsRequest_Subscribe_) isEventsRequest_Request() {}
+
+func (*EventsRequest_Ack_) isEventsRequest_Request() {}
+
+func (*EventsRequest_Reject_) isEventsRequest_Request() {}
+
+func (*EventsRequest_Ping_) isEventsRequest_Request() {}
+
+type EventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*EventsResponse_Event
+	//	*EventsResponse_Subscribed_
+	//	*EventsResponse_AckConfirmation_
+	//	*EventsResponse_RejectConfirmation_
+	//	*EventsResponse_PingConfirmation_
+	Response isEventsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *EventsResponse) Reset() {
+	*x = EventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse) ProtoMessage() {}
+
+func (x *EventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *EventsResponse) GetResponse() isEventsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetEvent() *Event {
+	if x, ok := x.GetResponse().(*EventsResponse_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetSubscribed() *EventsResponse_Subscribed {
+	if x, ok := x.GetResponse().(*EventsResponse_Subscribed_); ok {
+		return x.Subscribed
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetAckConfirmation() *EventsResponse_AckConfirmation {
+	if x, ok := x.GetResponse().(*EventsResponse_AckConfirmation_); ok {
+		return x.AckConfirmation
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetRejectConfirmation() *EventsResponse_RejectConfirmation {
+	if x, ok := x.GetResponse().(*EventsResponse_RejectConfirmation_); ok {
+		return x.RejectConfirmation
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetPingConfirmation() *EventsResponse_PingConfirmation {
+	if x, ok := x.GetResponse().(*EventsResponse_PingConfirmation_); ok {
+		return x.PingConfirmation
+	}
+	return nil
+}
+
+type isEventsResponse_Response interface {
+	isEventsResponse_Response()
+}
+
+type EventsResponse_Event struct {
+	// An event that was published.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3,oneof"`
+}
+
+type EventsResponse_Subscribed_ struct {
+	// Confirmation that the client has subscribed to events.
+	Subscribed *EventsResponse_Subscribed `protobuf:"bytes,2,opt,name=subscribed,proto3,oneof"`
+}
+
+type EventsResponse_AckConfirmation_ struct {
+	// Confirmation that some events were acknowledged.
+	AckConfirmation *EventsResponse_AckConfirmation `protobuf:"bytes,3,opt,name=ack_confirmation,json=ackConfirmation,proto3,oneof"`
+}
+
+type EventsResponse_RejectConfirmation_ struct {
+	// Confirmation that some events were rejected.
+	RejectConfirmation *EventsResponse_RejectConfirmation `protobuf:"bytes,4,opt,name=reject_confirmation,json=rejectConfirmation,proto3,oneof"`
+}
+
+type EventsResponse_PingConfirmation_ struct {
+	// Confirmation that a ping was received.
+	PingConfirmation *EventsResponse_PingConfirmation `protobuf:"bytes,5,opt,name=ping_confirmation,json=pingConfirmation,proto3,oneof"`
+}
+
+func (*EventsResponse_Event) isEventsResponse_Response() {}
+
+func (*EventsResponse_Subscribed_) isEventsResponse_Response() {}
+
+func (*EventsResponse_AckConfirmation_) isEventsResponse_Response() {}
+
+func (*EventsResponse_RejectConfirmation_) isEventsResponse_Response() {}
+
+func (*EventsResponse_PingConfirmation_) isEventsResponse_Response() {}
+
+// A pointer to a position in a stream. Used to determine where to start
+// consuming events from.
+type StreamPointer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Pointer:
+	//
+	//	*StreamPointer_Start
+	//	*StreamPointer_End
+	//	*StreamPointer_Time
+	//	*StreamPointer_Offset
+	Pointer isStreamPointer_Pointer `protobuf_oneof:"pointer"`
+}
+
+func (x *StreamPointer) Reset() {
+	*x = StreamPointer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPointer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPointer) ProtoMessage() {}
+
+func (x *StreamPointer) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPointer.ProtoReflect.Descriptor instead.
+func (*StreamPointer) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (m *StreamPointer) GetPointer() isStreamPointer_Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+func (x *StreamPointer) GetStart() bool {
+	if x, ok := x.GetPointer().(*StreamPointer_Start); ok {
+		return x.Start
+	}
+	return false
+}
+
+func (x *StreamPointer) GetEnd() bool {
+	if x, ok := x.GetPointer().(*StreamPointer_End); ok {
+		return x.End
+	}
+	return false
+}
+
+func (x *StreamPointer) GetTime() *timestamppb.Timestamp {
+	if x, ok := x.GetPointer().(*StreamPointer_Time); ok {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *StreamPointer) GetOffset() uint64 {
+	if x, ok := x.GetPointer().(*StreamPointer_Offset); ok {
+		return x.Offset
+	}
+	return 0
+}
+
+type isStreamPointer_Pointer interface {
+	isStreamPointer_Pointer()
+}
+
+type StreamPointer_Start struct {
+	// Receive all events currently available.
+	Start bool `protobuf:"varint,1,opt,name=start,proto3,oneof"`
+}
+
+type StreamPointer_End struct {
+	// Receive only future events.
+	End bool `protobuf:"varint,2,opt,name=end,proto3,oneof"`
+}
+
+type StreamPointer_Time struct {
+	// The time to start receiving events from.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3,oneof"`
+}
+
+type StreamPointer_Offset struct {
+	// The id of the event to start receiving events from.
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3,oneof"`
+}
+
+func (*StreamPointer_Start) isStreamPointer_Pointer() {}
+
+func (*StreamPointer_End) isStreamPointer_Pointer() {}
+
+func (*StreamPointer_Time) isStreamPointer_Pointer() {}
+
+func (*StreamPointer_Offset) isStreamPointer_Pointer() {}
+
+// Request to fetch a batch of events from a consumer.
+type FetchEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the stream to fetch events from.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the consumer to fetch events from, should be a consumer
+	// previously created using EnsureConsumer.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// The maximum number of events to return.
+	//
+	// Defaults to 10 if not provided, can be at most 1000.
+	MaxEvents *uint32 `protobuf:"varint,3,opt,name=max_events,json=maxEvents,proto3,oneof" json:"max_events,omitempty"`
+	// The maximum time to wait for events to become available. The response
+	// is sent as soon as max_events have been received or this time has
+	// passed, and may contain fewer events or none at all.
+	//
+	// Defaults to 5 seconds if not provided, can be at most 1 minute.
+	MaxWait *durationpb.Duration `protobuf:"bytes,4,opt,name=max_wait,json=maxWait,proto3,oneof" json:"max_wait,omitempty"`
+	// The encoding to use for the data of events.
+	//
+	// Defaults to Protobuf if not provided.
+	Encoding *Encoding `protobuf:"varint,5,opt,name=encoding,proto3,enum=windshift.events.v1alpha1.Encoding,oneof" json:"encoding,omitempty"`
+	// Return events as CloudEvents. The encoding is used for the data of the
+	// CloudEvents.
+	//
+	// Defaults to not using CloudEvents if not provided.
+	CloudEvents *CloudEventsMode `protobuf:"varint,6,opt,name=cloud_events,json=cloudEvents,proto3,enum=windshift.events.v1alpha1.CloudEventsMode,oneof" json:"cloud_events,omitempty"`
+}
+
+func (x *FetchEventsRequest) Reset() {
+	*x = FetchEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEventsRequest) ProtoMessage() {}
+
+func (x *FetchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEventsRequest.ProtoReflect.Descriptor instead.
+func (*FetchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FetchEventsRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *FetchEventsRequest) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *FetchEventsRequest) GetMaxEvents() uint32 {
+	if x != nil && x.MaxEvents != nil {
+		return *x.MaxEvents
+	}
+	return 0
+}
+
+func (x *FetchEventsRequest) GetMaxWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxWait
+	}
+	return nil
+}
+
+func (x *FetchEventsRequest) GetEncoding() Encoding {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *FetchEventsRequest) GetCloudEvents() CloudEventsMode {
+	if x != nil && x.CloudEvents != nil {
+		return *x.CloudEvents
+	}
+	return CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED
+}
+
+// Response to fetching events.
+type FetchEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events that were fetched. Each event must be acknowledged or
+	// rejected using its ack token before the processing timeout of the
+	// consumer, otherwise it will be redelivered.
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *FetchEventsResponse) Reset() {
+	*x = FetchEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchEventsResponse) ProtoMessage() {}
+
+func (x *FetchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchEventsResponse.ProtoReflect.Descriptor instead.
+func (*FetchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FetchEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Request to get a single event from a stream.
+type GetEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the stream the event is stored in.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The id of the event, which is its sequence number in the stream.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The encoding to use for the data of the event.
+	//
+	// Defaults to Protobuf if not provided.
+	Encoding *Encoding `protobuf:"varint,3,opt,name=encoding,proto3,enum=windshift.events.v1alpha1.Encoding,oneof" json:"encoding,omitempty"`
+	// Return the event as a CloudEvent. The encoding is used for the data of
+	// the CloudEvent.
+	//
+	// Defaults to not using CloudEvents if not provided.
+	CloudEvents *CloudEventsMode `protobuf:"varint,4,opt,name=cloud_events,json=cloudEvents,proto3,enum=windshift.events.v1alpha1.CloudEventsMode,oneof" json:"cloud_events,omitempty"`
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEventRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *GetEventRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetEventRequest) GetEncoding() Encoding {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *GetEventRequest) GetCloudEvents() CloudEventsMode {
+	if x != nil && x.CloudEvents != nil {
+		return *x.CloudEvents
+	}
+	return CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED
+}
+
+// Response to getting a single event. The event does not have an ack token,
+// as it was not delivered by a consumer.
+type GetEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The event.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventResponse) ProtoMessage() {}
+
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Request to read events stored in a stream.
+type ReadEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the stream to read events from.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// Only read events published to subjects matching this subject, which
+	// may contain wildcards.
+	//
+	// Defaults to all events in the stream if not provided.
+	Subject *string `protobuf:"bytes,2,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	// Where to start reading events.
+	//
+	// Defaults to the first event in the stream if not provided.
+	//
+	// Types that are assignable to Start:
+	//
+	//	*ReadEventsRequest_StartId
+	//	*ReadEventsRequest_StartTime
+	Start isReadEventsRequest_Start `protobuf_oneof:"start"`
+	// Where to stop reading events.
+	//
+	// Defaults to the last event in the stream if not provided.
+	//
+	// Types that are assignable to End:
+	//
+	//	*ReadEventsRequest_EndId
+	//	*ReadEventsRequest_EndTime
+	End isReadEventsRequest_End `protobuf_oneof:"end"`
+	// Maximum number of events to return.
+	//
+	// Defaults to 100 if not provided, can be at most 1000.
+	PageSize *uint32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// Token of the page to return, as returned in `next_page_token` of a
+	// previous response. The other fields of the request should be the same
+	// as for the previous page.
+	//
+	// Defaults to the first page if not provided.
+	PageToken *string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// The encoding to use for the data of events.
+	//
+	// Defaults to Protobuf if not provided.
+	Encoding *Encoding `protobuf:"varint,9,opt,name=encoding,proto3,enum=windshift.events.v1alpha1.Encoding,oneof" json:"encoding,omitempty"`
+	// Return events as CloudEvents. The encoding is used for the data of the
+	// CloudEvents.
+	//
+	// Defaults to not using CloudEvents if not provided.
+	CloudEvents *CloudEventsMode `protobuf:"varint,10,opt,name=cloud_events,json=cloudEvents,proto3,enum=windshift.events.v1alpha1.CloudEventsMode,oneof" json:"cloud_events,omitempty"`
+}
+
+func (x *ReadEventsRequest) Reset() {
+	*x = ReadEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadEventsRequest) ProtoMessage() {}
+
+func (x *ReadEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReadEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReadEventsRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ReadEventsRequest) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (m *ReadEventsRequest) GetStart() isReadEventsRequest_Start {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (x *ReadEventsRequest) GetStartId() uint64 {
+	if x, ok := x.GetStart().(*ReadEventsRequest_StartId); ok {
+		return x.StartId
+	}
+	return 0
+}
+
+func (x *ReadEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x, ok := x.GetStart().(*ReadEventsRequest_StartTime); ok {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (m *ReadEventsRequest) GetEnd() isReadEventsRequest_End {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (x *ReadEventsRequest) GetEndId() uint64 {
+	if x, ok := x.GetEnd().(*ReadEventsRequest_EndId); ok {
+		return x.EndId
+	}
+	return 0
+}
+
+func (x *ReadEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x, ok := x.GetEnd().(*ReadEventsRequest_EndTime); ok {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReadEventsRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ReadEventsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ReadEventsRequest) GetEncoding() Encoding {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *ReadEventsRequest) GetCloudEvents() CloudEventsMode {
+	if x != nil && x.CloudEvents != nil {
+		return *x.CloudEvents
+	}
+	return CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED
+}
+
+type isReadEventsRequest_Start interface {
+	isReadEventsRequest_Start()
+}
+
+type ReadEventsRequest_StartId struct {
+	// The id of the first event to read.
+	StartId uint64 `protobuf:"varint,3,opt,name=start_id,json=startId,proto3,oneof"`
+}
+
+type ReadEventsRequest_StartTime struct {
+	// Read events stored at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,oneof"`
+}
+
+func (*ReadEventsRequest_StartId) isReadEventsRequest_Start() {}
+
+func (*ReadEventsRequest_StartTime) isReadEventsRequest_Start() {}
+
+type isReadEventsRequest_End interface {
+	isReadEventsRequest_End()
+}
+
+type ReadEventsRequest_EndId struct {
+	// The id of the last event to read.
+	EndId uint64 `protobuf:"varint,5,opt,name=end_id,json=endId,proto3,oneof"`
+}
+
+type ReadEventsRequest_EndTime struct {
+	// Read events stored before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,oneof"`
+}
+
+func (*ReadEventsRequest_EndId) isReadEventsRequest_End() {}
+
+func (*ReadEventsRequest_EndTime) isReadEventsRequest_End() {}
+
+// Response to reading events. The events do not have ack tokens, as they
+// were not delivered by a consumer.
+type ReadEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The events in this page, in the order they were stored.
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Token that can be used to request the next page, not set if this is the
+	// last page.
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+}
+
+func (x *ReadEventsResponse) Reset() {
+	*x = ReadEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadEventsResponse) ProtoMessage() {}
+
+func (x *ReadEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReadEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReadEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ReadEventsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+// Request to acknowledge events using their ack tokens.
+type AckEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ack tokens of the events to acknowledge.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *AckEventsRequest) Reset() {
+	*x = AckEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckEventsRequest) ProtoMessage() {}
+
+func (x *AckEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckEventsRequest.ProtoReflect.Descriptor instead.
+func (*AckEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AckEventsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Response to acknowledging events.
+type AckEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tokens that were acknowledged.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Tokens that were invalid, should not be retried by the client.
+	InvalidTokens []string `protobuf:"bytes,2,rep,name=invalid_tokens,json=invalidTokens,proto3" json:"invalid_tokens,omitempty"`
+	// Tokens that could not be processed temporarily, should be retried by
+	// the client.
+	TemporaryFailedTokens []string `protobuf:"bytes,3,rep,name=temporary_failed_tokens,json=temporaryFailedTokens,proto3" json:"temporary_failed_tokens,omitempty"`
+}
+
+func (x *AckEventsResponse) Reset() {
+	*x = AckEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckEventsResponse) ProtoMessage() {}
+
+func (x *AckEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckEventsResponse.ProtoReflect.Descriptor instead.
+func (*AckEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AckEventsResponse) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *AckEventsResponse) GetInvalidTokens() []string {
+	if x != nil {
+		return x.InvalidTokens
+	}
+	return nil
+}
+
+func (x *AckEventsResponse) GetTemporaryFailedTokens() []string {
+	if x != nil {
+		return x.TemporaryFailedTokens
+	}
+	return nil
+}
+
+// Request to reject events using their ack tokens.
+type RejectEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ack tokens of the events to reject.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Permanently reject the events, if not provided the events will be
+	// retried after a timeout. If permanently is set to true, the events
+	// will not be redelivered.
+	//
+	// Can not be combined with delay.
+	Permanently *bool `protobuf:"varint,2,opt,name=permanently,proto3,oneof" json:"permanently,omitempty"`
+	// Optional time to wait before redelivering the events. If not
+	// provided the redelivery backoff of the consumer is used.
+	//
+	// Can not be combined with permanently.
+	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+	// Human readable reason for why the events failed. Recorded in the
+	// failure log of the consumer and in dead letters.
+	//
+	// No failure is recorded if not provided. At most 4096 characters and
+	// can not contain line breaks, the call fails with INVALID_ARGUMENT
+	// otherwise.
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Error code of the failure, for grouping similar failures. Only used
+	// if a reason is provided. At most 4096 characters and can not contain
+	// line breaks.
+	Code *string `protobuf:"bytes,5,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	// Additional details about the failure. Only used if a reason is
+	// provided. At most 64 details with a combined size of 16384 characters.
+	Details map[string]string `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RejectEventsRequest) Reset() {
+	*x = RejectEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEventsRequest) ProtoMessage() {}
+
+func (x *RejectEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEventsRequest.ProtoReflect.Descriptor instead.
+func (*RejectEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RejectEventsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *RejectEventsRequest) GetPermanently() bool {
+	if x != nil && x.Permanently != nil {
+		return *x.Permanently
+	}
+	return false
+}
+
+func (x *RejectEventsRequest) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *RejectEventsRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *RejectEventsRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *RejectEventsRequest) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Response to rejecting events.
+type RejectEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tokens that were rejected.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Tokens that were invalid, should not be retried by the client.
+	InvalidTokens []string `protobuf:"bytes,2,rep,name=invalid_tokens,json=invalidTokens,proto3" json:"invalid_tokens,omitempty"`
+	// Tokens that could not be processed temporarily, should be retried by
+	// the client.
+	TemporaryFailedTokens []string `protobuf:"bytes,3,rep,name=temporary_failed_tokens,json=temporaryFailedTokens,proto3" json:"temporary_failed_tokens,omitempty"`
+}
+
+func (x *RejectEventsResponse) Reset() {
+	*x = RejectEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectEventsResponse) ProtoMessage() {}
+
+func (x *RejectEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectEventsResponse.ProtoReflect.Descriptor instead.
+func (*RejectEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RejectEventsResponse) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *RejectEventsResponse) GetInvalidTokens() []string {
+	if x != nil {
+		return x.InvalidTokens
+	}
+	return nil
+}
+
+func (x *RejectEventsResponse) GetTemporaryFailedTokens() []string {
+	if x != nil {
+		return x.TemporaryFailedTokens
+	}
+	return nil
+}
+
+// Request to ping events using their ack tokens.
+type PingEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ack tokens of the events that are still being processed.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *PingEventsRequest) Reset() {
+	*x = PingEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingEventsRequest) ProtoMessage() {}
+
+func (x *PingEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingEventsRequest.ProtoReflect.Descriptor instead.
+func (*PingEventsRequest) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PingEventsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Response to pinging events.
+type PingEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The tokens that were pinged.
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Tokens that were invalid, should not be retried by the client.
+	InvalidTokens []string `protobuf:"bytes,2,rep,name=invalid_tokens,json=invalidTokens,proto3" json:"invalid_tokens,omitempty"`
+	// Tokens that could not be processed temporarily, should be retried by
+	// the client.
+	TemporaryFailedTokens []string `protobuf:"bytes,3,rep,name=temporary_failed_tokens,json=temporaryFailedTokens,proto3" json:"temporary_failed_tokens,omitempty"`
+}
+
+func (x *PingEventsResponse) Reset() {
+	*x = PingEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingEventsResponse) ProtoMessage() {}
+
+func (x *PingEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingEventsResponse.ProtoReflect.Descriptor instead.
+func (*PingEventsResponse) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PingEventsResponse) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *PingEventsResponse) GetInvalidTokens() []string {
+	if x != nil {
+		return x.InvalidTokens
+	}
+	return nil
+}
+
+func (x *PingEventsResponse) GetTemporaryFailedTokens() []string {
+	if x != nil {
+		return x.TemporaryFailedTokens
+	}
+	return nil
+}
+
+// Event that was published and sent to a subscriber.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the event, used to acknowledge, reject or ping the event.
+	// Can also be used to resume processing of events if using an ephemeral
+	// subscriber.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The subject of this event.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Headers of the event.
+	Headers *Headers `protobuf:"bytes,3,opt,name=headers,proto3" json:"headers,omitempty"`
+	// Data of the event. The data is dynamic and it is up to the publisher
+	// and subscriber to agree on the Protobuf messages to support.
+	//
+	// Each subject can support several different types of data, which can be
+	// useful to support different versions of the data. Subscribers should
+	// ignore data they do not understand.
+	//
+	// Not set if the data is returned as JSON.
+	Data *anypb.Any `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// The delivery attempt this is. Starts at 1 and increases for each
+	// redelivery of the event.
+	DeliveryAttempt uint64 `protobuf:"varint,5,opt,name=delivery_attempt,json=deliveryAttempt,proto3" json:"delivery_attempt,omitempty"`
+	// Opaque token for this delivery of the event. Can be used with
+	// AckEvents, RejectEvents and PingEvents from any connection to the
+	// server.
+	AckToken string `protobuf:"bytes,6,opt,name=ack_token,json=ackToken,proto3" json:"ack_token,omitempty"`
+	// Data of the event encoded as JSON, set instead of data if JSON
+	// encoding was requested. Uses the JSON format of `google.protobuf.Any`,
+	// with the full type URL in the `@type` field.
+	//
+	// If the type of the data is not known to the server the data is
+	// returned as Protobuf in data instead.
+	JsonData *string `protobuf:"bytes,7,opt,name=json_data,json=jsonData,proto3,oneof" json:"json_data,omitempty"`
+	// The event as a CloudEvent, set if CloudEvents were requested. Neither
+	// data nor json_data are set if the event is sent as a CloudEvent.
+	//
+	// Types that are assignable to CloudEvent:
+	//
+	//	*Event_StructuredCloudEvent
+	//	*Event_StructuredJsonCloudEvent
+	//	*Event_BinaryCloudEvent
+	CloudEvent isEvent_CloudEvent `protobuf_oneof:"cloud_event"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Event) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Event) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Event) GetHeaders() *Headers {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Event) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Event) GetDeliveryAttempt() uint64 {
+	if x != nil {
+		return x.DeliveryAttempt
+	}
+	return 0
+}
+
+func (x *Event) GetAckToken() string {
+	if x != nil {
+		return x.AckToken
+	}
+	return ""
+}
+
+func (x *Event) GetJsonData() string {
+	if x != nil && x.JsonData != nil {
+		return *x.JsonData
+	}
+	return ""
+}
+
+func (m *Event) GetCloudEvent() isEvent_CloudEvent {
+	if m != nil {
+		return m.CloudEvent
+	}
+	return nil
+}
+
+func (x *Event) GetStructuredCloudEvent() *CloudEvent {
+	if x, ok := x.GetCloudEvent().(*Event_StructuredCloudEvent); ok {
+		return x.StructuredCloudEvent
+	}
+	return nil
+}
+
+func (x *Event) GetStructuredJsonCloudEvent() string {
+	if x, ok := x.GetCloudEvent().(*Event_StructuredJsonCloudEvent); ok {
+		return x.StructuredJsonCloudEvent
+	}
+	return ""
+}
+
+func (x *Event) GetBinaryCloudEvent() *BinaryCloudEvent {
+	if x, ok := x.GetCloudEvent().(*Event_BinaryCloudEvent); ok {
+		return x.BinaryCloudEvent
+	}
+	return nil
+}
+
+type isEvent_CloudEvent interface {
+	isEvent_CloudEvent()
+}
+
+type Event_StructuredCloudEvent struct {
+	// The event in structured mode, using the Protobuf format.
+	StructuredCloudEvent *CloudEvent `protobuf:"bytes,8,opt,name=structured_cloud_event,json=structuredCloudEvent,proto3,oneof"`
+}
+
+type Event_StructuredJsonCloudEvent struct {
+	// The event in structured mode, using the JSON format with the media
+	// type `application/cloudevents+json`.
+	StructuredJsonCloudEvent string `protobuf:"bytes,9,opt,name=structured_json_cloud_event,json=structuredJsonCloudEvent,proto3,oneof"`
+}
+
+type Event_BinaryCloudEvent struct {
+	// The event in binary mode.
+	BinaryCloudEvent *BinaryCloudEvent `protobuf:"bytes,10,opt,name=binary_cloud_event,json=binaryCloudEvent,proto3,oneof"`
+}
+
+func (*Event_StructuredCloudEvent) isEvent_CloudEvent() {}
+
+func (*Event_StructuredJsonCloudEvent) isEvent_CloudEvent() {}
+
+func (*Event_BinaryCloudEvent) isEvent_CloudEvent() {}
+
+// A CloudEvent in the CloudEvents Protobuf format. Uses the same fields as
+// `io.cloudevents.v1.CloudEvent`, so it can be decoded by CloudEvents SDKs.
+//
+// See https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/protobuf-format.md
+type CloudEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the event.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifies the context in which the event happened.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// The version of the CloudEvents specification, always `1.0`.
+	SpecVersion string `protobuf:"bytes,3,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	// The type of the event.
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Optional and extension attributes, such as `time` and `subject`.
+	Attributes map[string]*CloudEvent_CloudEventAttributeValue `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The data of the event.
+	//
+	// Types that are assignable to Data:
+	//
+	//	*CloudEvent_BinaryData
+	//	*CloudEvent_TextData
+	//	*CloudEvent_ProtoData
+	Data isCloudEvent_Data `protobuf_oneof:"data"`
+}
+
+func (x *CloudEvent) Reset() {
+	*x = CloudEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudEvent) ProtoMessage() {}
+
+func (x *CloudEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudEvent.ProtoReflect.Descriptor instead.
+func (*CloudEvent) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CloudEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetSpecVersion() string {
+	if x != nil {
+		return x.SpecVersion
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetAttributes() map[string]*CloudEvent_CloudEventAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (m *CloudEvent) GetData() isCloudEvent_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CloudEvent) GetBinaryData() []byte {
+	if x, ok := x.GetData().(*CloudEvent_BinaryData); ok {
+		return x.BinaryData
+	}
+	return nil
+}
+
+func (x *CloudEvent) GetTextData() string {
+	if x, ok := x.GetData().(*CloudEvent_TextData); ok {
+		return x.TextData
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetProtoData() *anypb.Any {
+	if x, ok := x.GetData().(*CloudEvent_ProtoData); ok {
+		return x.ProtoData
+	}
+	return nil
+}
+
+type isCloudEvent_Data interface {
+	isCloudEvent_Data()
+}
+
+type CloudEvent_BinaryData struct {
+	// Binary data, such as a Protobuf message.
+	BinaryData []byte `protobuf:"bytes,6,opt,name=binary_data,json=binaryData,proto3,oneof"`
+}
+
+type CloudEvent_TextData struct {
+	// Text data, such as JSON.
+	TextData string `protobuf:"bytes,7,opt,name=text_data,json=textData,proto3,oneof"`
+}
+
+type CloudEvent_ProtoData struct {
+	// A Protobuf message.
+	ProtoData *anypb.Any `protobuf:"bytes,8,opt,name=proto_data,json=protoData,proto3,oneof"`
+}
+
+func (*CloudEvent_BinaryData) isCloudEvent_Data() {}
+
+func (*CloudEvent_TextData) isCloudEvent_Data() {}
+
+func (*CloudEvent_ProtoData) isCloudEvent_Data() {}
+
+// A CloudEvent in binary mode, with the attributes as headers and the data
+// kept as is.
+type BinaryCloudEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The attributes as headers prefixed with `ce-`, such as `ce-id` and
+	// `ce-type`, and the media type of the data in `content-type`.
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The data of the event.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BinaryCloudEvent) Reset() {
+	*x = BinaryCloudEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryCloudEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryCloudEvent) ProtoMessage() {}
+
+func (x *BinaryCloudEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryCloudEvent.ProtoReflect.Descriptor instead.
+func (*BinaryCloudEvent) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BinaryCloudEvent) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *BinaryCloudEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Headers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Timestamp of the event. This is the time the event occurred, clients
+	// may set this when publishing.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Key used to prevent duplicate events from being stored if a retry is
+	// needed.
+	IdempotencyKey *string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	// Parent trace id in the W3C trace context format.
+	TraceParent *string `protobuf:"bytes,3,opt,name=trace_parent,json=traceParent,proto3,oneof" json:"trace_parent,omitempty"`
+	// Trace state in the W3C trace context format.
+	TraceState *string `protobuf:"bytes,4,opt,name=trace_state,json=traceState,proto3,oneof" json:"trace_state,omitempty"`
+	// Custom headers set by the publisher of the event.
+	Custom map[string]string `protobuf:"bytes,5,rep,name=custom,proto3" json:"custom,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Headers) Reset() {
+	*x = Headers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Headers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Headers) ProtoMessage() {}
+
+func (x *Headers) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Headers.ProtoReflect.Descriptor instead.
+func (*Headers) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Headers) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Headers) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Headers) GetTraceParent() string {
+	if x != nil && x.TraceParent != nil {
+		return *x.TraceParent
+	}
+	return ""
+}
+
+func (x *Headers) GetTraceState() string {
+	if x != nil && x.TraceState != nil {
+		return *x.TraceState
+	}
+	return ""
+}
+
+func (x *Headers) GetCustom() map[string]string {
+	if x != nil {
+		return x.Custom
+	}
+	return nil
+}
+
+// Policy for how events in the stream should be retained.
+type EnsureStreamRequest_RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum age of events in the stream. Events older than this will be
+	// deleted.
+	//
+	// No default, if not provided events will not be deleted based on age.
+	MaxAge *durationpb.Duration `protobuf:"bytes,1,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	// Maximum number of events in the stream. Events beyond this limit will
+	// be deleted.
+	//
+	// No default, if not provided events will not be deleted based on
+	// count.
+	MaxEvents *uint64 `protobuf:"varint,2,opt,name=max_events,json=maxEvents,proto3,oneof" json:"max_events,omitempty"`
+	// Maximum number of events per subject in the stream. Events beyond
+	// this limit will be deleted.
+	//
+	// No default, if not provided events will not be deleted based on
+	// count.
+	MaxEventsPerSubject *uint64 `protobuf:"varint,3,opt,name=max_events_per_subject,json=maxEventsPerSubject,proto3,oneof" json:"max_events_per_subject,omitempty"`
+	// Maximum total byte size of all events in the stream. Events beyond
+	// this limit will be deleted.
+	//
+	// No default, if not provided events will not be deleted based on size.
+	MaxBytes *uint64 `protobuf:"varint,4,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	// Policy to use when discarding events the stream is full.
+	//
+	// Defaults to discarding old events if not provided.
+	DiscardPolicy *EnsureStreamRequest_DiscardPolicy `protobuf:"varint,5,opt,name=discard_policy,json=discardPolicy,proto3,enum=windshift.events.v1alpha1.EnsureStreamRequest_DiscardPolicy,oneof" json:"discard_policy,omitempty"`
+	// If discard policy is set to discard new events, settings this to
+	// true will discard new events per subject instead of globally.
+	//
+	// Defaults to false if not provided.
+	DiscardNewPerSubject *bool `protobuf:"varint,6,opt,name=discard_new_per_subject,json=discardNewPerSubject,proto3,oneof" json:"discard_new_per_subject,omitempty"`
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) Reset() {
+	*x = EnsureStreamRequest_RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureStreamRequest_RetentionPolicy) ProtoMessage() {}
+
+func (x *EnsureStreamRequest_RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureStreamRequest_RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*EnsureStreamRequest_RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetMaxEvents() uint64 {
+	if x != nil && x.MaxEvents != nil {
+		return *x.MaxEvents
+	}
+	return 0
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetMaxEventsPerSubject() uint64 {
+	if x != nil && x.MaxEventsPerSubject != nil {
+		return *x.MaxEventsPerSubject
+	}
+	return 0
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetMaxBytes() uint64 {
+	if x != nil && x.MaxBytes != nil {
+		return *x.MaxBytes
+	}
+	return 0
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetDiscardPolicy() EnsureStreamRequest_DiscardPolicy {
+	if x != nil && x.DiscardPolicy != nil {
+		return *x.DiscardPolicy
+	}
+	return EnsureStreamRequest_DISCARD_POLICY_UNSPECIFIED
+}
+
+func (x *EnsureStreamRequest_RetentionPolicy) GetDiscardNewPerSubject() bool {
+	if x != nil && x.DiscardNewPerSubject != nil {
+		return *x.DiscardNewPerSubject
+	}
+	return false
+}
+
+// List of subjects that will be collected into the stream.
+type EnsureStreamRequest_Subjects struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of subjects. Subjects are case-sensitive and should only
+	// contain the following characters:
+	//
+	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
+	//
+	//   - `_` and `-` are allowed for separating words, but the use of
+	//     camelCase is recommended.
+	//
+	//   - `.` is allowed and used as a hierarchy separator, such as
+	//     `time.us.east` and `time.eu.sweden`, which share the `time`
+	//     prefix.
+	//
+	//   - `*` matches a single token, at any level of the subject. Such as
+	//     `time.*.east` will match `time.us.east` and `time.eu.east` but
+	//     not `time.us.west` or `time.us.central.east`. Similarly `time.us.*`
+	//     will match `time.us.east` but not `time.us.east.atlanta`.
+	//
+	//     The `*` wildcard can be used multiple times in a subject, such as
+	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
+	//     `time.us.east.atlanta`.
+	//   - `>` matches one or more tokens at the tail of a subject, and can
+	//     only be used as the last token. Such as `time.us.>` will match
+	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
+	//
+	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+}
+
+func (x *EnsureStreamRequest_Subjects) Reset() {
+	*x = EnsureStreamRequest_Subjects{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureStreamRequest_Subjects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureStreamRequest_Subjects) ProtoMessage() {}
+
+func (x *EnsureStreamRequest_Subjects) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureStreamRequest_Subjects.ProtoReflect.Descriptor instead.
+func (*EnsureStreamRequest_Subjects) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *EnsureStreamRequest_Subjects) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+// Source describing mirroring/aggregation of events from another stream
+// into this stream.
+type EnsureStreamRequest_StreamSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stream to receive events from. The stream must already exist.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optionally define where to start receiving events from.
+	//
+	// Defaults to the start of stream if not provided.
+	From *StreamPointer `protobuf:"bytes,2,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// Optionally filter events by subject. Subjects are case-sensitive and
+	// should only contain the following characters:
+	//
+	//   - `a` to `z`, `A` to `Z` and `0` to `9` are allowed.
+	//
+	//   - `_` and `-` are allowed for separating words, but the use of
+	//     camelCase is recommended.
+	//
+	//   - `.` is allowed and used as a hierarchy separator, such as
+	//     `time.us.east` and `time.eu.sweden`, which share the `time`
+	//     prefix.
+	//
+	//   - `*` matches a single token, at any level of the subject. Such as
+	//     `time.*.east` will match `time.us.east` and `time.eu.east` but
+	//     not `time.us.west` or `time.us.central.east`. Similarly `time.us.*`
+	//     will match `time.us.east` but not `time.us.east.atlanta`.
+	//
+	//     The `*` wildcard can be used multiple times in a subject, such as
+	//     `time.*.*` will match `time.us.east` and `time.eu.west` but not
+	//     `time.us.east.atlanta`.
+	//   - `>` matches one or more tokens at the tail of a subject, and can
+	//     only be used as the last token. Such as `time.us.>` will match
+	//     `time.us.east` and `time.us.east.atlanta` but not `time.eu.east`.
+	//
+	// See NATS concepts: https://docs.nats.io/nats-concepts/subjects
+	FilterSubjects []string `protobuf:"bytes,3,rep,name=filter_subjects,json=filterSubjects,proto3" json:"filter_subjects,omitempty"`
+}
+
+func (x *EnsureStreamRequest_StreamSource) Reset() {
+	*x = EnsureStreamRequest_StreamSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureStreamRequest_StreamSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureStreamRequest_StreamSource) ProtoMessage() {}
+
+func (x *EnsureStreamRequest_StreamSource) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureStreamRequest_StreamSource.ProtoReflect.Descriptor instead.
+func (*EnsureStreamRequest_StreamSource) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *EnsureStreamRequest_StreamSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnsureStreamRequest_StreamSource) GetFrom() *StreamPointer {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *EnsureStreamRequest_StreamSource) GetFilterSubjects() []string {
+	if x != nil {
+		return x.FilterSubjects
+	}
+	return nil
+}
+
+// Multiple streams that can be mirrored into the stream.
+type EnsureStreamRequest_StreamSources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The streams to receive events from.
+	Sources []*EnsureStreamRequest_StreamSource `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *EnsureStreamRequest_StreamSources) Reset() {
+	*x = EnsureStreamRequest_StreamSources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureStreamRequest_StreamSources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureStreamRequest_StreamSources) ProtoMessage() {}
+
+func (x *EnsureStreamRequest_StreamSources) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureStreamRequest_StreamSources.ProtoReflect.Descriptor instead.
+func (*EnsureStreamRequest_StreamSources) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *EnsureStreamRequest_StreamSources) GetSources() []*EnsureStreamRequest_StreamSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+// Define how the stream should be stored.
+type EnsureStreamRequest_Storage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of storage to use for the stream.
+	//
+	// Defaults to file based storage if not provided.
+	Type *EnsureStreamRequest_StorageType `protobuf:"varint,1,opt,name=type,proto3,enum=windshift.events.v1alpha1.EnsureStreamRequest_StorageType,oneof" json:"type,omitempty"`
+	// Number of replicas to use for the stream.
+	//
+	// Defaults to 1 if not provided.
+	Replicas *uint32 `protobuf:"varint,2,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+}
+
+func (x *EnsureStreamRequest_Storage) Reset() {
+	*x = EnsureStreamRequest_Storage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureStreamRequest_Storage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureStreamRequest_Storage) ProtoMessage() {}
+
+func (x *EnsureStreamRequest_Storage) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureStreamRequest_Storage.ProtoReflect.Descriptor instead.
+func (*EnsureStreamRequest_Storage) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *EnsureStreamRequest_Storage) GetType() EnsureStreamRequest_StorageType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return EnsureStreamRequest_STORAGE_TYPE_UNSPECIFIED
+}
+
+func (x *EnsureStreamRequest_Storage) GetReplicas() uint32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+// State of the stream.
+type StreamInfo_State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of events currently stored in the stream.
+	Events uint64 `protobuf:"varint,1,opt,name=events,proto3" json:"events,omitempty"`
+	// Number of bytes currently stored in the stream.
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// The id of the first event in the stream.
+	FirstId uint64 `protobuf:"varint,3,opt,name=first_id,json=firstId,proto3" json:"first_id,omitempty"`
+	// Timestamp of when the first event was stored.
+	FirstTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=first_timestamp,json=firstTimestamp,proto3,oneof" json:"first_timestamp,omitempty"`
+	// The id of the last event in the stream.
+	LastId uint64 `protobuf:"varint,5,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// Timestamp of when the last event was stored.
+	LastTimestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_timestamp,json=lastTimestamp,proto3,oneof" json:"last_timestamp,omitempty"`
+	// Number of consumers of the stream.
+	Consumers uint32 `protobuf:"varint,7,opt,name=consumers,proto3" json:"consumers,omitempty"`
+}
+
+func (x *StreamInfo_State) Reset() {
+	*x = StreamInfo_State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInfo_State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo_State) ProtoMessage() {}
+
+func (x *StreamInfo_State) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo_State.ProtoReflect.Descriptor instead.
+func (*StreamInfo_State) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *StreamInfo_State) GetEvents() uint64 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *StreamInfo_State) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StreamInfo_State) GetFirstId() uint64 {
+	if x != nil {
+		return x.FirstId
+	}
+	return 0
+}
+
+func (x *StreamInfo_State) GetFirstTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return nil
+}
+
+func (x *StreamInfo_State) GetLastId() uint64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *StreamInfo_State) GetLastTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+func (x *StreamInfo_State) GetConsumers() uint32 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+// Backoff used when redelivering events that failed processing.
+type EnsureConsumerRequest_RedeliveryBackoff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Policy:
+	//
+	//	*EnsureConsumerRequest_RedeliveryBackoff_Explicit
+	//	*EnsureConsumerRequest_RedeliveryBackoff_Exponential_
+	Policy isEnsureConsumerRequest_RedeliveryBackoff_Policy `protobuf_oneof:"policy"`
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff) Reset() {
+	*x = EnsureConsumerRequest_RedeliveryBackoff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureConsumerRequest_RedeliveryBackoff) ProtoMessage() {}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureConsumerRequest_RedeliveryBackoff.ProtoReflect.Descriptor instead.
+func (*EnsureConsumerRequest_RedeliveryBackoff) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (m *EnsureConsumerRequest_RedeliveryBackoff) GetPolicy() isEnsureConsumerRequest_RedeliveryBackoff_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff) GetExplicit() *EnsureConsumerRequest_RedeliveryBackoff_Delays {
+	if x, ok := x.GetPolicy().(*EnsureConsumerRequest_RedeliveryBackoff_Explicit); ok {
+		return x.Explicit
+	}
+	return nil
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff) GetExponential() *EnsureConsumerRequest_RedeliveryBackoff_Exponential {
+	if x, ok := x.GetPolicy().(*EnsureConsumerRequest_RedeliveryBackoff_Exponential_); ok {
+		return x.Exponential
+	}
+	return nil
+}
+
+type isEnsureConsumerRequest_RedeliveryBackoff_Policy interface {
+	isEnsureConsumerRequest_RedeliveryBackoff_Policy()
+}
+
+type EnsureConsumerRequest_RedeliveryBackoff_Explicit struct {
+	// Use an explicit list of delays.
+	Explicit *EnsureConsumerRequest_RedeliveryBackoff_Delays `protobuf:"bytes,1,opt,name=explicit,proto3,oneof"`
+}
+
+type EnsureConsumerRequest_RedeliveryBackoff_Exponential_ struct {
+	// Use delays that grow exponentially.
+	Exponential *EnsureConsumerRequest_RedeliveryBackoff_Exponential `protobuf:"bytes,2,opt,name=exponential,proto3,oneof"`
+}
+
+func (*EnsureConsumerRequest_RedeliveryBackoff_Explicit) isEnsureConsumerRequest_RedeliveryBackoff_Policy() {
+}
+
+func (*EnsureConsumerRequest_RedeliveryBackoff_Exponential_) isEnsureConsumerRequest_RedeliveryBackoff_Policy() {
+}
+
+// Explicit list of delays.
+type EnsureConsumerRequest_RedeliveryBackoff_Delays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The delay before each redelivery, the first delay is used for
+	// the first redelivery and so on. The last delay is used for all
+	// further redeliveries.
+	Delays []*durationpb.Duration `protobuf:"bytes,1,rep,name=delays,proto3" json:"delays,omitempty"`
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Delays) Reset() {
+	*x = EnsureConsumerRequest_RedeliveryBackoff_Delays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Delays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureConsumerRequest_RedeliveryBackoff_Delays) ProtoMessage() {}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Delays) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureConsumerRequest_RedeliveryBackoff_Delays.ProtoReflect.Descriptor instead.
+func (*EnsureConsumerRequest_RedeliveryBackoff_Delays) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 0, 0}
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Delays) GetDelays() []*durationpb.Duration {
+	if x != nil {
+		return x.Delays
+	}
+	return nil
+}
+
+// Delays that grow exponentially.
+type EnsureConsumerRequest_RedeliveryBackoff_Exponential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The delay before the first redelivery.
+	InitialDelay *durationpb.Duration `protobuf:"bytes,1,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	// The maximum delay between redeliveries.
+	//
+	// No default, if not provided the delay keeps growing up to 32
+	// redeliveries.
+	MaxDelay *durationpb.Duration `protobuf:"bytes,2,opt,name=max_delay,json=maxDelay,proto3,oneof" json:"max_delay,omitempty"`
+	// The factor the delay is multiplied with for each redelivery.
+	//
+	// Defaults to 2 if not provided.
+	Multiplier *float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3,oneof" json:"multiplier,omitempty"`
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) Reset() {
+	*x = EnsureConsumerRequest_RedeliveryBackoff_Exponential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnsureConsumerRequest_RedeliveryBackoff_Exponential) ProtoMessage() {}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnsureConsumerRequest_RedeliveryBackoff_Exponential.ProtoReflect.Descriptor instead.
+func (*EnsureConsumerRequest_RedeliveryBackoff_Exponential) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{9, 0, 1}
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) GetInitialDelay() *durationpb.Duration {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return nil
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+func (x *EnsureConsumerRequest_RedeliveryBackoff_Exponential) GetMultiplier() float64 {
+	if x != nil && x.Multiplier != nil {
+		return *x.Multiplier
+	}
+	return 0
+}
+
+// State of the consumer.
+type ConsumerInfo_State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of events in the stream that match the subjects of the
+	// consumer and have not been delivered yet.
+	Pending uint64 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	// Number of events that have been delivered but not yet acknowledged
+	// or rejected.
+	AckPending uint64 `protobuf:"varint,2,opt,name=ack_pending,json=ackPending,proto3" json:"ack_pending,omitempty"`
+	// Number of events that have been delivered more than once and are
+	// still waiting to be acknowledged.
+	Redelivered uint64 `protobuf:"varint,3,opt,name=redelivered,proto3" json:"redelivered,omitempty"`
+	// The id of the last event delivered to the consumer.
+	LastDeliveredId uint64 `protobuf:"varint,4,opt,name=last_delivered_id,json=lastDeliveredId,proto3" json:"last_delivered_id,omitempty"`
+	// Timestamp of when the last event was delivered.
+	LastDeliveredTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_delivered_timestamp,json=lastDeliveredTimestamp,proto3,oneof" json:"last_delivered_timestamp,omitempty"`
+	// The id of the event up to which all events have been acknowledged.
+	AckFloorId uint64 `protobuf:"varint,6,opt,name=ack_floor_id,json=ackFloorId,proto3" json:"ack_floor_id,omitempty"`
+}
+
+func (x *ConsumerInfo_State) Reset() {
+	*x = ConsumerInfo_State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerInfo_State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerInfo_State) ProtoMessage() {}
+
+func (x *ConsumerInfo_State) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerInfo_State.ProtoReflect.Descriptor instead.
+func (*ConsumerInfo_State) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ConsumerInfo_State) GetPending() uint64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ConsumerInfo_State) GetAckPending() uint64 {
+	if x != nil {
+		return x.AckPending
+	}
+	return 0
+}
+
+func (x *ConsumerInfo_State) GetRedelivered() uint64 {
+	if x != nil {
+		return x.Redelivered
+	}
+	return 0
+}
+
+func (x *ConsumerInfo_State) GetLastDeliveredId() uint64 {
+	if x != nil {
+		return x.LastDeliveredId
+	}
+	return 0
+}
+
+func (x *ConsumerInfo_State) GetLastDeliveredTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDeliveredTimestamp
+	}
+	return nil
+}
+
+func (x *ConsumerInfo_State) GetAckFloorId() uint64 {
+	if x != nil {
+		return x.AckFloorId
+	}
+	return 0
+}
+
+// Subscribe to events, must be sent as the first message in the stream.
+type EventsRequest_Subscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the stream to subscribe to. The stream must already
+	// exist.
+	Stream string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The name of the consumer, should be an name previously created using
+	// EnsureConsumer.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// The maximum number of events to process at once.
+	//
+	// In most cases this should be set to approximately the number of
+	// events that can be processed in parallel plus a small buffer. The
+	// server will ramp up events to this limit, and will only send more
+	// events once the client has acknowledged or rejected events.
+	//
+	// Defaults to 50 if not provided.
+	MaxProcessingEvents *uint64 `protobuf:"varint,4,opt,name=max_processing_events,json=maxProcessingEvents,proto3,oneof" json:"max_processing_events,omitempty"`
+	// The encoding to use for the data of events.
+	//
+	// Defaults to Protobuf if not provided.
+	Encoding *Encoding `protobuf:"varint,5,opt,name=encoding,proto3,enum=windshift.events.v1alpha1.Encoding,oneof" json:"encoding,omitempty"`
+	// Deliver events as CloudEvents. The encoding is used for the data of
+	// the CloudEvents.
+	//
+	// Defaults to not using CloudEvents if not provided.
+	CloudEvents *CloudEventsMode `protobuf:"varint,6,opt,name=cloud_events,json=cloudEvents,proto3,enum=windshift.events.v1alpha1.CloudEventsMode,oneof" json:"cloud_events,omitempty"`
+}
+
+func (x *EventsRequest_Subscribe) Reset() {
+	*x = EventsRequest_Subscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest_Subscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest_Subscribe) ProtoMessage() {}
+
+func (x *EventsRequest_Subscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest_Subscribe.ProtoReflect.Descriptor instead.
+func (*EventsRequest_Subscribe) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{40, 0}
+}
+
+func (x *EventsRequest_Subscribe) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *EventsRequest_Subscribe) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *EventsRequest_Subscribe) GetMaxProcessingEvents() uint64 {
+	if x != nil && x.MaxProcessingEvents != nil {
+		return *x.MaxProcessingEvents
+	}
+	return 0
+}
+
+func (x *EventsRequest_Subscribe) GetEncoding() Encoding {
+	if x != nil && x.Encoding != nil {
+		return *x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *EventsRequest_Subscribe) GetCloudEvents() CloudEventsMode {
+	if x != nil && x.CloudEvents != nil {
+		return *x.CloudEvents
+	}
+	return CloudEventsMode_CLOUD_EVENTS_MODE_UNSPECIFIED
+}
+
+// Ack indicates that some events have been successfully processed.
+type EventsRequest_Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers to acknowledge.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *EventsRequest_Ack) Reset() {
+	*x = EventsRequest_Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest_Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest_Ack) ProtoMessage() {}
+
+func (x *EventsRequest_Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest_Ack.ProtoReflect.Descriptor instead.
+func (*EventsRequest_Ack) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{40, 1}
+}
+
+func (x *EventsRequest_Ack) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Reject indicates that some events have failed to be processed.
+type EventsRequest_Reject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers to reject.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Permanently reject the events, if not provided the events will be
+	// retried after a timeout. If permanently is set to true, the events
+	// will not be redelivered.
+	//
+	// Can not be combined with delay.
+	Permanently *bool `protobuf:"varint,2,opt,name=permanently,proto3,oneof" json:"permanently,omitempty"`
+	// Optional time to wait before redelivering the events. If not
+	// provided the server will decide how long to wait.
+	//
+	// Can not be combined with permanently.
+	Delay *durationpb.Duration `protobuf:"bytes,3,opt,name=delay,proto3,oneof" json:"delay,omitempty"`
+	// Human readable reason for why the events failed. Recorded in the
+	// trace of the events, the failure log of the consumer and in dead
+	// letters.
+	//
+	// No failure is recorded if not provided. At most 4096 characters
+	// and can not contain line breaks, the stream fails with
+	// INVALID_ARGUMENT otherwise.
+	Reason *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// Error code of the failure, for grouping similar failures. Only used
+	// if a reason is provided. At most 4096 characters and can not
+	// contain line breaks.
+	Code *string `protobuf:"bytes,5,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	// Additional details about the failure. Only used if a reason is
+	// provided. At most 64 details with a combined size of 16384
+	// characters.
+	Details map[string]string `protobuf:"bytes,6,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *EventsRequest_Reject) Reset() {
+	*x = EventsRequest_Reject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest_Reject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest_Reject) ProtoMessage() {}
+
+func (x *EventsRequest_Reject) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest_Reject.ProtoReflect.Descriptor instead.
+func (*EventsRequest_Reject) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{40, 2}
+}
+
+func (x *EventsRequest_Reject) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *EventsRequest_Reject) GetPermanently() bool {
+	if x != nil && x.Permanently != nil {
+		return *x.Permanently
+	}
+	return false
+}
+
+func (x *EventsRequest_Reject) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *EventsRequest_Reject) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *EventsRequest_Reject) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *EventsRequest_Reject) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Ping events to indicate that they are still being processed. Pings should
+// be sent regularly to indicate that the events are still being processed.
+// It is recommended to send pings at half the interval of the processing
+// timeout.
+type EventsRequest_Ping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers of the events that are currently being processed.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *EventsRequest_Ping) Reset() {
+	*x = EventsRequest_Ping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest_Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest_Ping) ProtoMessage() {}
+
+func (x *EventsRequest_Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest_Ping.ProtoReflect.Descriptor instead.
+func (*EventsRequest_Ping) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{40, 3}
+}
+
+func (x *EventsRequest_Ping) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Confirmation that the client has subscribed to events.
+type EventsResponse_Subscribed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The timeout for events, after which they will be resent if not
+	// acknowledged or rejected. Can be used by the client to implements
+	// features such as pings.
+	ProcessingTimeout *durationpb.Duration `protobuf:"bytes,1,opt,name=processing_timeout,json=processingTimeout,proto3" json:"processing_timeout,omitempty"`
+}
+
+func (x *EventsResponse_Subscribed) Reset() {
+	*x = EventsResponse_Subscribed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse_Subscribed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse_Subscribed) ProtoMessage() {}
+
+func (x *EventsResponse_Subscribed) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse_Subscribed.ProtoReflect.Descriptor instead.
+func (*EventsResponse_Subscribed) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *EventsResponse_Subscribed) GetProcessingTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProcessingTimeout
+	}
+	return nil
+}
+
+// Confirmation that some events were acknowledged.
+type EventsResponse_AckConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers that were acknowledged.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Identifiers that were invalid, such as already being acknowledged or
+	// rejected, should not be retried by the client.
+	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
+	// Identifiers that could not be processed temporarily, should be
+	// retried by the client.
+	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+}
+
+func (x *EventsResponse_AckConfirmation) Reset() {
+	*x = EventsResponse_AckConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse_AckConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse_AckConfirmation) ProtoMessage() {}
+
+func (x *EventsResponse_AckConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse_AckConfirmation.ProtoReflect.Descriptor instead.
+func (*EventsResponse_AckConfirmation) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *EventsResponse_AckConfirmation) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *EventsResponse_AckConfirmation) GetInvalidIds() []uint64 {
+	if x != nil {
+		return x.InvalidIds
+	}
+	return nil
+}
+
+func (x *EventsResponse_AckConfirmation) GetTemporaryFailedIds() []uint64 {
+	if x != nil {
+		return x.TemporaryFailedIds
+	}
+	return nil
+}
+
+// Confirmation that some events were rejected.
+type EventsResponse_RejectConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers that were rejected.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Identifiers that were invalid, such as already being acknowledged or
+	// rejected, should not be retried by the client.
+	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
+	// Identifiers that could not be processed temporarily, should be
+	// retried by the client.
+	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+}
+
+func (x *EventsResponse_RejectConfirmation) Reset() {
+	*x = EventsResponse_RejectConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse_RejectConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse_RejectConfirmation) ProtoMessage() {}
+
+func (x *EventsResponse_RejectConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse_RejectConfirmation.ProtoReflect.Descriptor instead.
+func (*EventsResponse_RejectConfirmation) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{41, 2}
+}
+
+func (x *EventsResponse_RejectConfirmation) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *EventsResponse_RejectConfirmation) GetInvalidIds() []uint64 {
+	if x != nil {
+		return x.InvalidIds
+	}
+	return nil
+}
+
+func (x *EventsResponse_RejectConfirmation) GetTemporaryFailedIds() []uint64 {
+	if x != nil {
+		return x.TemporaryFailedIds
+	}
+	return nil
+}
+
+// Confirmation that a ping was received.
+type EventsResponse_PingConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifiers of the events that are currently being processed.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Identifiers that were invalid, such as already being acknowledged or
+	// rejected, should not be retried by the client.
+	InvalidIds []uint64 `protobuf:"varint,2,rep,packed,name=invalid_ids,json=invalidIds,proto3" json:"invalid_ids,omitempty"`
+	// Identifiers that could not be processed temporarily, should be
+	// retried by the client.
+	TemporaryFailedIds []uint64 `protobuf:"varint,3,rep,packed,name=temporary_failed_ids,json=temporaryFailedIds,proto3" json:"temporary_failed_ids,omitempty"`
+}
+
+func (x *EventsResponse_PingConfirmation) Reset() {
+	*x = EventsResponse_PingConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsResponse_PingConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse_PingConfirmation) ProtoMessage() {}
+
+func (x *EventsResponse_PingConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse_PingConfirmation.ProtoReflect.Descriptor instead.
+func (*EventsResponse_PingConfirmation) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{41, 3}
+}
+
+func (x *EventsResponse_PingConfirmation) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *EventsResponse_PingConfirmation) GetInvalidIds() []uint64 {
+	if x != nil {
+		return x.InvalidIds
+	}
+	return nil
+}
+
+func (x *EventsResponse_PingConfirmation) GetTemporaryFailedIds() []uint64 {
+	if x != nil {
+		return x.TemporaryFailedIds
+	}
+	return nil
+}
+
+// The value of an attribute.
+type CloudEvent_CloudEventAttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Attr:
+	//
+	//	*CloudEvent_CloudEventAttributeValue_CeBoolean
+	//	*CloudEvent_CloudEventAttributeValue_CeInteger
+	//	*CloudEvent_CloudEventAttributeValue_CeString
+	//	*CloudEvent_CloudEventAttributeValue_CeBytes
+	//	*CloudEvent_CloudEventAttributeValue_CeUri
+	//	*CloudEvent_CloudEventAttributeValue_CeUriRef
+	//	*CloudEvent_CloudEventAttributeValue_CeTimestamp
+	Attr isCloudEvent_CloudEventAttributeValue_Attr `protobuf_oneof:"attr"`
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) Reset() {
+	*x = CloudEvent_CloudEventAttributeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudEvent_CloudEventAttributeValue) ProtoMessage() {}
+
+func (x *CloudEvent_CloudEventAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_events_v1alpha1_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudEvent_CloudEventAttributeValue.ProtoReflect.Descriptor instead.
+func (*CloudEvent_CloudEventAttributeValue) Descriptor() ([]byte, []int) {
+	return file_windshift_events_v1alpha1_service_proto_rawDescGZIP(), []int{56, 1}
+}
+
+func (m *CloudEvent_CloudEventAttributeValue) GetAttr() isCloudEvent_CloudEventAttributeValue_Attr {
+	if m != nil {
+		return m.Attr
+	}
+	return nil
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeBoolean() bool {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeBoolean); ok {
+		return x.CeBoolean
+	}
+	return false
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeInteger() int32 {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeInteger); ok {
+		return x.CeInteger
+	}
+	return 0
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeString() string {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeString); ok {
+		return x.CeString
+	}
+	return ""
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeBytes() []byte {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeBytes); ok {
+		return x.CeBytes
+	}
+	return nil
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeUri() string {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeUri); ok {
+		return x.CeUri
+	}
+	return ""
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeUriRef() string {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeUriRef); ok {
+		return x.CeUriRef
+	}
+	return ""
+}
+
+func (x *CloudEvent_CloudEventAttributeValue) GetCeTimestamp() *timestamppb.Timestamp {
+	if x, ok := x.GetAttr().(*CloudEvent_CloudEventAttributeValue_CeTimestamp); ok {
+		return x.CeTimestamp
+	}
+	return nil
+}
+
+type isCloudEvent_CloudEventAttributeValue_Attr interface {
+	isCloudEvent_CloudEventAttributeValue_Attr()
+}
+
+type CloudEvent_CloudEventAttributeValue_CeBoolean struct {
+	CeBoolean bool `protobuf:"varint,1,opt,name=ce_boolean,json=ceBoolean,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeInteger struct {
+	CeInteger int32 `protobuf:"varint,2,opt,name=ce_integer,json=ceInteger,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeString struct {
+	CeString string `protobuf:"bytes,3,opt,name=ce_string,json=ceString,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeBytes struct {
+	CeBytes []byte `protobuf:"bytes,4,opt,name=ce_bytes,json=ceBytes,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeUri struct {
+	CeUri string `protobuf:"bytes,5,opt,name=ce_uri,json=ceUri,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeUriRef struct {
+	CeUriRef string `protobuf:"bytes,6,opt,name=ce_uri_ref,json=ceUriRef,proto3,oneof"`
+}
+
+type CloudEvent_CloudEventAttributeValue_CeTimestamp struct {
+	CeTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ce_timestamp,json=ceTimestamp,proto3,oneof"`
+}
+
+func (*CloudEvent_CloudEventAttributeValue_CeBoolean) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeInteger) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeString) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeBytes) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeUri) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeUriRef) isCloudEvent_CloudEventAttributeValue_Attr() {}
+
+func (*CloudEvent_CloudEventAttributeValue_CeTimestamp) isCloudEvent_CloudEventAttributeValue_Attr() {
+}
+
+var File_windshift_events_v1alpha1_service_proto protoreflect.FileDescriptor
+
+var file_windshift_events_v1alpha1_service_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb7, 0x0e, 0x0a, 0x13, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6e, 0x0a, 0x10,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x48, 0x02, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x14, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x03, 0x52, 0x13, 0x64, 0x65, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x0c, 0x6d, 0x61,
+	0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x1a, 0xe3, 0x03,
+	0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x38,
+	0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02,
+	0x52, 0x13, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x68, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45,
+	0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x48, 0x04, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x14, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x4e, 0x65, 0x77, 0x50, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x19, 0x0a, 0x17, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x1a, 0x26, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x0c,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x66, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x95, 0x01,
+	0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x01, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x5f, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52,
+	0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52,
+	0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4e, 0x45, 0x57, 0x10, 0x02, 0x22, 0x5b, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
+	0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52,
+	0x59, 0x10, 0x02, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x55, 0x0a, 0x14, 0x45, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x77, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12,
+	0x2b, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x29, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa3, 0x08, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x69, 0x0a, 0x10,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x55, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x55,
+	0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d,
+	0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x1a, 0xc0, 0x02, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x48, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xd7, 0x08, 0x0a, 0x15, 0x45, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x12, 0x41, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x02, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x76, 0x0a, 0x12, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x48, 0x05, 0x52, 0x11, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x88, 0x01, 0x01, 0x1a, 0x86, 0x04, 0x0a, 0x11, 0x52, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x67,
+	0x0a, 0x08, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x49, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x12, 0x72, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x3b, 0x0a, 0x06, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x0b, 0x45, 0x78, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64,
+	0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3c, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe7, 0x06,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x43,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x13, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x53, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x12, 0x72, 0x65, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x11, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x1a, 0xaa, 0x02, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x6b,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x18, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x6b, 0x46, 0x6c, 0x6f, 0x6f, 0x72,
+	0x49, 0x64, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x16, 0x0a, 0x14, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x19, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x81,
+	0x01, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x64, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49,
+	0x64, 0x73, 0x22, 0xbb, 0x04, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x41, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x22, 0x50, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d,
+	0x41, 0x58, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x22, 0xd9, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xca, 0x01, 0x0a,
+	0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x2e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x0d, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e,
+	0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3c, 0x0a,
+	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0xfe, 0x04, 0x0a, 0x13,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x28, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x73,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x88,
+	0x01, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x61, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x5f, 0x0a, 0x14,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x22, 0xcd, 0x02,
+	0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x45,
+	0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02,
+	0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x2b, 0x0a,
+	0x19, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x1b, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x41, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5e, 0x0a, 0x14, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x5b, 0x0a, 0x15, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3f, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xfc, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x40, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8f,
+	0x01, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
+	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x4a, 0x45, 0x43, 0x54,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47,
+	0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x11, 0x0a,
+	0x0d, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x05,
+	0x22, 0xb2, 0x08, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x52, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x40, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63,
+	0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0xca, 0x02, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x01, 0x52, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x02, 0x52, 0x0b, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x17, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x1a, 0xef,
+	0x02, 0x0a, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x56,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74,
+	0x6c, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x1a, 0x18, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb6, 0x07, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x66, 0x0a, 0x10, 0x61, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x41, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0f, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x6f, 0x0a, 0x13, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x3c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x12, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x11, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x70, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x56,
+	0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x12,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x76, 0x0a, 0x0f, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x73, 0x1a, 0x79,
+	0x0a, 0x12, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x64, 0x73, 0x1a, 0x77, 0x0a, 0x10, 0x50, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x64, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x12,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49,
+	0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92,
+	0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x22, 0xfb, 0x02, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a,
+	0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x48, 0x02, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0x4f, 0x0a, 0x13, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x44,
+	0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x01, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xad, 0x04, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x05, 0x65, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x37, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x44, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x06, 0x52, 0x0b, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0x8a, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x81, 0x03,
+	0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x6c,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x01,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x55, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e,
+	0x74, 0x6c, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x22, 0x2b, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x8b,
+	0x01, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72,
+	0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x72, 0x79,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x9d, 0x04, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x28,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x20, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x3f, 0x0a, 0x1b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x18, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x12, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x62,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x0d, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x05, 0x0a,
+	0x0a, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x63, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x09, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x78, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x48, 0x00, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x7d, 0x0a, 0x0f, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x54, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x9a, 0x02, 0x0a, 0x18, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6c, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65,
+	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x65, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63,
+	0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x08, 0x63, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x63, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63, 0x65, 0x55, 0x72, 0x69, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x65, 0x55, 0x72, 0x69, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a,
+	0x0c, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0b, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06,
+	0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb6,
+	0x01, 0x0a, 0x10, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3a, 0x0a, 0x0c, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf7, 0x02, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x2a, 0x9b, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x4f, 0x55,
+	0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x43, 0x4c,
+	0x4f, 0x55, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x53, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x2a,
+	0x4e, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x14, 0x45,
+	0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x42, 0x55, 0x46, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x32,
+	0xeb, 0x15, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x45, 0x6e, 0x73,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x77, 0x69,
+	0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x73, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12,
+	0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0d, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6f, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x89,
+	0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x06, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x6e, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x68, 0x0a, 0x09, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0a,
+	0x50, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6b, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x94, 0x02,
+	0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42,
+	0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x66, 0x6f, 0x75, 0x72, 0x61, 0x62, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x57, 0x45, 0x58, 0xaa, 0x02, 0x19, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xca, 0x02, 0x19, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02,
+	0x25, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x3a, 0x3a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_windshift_events_v1alpha1_service_proto_rawDescOnce sync.Once
+	file_windshift_events_v1alpha1_service_proto_rawDescData = file_windshift_events_v1alpha1_service_proto_rawDesc
+)
+
+func file_windshift_events_v1alpha1_service_proto_rawDescGZIP() []byte {
+	file_windshift_events_v1alpha1_service_proto_rawDescOnce.Do(func() {
+		file_windshift_events_v1alpha1_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_windshift_events_v1alpha1_service_proto_rawDescData)
+	})
+	return file_windshift_events_v1alpha1_service_proto_rawDescData
+}
+
+var file_windshift_events_v1alpha1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_windshift_events_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_windshift_events_v1alpha1_service_proto_goTypes = []interface{}{
+	(CloudEventsMode)(0),                                        // 0: windshift.events.v1alpha1.CloudEventsMode
+	(Encoding)(0),                                               // 1: windshift.events.v1alpha1.Encoding
+	(EnsureStreamRequest_DiscardPolicy)(0),                      // 2: windshift.events.v1alpha1.EnsureStreamRequest.DiscardPolicy
+	(EnsureStreamRequest_StorageType)(0),                        // 3: windshift.events.v1alpha1.EnsureStreamRequest.StorageType
+	(DeadLetter_Reason)(0),                                      // 4: windshift.events.v1alpha1.DeadLetter.Reason
+	(PublishError_Code)(0),                                      // 5: windshift.events.v1alpha1.PublishError.Code
+	(*EnsureStreamRequest)(nil),                                 // 6: windshift.events.v1alpha1.EnsureStreamRequest
+	(*EnsureStreamResponse)(nil),                                // 7: windshift.events.v1alpha1.EnsureStreamResponse
+	(*GetStreamRequest)(nil),                                    // 8: windshift.events.v1alpha1.GetStreamRequest
+	(*GetStreamResponse)(nil),                                   // 9: windshift.events.v1alpha1.GetStreamResponse
+	(*ListStreamsRequest)(nil),                                  // 10: windshift.events.v1alpha1.ListStreamsRequest
+	(*ListStreamsResponse)(nil),                                 // 11: windshift.events.v1alpha1.ListStreamsResponse
+	(*DeleteStreamRequest)(nil),                                 // 12: windshift.events.v1alpha1.DeleteStreamRequest
+	(*DeleteStreamResponse)(nil),                                // 13: windshift.events.v1alpha1.DeleteStreamResponse
+	(*StreamInfo)(nil),                                          // 14: windshift.events.v1alpha1.StreamInfo
+	(*EnsureConsumerRequest)(nil),                               // 15: windshift.events.v1alpha1.EnsureConsumerRequest
+	(*EnsureConsumerResponse)(nil),                              // 16: windshift.events.v1alpha1.EnsureConsumerResponse
+	(*GetConsumerRequest)(nil),                                  // 17: windshift.events.v1alpha1.GetConsumerRequest
+	(*GetConsumerResponse)(nil),                                 // 18: windshift.events.v1alpha1.GetConsumerResponse
+	(*ListConsumersRequest)(nil),                                // 19: windshift.events.v1alpha1.ListConsumersRequest
+	(*ListConsumersResponse)(nil),                               // 20: windshift.events.v1alpha1.ListConsumersResponse
+	(*ConsumerInfo)(nil),                                        // 21: windshift.events.v1alpha1.ConsumerInfo
+	(*DeleteConsumerRequest)(nil),                               // 22: windshift.events.v1alpha1.DeleteConsumerRequest
+	(*DeleteConsumerResponse)(nil),                              // 23: windshift.events.v1alpha1.DeleteConsumerResponse
+	(*ListDeadLettersRequest)(nil),                              // 24: windshift.events.v1alpha1.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),                             // 25: windshift.events.v1alpha1.ListDeadLettersResponse
+	(*RequeueDeadLettersRequest)(nil),                           // 26: windshift.events.v1alpha1.RequeueDeadLettersRequest
+	(*RequeueDeadLettersResponse)(nil),                          // 27: windshift.events.v1alpha1.RequeueDeadLettersResponse
+	(*DeadLetter)(nil),                                          // 28: windshift.events.v1alpha1.DeadLetter
+	(*ListFailuresRequest)(nil),                                 // 29: windshift.events.v1alpha1.ListFailuresRequest
+	(*ListFailuresResponse)(nil),                                // 30: windshift.events.v1alpha1.ListFailuresResponse
+	(*Failure)(nil),                                             // 31: windshift.events.v1alpha1.Failure
+	(*FailureRecord)(nil),                                       // 32: windshift.events.v1alpha1.FailureRecord
+	(*PublishEventRequest)(nil),                                 // 33: windshift.events.v1alpha1.PublishEventRequest
+	(*PublishEventResponse)(nil),                                // 34: windshift.events.v1alpha1.PublishEventResponse
+	(*PublishCloudEventRequest)(nil),                            // 35: windshift.events.v1alpha1.PublishCloudEventRequest
+	(*PublishCloudEventResponse)(nil),                           // 36: windshift.events.v1alpha1.PublishCloudEventResponse
+	(*ListScheduledEventsRequest)(nil),                          // 37: windshift.events.v1alpha1.ListScheduledEventsRequest
+	(*ListScheduledEventsResponse)(nil),                         // 38: windshift.events.v1alpha1.ListScheduledEventsResponse
+	(*CancelScheduledEventRequest)(nil),                         // 39: windshift.events.v1alpha1.CancelScheduledEventRequest
+	(*CancelScheduledEventResponse)(nil),                        // 40: windshift.events.v1alpha1.CancelScheduledEventResponse
+	(*ScheduledEvent)(nil),                                      // 41: windshift.events.v1alpha1.ScheduledEvent
+	(*PublishEventsRequest)(nil),                                // 42: windshift.events.v1alpha1.PublishEventsRequest
+	(*PublishEventsResponse)(nil),                               // 43: windshift.events.v1alpha1.PublishEventsResponse
+	(*PublishResult)(nil),                                       // 44: windshift.events.v1alpha1.PublishResult
+	(*PublishError)(nil),                                        // 45: windshift.events.v1alpha1.PublishError
+	(*EventsRequest)(nil),                                       // 46: windshift.events.v1alpha1.EventsRequest
+	(*EventsResponse)(nil),                                      // 47: windshift.events.v1alpha1.EventsResponse
+	(*StreamPointer)(nil),                                       // 48: windshift.events.v1alpha1.StreamPointer
+	(*FetchEventsRequest)(nil),                                  // 49: windshift.events.v1alpha1.FetchEventsRequest
+	(*FetchEventsResponse)(nil),                                 // 50: windshift.events.v1alpha1.FetchEventsResponse
+	(*GetEventRequest)(nil),                                     // 51: windshift.events.v1alpha1.GetEventRequest
+	(*GetEventResponse)(nil),                                    // 52: windshift.events.v1alpha1.GetEventResponse
+	(*ReadEventsRequest)(nil),                                   // 53: windshift.events.v1alpha1.ReadEventsRequest
+	(*ReadEventsResponse)(nil),                                  // 54: windshift.events.v1alpha1.ReadEventsResponse
+	(*AckEventsRequest)(nil),                                    // 55: windshift.events.v1alpha1.AckEventsRequest
+	(*AckEventsResponse)(nil),                                   // 56: windshift.events.v1alpha1.AckEventsResponse
+	(*RejectEventsRequest)(nil),                                 // 57: windshift.events.v1alpha1.RejectEventsRequest
+	(*RejectEventsResponse)(nil),                                // 58: windshift.events.v1alpha1.RejectEventsResponse
+	(*PingEventsRequest)(nil),                                   // 59: windshift.events.v1alpha1.PingEventsRequest
+	(*PingEventsResponse)(nil),                                  // 60: windshift.events.v1alpha1.PingEventsResponse
+	(*Event)(nil),                                               // 61: windshift.events.v1alpha1.Event
+	(*CloudEvent)(nil),                                          // 62: windshift.events.v1alpha1.CloudEvent
+	(*BinaryCloudEvent)(nil),                                    // 63: windshift.events.v1alpha1.BinaryCloudEvent
+	(*Headers)(nil),                                             // 64: windshift.events.v1alpha1.Headers
+	(*EnsureStreamRequest_RetentionPolicy)(nil),                 // 65: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy
+	(*EnsureStreamRequest_Subjects)(nil),                        // 66: windshift.events.v1alpha1.EnsureStreamRequest.Subjects
+	(*EnsureStreamRequest_StreamSource)(nil),                    // 67: windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
+	(*EnsureStreamRequest_StreamSources)(nil),                   // 68: windshift.events.v1alpha1.EnsureStreamRequest.StreamSources
+	(*EnsureStreamRequest_Storage)(nil),                         // 69: windshift.events.v1alpha1.EnsureStreamRequest.Storage
+	(*StreamInfo_State)(nil),                                    // 70: windshift.events.v1alpha1.StreamInfo.State
+	(*EnsureConsumerRequest_RedeliveryBackoff)(nil),             // 71: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff
+	(*EnsureConsumerRequest_RedeliveryBackoff_Delays)(nil),      // 72: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Delays
+	(*EnsureConsumerRequest_RedeliveryBackoff_Exponential)(nil), // 73: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Exponential
+	(*ConsumerInfo_State)(nil),                                  // 74: windshift.events.v1alpha1.ConsumerInfo.State
+	nil,                                                         // 75: windshift.events.v1alpha1.Failure.DetailsEntry
+	nil,                                                         // 76: windshift.events.v1alpha1.PublishEventRequest.HeadersEntry
+	(*EventsRequest_Subscribe)(nil),                             // 77: windshift.events.v1alpha1.EventsRequest.Subscribe
+	(*EventsRequest_Ack)(nil),                                   // 78: windshift.events.v1alpha1.EventsRequest.Ack
+	(*EventsRequest_Reject)(nil),                                // 79: windshift.events.v1alpha1.EventsRequest.Reject
+	(*EventsRequest_Ping)(nil),                                  // 80: windshift.events.v1alpha1.EventsRequest.Ping
+	nil,                                                         // 81: windshift.events.v1alpha1.EventsRequest.Reject.DetailsEntry
+	(*EventsResponse_Subscribed)(nil),                           // 82: windshift.events.v1alpha1.EventsResponse.Subscribed
+	(*EventsResponse_AckConfirmation)(nil),                      // 83: windshift.events.v1alpha1.EventsResponse.AckConfirmation
+	(*EventsResponse_RejectConfirmation)(nil),                   // 84: windshift.events.v1alpha1.EventsResponse.RejectConfirmation
+	(*EventsResponse_PingConfirmation)(nil),                     // 85: windshift.events.v1alpha1.EventsResponse.PingConfirmation
+	nil,                                                         // 86: windshift.events.v1alpha1.RejectEventsRequest.DetailsEntry
+	nil,                                                         // 87: windshift.events.v1alpha1.CloudEvent.AttributesEntry
+	(*CloudEvent_CloudEventAttributeValue)(nil),                 // 88: windshift.events.v1alpha1.CloudEvent.CloudEventAttributeValue
+	nil,                           // 89: windshift.events.v1alpha1.BinaryCloudEvent.HeadersEntry
+	nil,                           // 90: windshift.events.v1alpha1.Headers.CustomEntry
+	(*durationpb.Duration)(nil),   // 91: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 92: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 93: google.protobuf.Any
+}
+var file_windshift_events_v1alpha1_service_proto_depIdxs = []int32{
+	65,  // 0: windshift.events.v1alpha1.EnsureStreamRequest.retention_policy:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy
+	66,  // 1: windshift.events.v1alpha1.EnsureStreamRequest.subjects:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Subjects
+	67,  // 2: windshift.events.v1alpha1.EnsureStreamRequest.mirror:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
+	68,  // 3: windshift.events.v1alpha1.EnsureStreamRequest.aggregate:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSources
+	69,  // 4: windshift.events.v1alpha1.EnsureStreamRequest.storage:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Storage
+	91,  // 5: windshift.events.v1alpha1.EnsureStreamRequest.deduplication_window:type_name -> google.protobuf.Duration
+	14,  // 6: windshift.events.v1alpha1.EnsureStreamResponse.stream:type_name -> windshift.events.v1alpha1.StreamInfo
+	14,  // 7: windshift.events.v1alpha1.GetStreamResponse.stream:type_name -> windshift.events.v1alpha1.StreamInfo
+	14,  // 8: windshift.events.v1alpha1.ListStreamsResponse.streams:type_name -> windshift.events.v1alpha1.StreamInfo
+	92,  // 9: windshift.events.v1alpha1.StreamInfo.created:type_name -> google.protobuf.Timestamp
+	65,  // 10: windshift.events.v1alpha1.StreamInfo.retention_policy:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy
+	66,  // 11: windshift.events.v1alpha1.StreamInfo.subjects:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Subjects
+	67,  // 12: windshift.events.v1alpha1.StreamInfo.mirror:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
+	68,  // 13: windshift.events.v1alpha1.StreamInfo.aggregate:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSources
+	69,  // 14: windshift.events.v1alpha1.StreamInfo.storage:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.Storage
+	91,  // 15: windshift.events.v1alpha1.StreamInfo.deduplication_window:type_name -> google.protobuf.Duration
+	70,  // 16: windshift.events.v1alpha1.StreamInfo.state:type_name -> windshift.events.v1alpha1.StreamInfo.State
+	48,  // 17: windshift.events.v1alpha1.EnsureConsumerRequest.from:type_name -> windshift.events.v1alpha1.StreamPointer
+	91,  // 18: windshift.events.v1alpha1.EnsureConsumerRequest.processing_timeout:type_name -> google.protobuf.Duration
+	71,  // 19: windshift.events.v1alpha1.EnsureConsumerRequest.redelivery_backoff:type_name -> windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff
+	21,  // 20: windshift.events.v1alpha1.GetConsumerResponse.consumer:type_name -> windshift.events.v1alpha1.ConsumerInfo
+	21,  // 21: windshift.events.v1alpha1.ListConsumersResponse.consumers:type_name -> windshift.events.v1alpha1.ConsumerInfo
+	92,  // 22: windshift.events.v1alpha1.ConsumerInfo.created:type_name -> google.protobuf.Timestamp
+	48,  // 23: windshift.events.v1alpha1.ConsumerInfo.from:type_name -> windshift.events.v1alpha1.StreamPointer
+	91,  // 24: windshift.events.v1alpha1.ConsumerInfo.processing_timeout:type_name -> google.protobuf.Duration
+	74,  // 25: windshift.events.v1alpha1.ConsumerInfo.state:type_name -> windshift.events.v1alpha1.ConsumerInfo.State
+	91,  // 26: windshift.events.v1alpha1.ConsumerInfo.redelivery_backoff:type_name -> google.protobuf.Duration
+	28,  // 27: windshift.events.v1alpha1.ListDeadLettersResponse.dead_letters:type_name -> windshift.events.v1alpha1.DeadLetter
+	4,   // 28: windshift.events.v1alpha1.DeadLetter.reason:type_name -> windshift.events.v1alpha1.DeadLetter.Reason
+	92,  // 29: windshift.events.v1alpha1.DeadLetter.timestamp:type_name -> google.protobuf.Timestamp
+	64,  // 30: windshift.events.v1alpha1.DeadLetter.headers:type_name -> windshift.events.v1alpha1.Headers
+	93,  // 31: windshift.events.v1alpha1.DeadLetter.data:type_name -> google.protobuf.Any
+	31,  // 32: windshift.events.v1alpha1.DeadLetter.failure:type_name -> windshift.events.v1alpha1.Failure
+	32,  // 33: windshift.events.v1alpha1.ListFailuresResponse.failures:type_name -> windshift.events.v1alpha1.FailureRecord
+	75,  // 34: windshift.events.v1alpha1.Failure.details:type_name -> windshift.events.v1alpha1.Failure.DetailsEntry
+	92,  // 35: windshift.events.v1alpha1.FailureRecord.timestamp:type_name -> google.protobuf.Timestamp
+	31,  // 36: windshift.events.v1alpha1.FailureRecord.failure:type_name -> windshift.events.v1alpha1.Failure
+	93,  // 37: windshift.events.v1alpha1.PublishEventRequest.data:type_name -> google.protobuf.Any
+	92,  // 38: windshift.events.v1alpha1.PublishEventRequest.timestamp:type_name -> google.protobuf.Timestamp
+	76,  // 39: windshift.events.v1alpha1.PublishEventRequest.headers:type_name -> windshift.events.v1alpha1.PublishEventRequest.HeadersEntry
+	92,  // 40: windshift.events.v1alpha1.PublishEventRequest.deliver_at:type_name -> google.protobuf.Timestamp
+	91,  // 41: windshift.events.v1alpha1.PublishEventRequest.delay:type_name -> google.protobuf.Duration
+	62,  // 42: windshift.events.v1alpha1.PublishCloudEventRequest.structured:type_name -> windshift.events.v1alpha1.CloudEvent
+	63,  // 43: windshift.events.v1alpha1.PublishCloudEventRequest.binary:type_name -> windshift.events.v1alpha1.BinaryCloudEvent
+	41,  // 44: windshift.events.v1alpha1.ListScheduledEventsResponse.events:type_name -> windshift.events.v1alpha1.ScheduledEvent
+	92,  // 45: windshift.events.v1alpha1.ScheduledEvent.deliver_at:type_name -> google.protobuf.Timestamp
+	64,  // 46: windshift.events.v1alpha1.ScheduledEvent.headers:type_name -> windshift.events.v1alpha1.Headers
+	93,  // 47: windshift.events.v1alpha1.ScheduledEvent.data:type_name -> google.protobuf.Any
+	33,  // 48: windshift.events.v1alpha1.PublishEventsRequest.events:type_name -> windshift.events.v1alpha1.PublishEventRequest
+	44,  // 49: windshift.events.v1alpha1.PublishEventsResponse.results:type_name -> windshift.events.v1alpha1.PublishResult
+	45,  // 50: windshift.events.v1alpha1.PublishResult.error:type_name -> windshift.events.v1alpha1.PublishError
+	5,   // 51: windshift.events.v1alpha1.PublishError.code:type_name -> windshift.events.v1alpha1.PublishError.Code
+	77,  // 52: windshift.events.v1alpha1.EventsRequest.subscribe:type_name -> windshift.events.v1alpha1.EventsRequest.Subscribe
+	78,  // 53: windshift.events.v1alpha1.EventsRequest.ack:type_name -> windshift.events.v1alpha1.EventsRequest.Ack
+	79,  // 54: windshift.events.v1alpha1.EventsRequest.reject:type_name -> windshift.events.v1alpha1.EventsRequest.Reject
+	80,  // 55: windshift.events.v1alpha1.EventsRequest.ping:type_name -> windshift.events.v1alpha1.EventsRequest.Ping
+	61,  // 56: windshift.events.v1alpha1.EventsResponse.event:type_name -> windshift.events.v1alpha1.Event
+	82,  // 57: windshift.events.v1alpha1.EventsResponse.subscribed:type_name -> windshift.events.v1alpha1.EventsResponse.Subscribed
+	83,  // 58: windshift.events.v1alpha1.EventsResponse.ack_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.AckConfirmation
+	84,  // 59: windshift.events.v1alpha1.EventsResponse.reject_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.RejectConfirmation
+	85,  // 60: windshift.events.v1alpha1.EventsResponse.ping_confirmation:type_name -> windshift.events.v1alpha1.EventsResponse.PingConfirmation
+	92,  // 61: windshift.events.v1alpha1.StreamPointer.time:type_name -> google.protobuf.Timestamp
+	91,  // 62: windshift.events.v1alpha1.FetchEventsRequest.max_wait:type_name -> google.protobuf.Duration
+	1,   // 63: windshift.events.v1alpha1.FetchEventsRequest.encoding:type_name -> windshift.events.v1alpha1.Encoding
+	0,   // 64: windshift.events.v1alpha1.FetchEventsRequest.cloud_events:type_name -> windshift.events.v1alpha1.CloudEventsMode
+	61,  // 65: windshift.events.v1alpha1.FetchEventsResponse.events:type_name -> windshift.events.v1alpha1.Event
+	1,   // 66: windshift.events.v1alpha1.GetEventRequest.encoding:type_name -> windshift.events.v1alpha1.Encoding
+	0,   // 67: windshift.events.v1alpha1.GetEventRequest.cloud_events:type_name -> windshift.events.v1alpha1.CloudEventsMode
+	61,  // 68: windshift.events.v1alpha1.GetEventResponse.event:type_name -> windshift.events.v1alpha1.Event
+	92,  // 69: windshift.events.v1alpha1.ReadEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 70: windshift.events.v1alpha1.ReadEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,   // 71: windshift.events.v1alpha1.ReadEventsRequest.encoding:type_name -> windshift.events.v1alpha1.Encoding
+	0,   // 72: windshift.events.v1alpha1.ReadEventsRequest.cloud_events:type_name -> windshift.events.v1alpha1.CloudEventsMode
+	61,  // 73: windshift.events.v1alpha1.ReadEventsResponse.events:type_name -> windshift.events.v1alpha1.Event
+	91,  // 74: windshift.events.v1alpha1.RejectEventsRequest.delay:type_name -> google.protobuf.Duration
+	86,  // 75: windshift.events.v1alpha1.RejectEventsRequest.details:type_name -> windshift.events.v1alpha1.RejectEventsRequest.DetailsEntry
+	64,  // 76: windshift.events.v1alpha1.Event.headers:type_name -> windshift.events.v1alpha1.Headers
+	93,  // 77: windshift.events.v1alpha1.Event.data:type_name -> google.protobuf.Any
+	62,  // 78: windshift.events.v1alpha1.Event.structured_cloud_event:type_name -> windshift.events.v1alpha1.CloudEvent
+	63,  // 79: windshift.events.v1alpha1.Event.binary_cloud_event:type_name -> windshift.events.v1alpha1.BinaryCloudEvent
+	87,  // 80: windshift.events.v1alpha1.CloudEvent.attributes:type_name -> windshift.events.v1alpha1.CloudEvent.AttributesEntry
+	93,  // 81: windshift.events.v1alpha1.CloudEvent.proto_data:type_name -> google.protobuf.Any
+	89,  // 82: windshift.events.v1alpha1.BinaryCloudEvent.headers:type_name -> windshift.events.v1alpha1.BinaryCloudEvent.HeadersEntry
+	92,  // 83: windshift.events.v1alpha1.Headers.timestamp:type_name -> google.protobuf.Timestamp
+	90,  // 84: windshift.events.v1alpha1.Headers.custom:type_name -> windshift.events.v1alpha1.Headers.CustomEntry
+	91,  // 85: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy.max_age:type_name -> google.protobuf.Duration
+	2,   // 86: windshift.events.v1alpha1.EnsureStreamRequest.RetentionPolicy.discard_policy:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.DiscardPolicy
+	48,  // 87: windshift.events.v1alpha1.EnsureStreamRequest.StreamSource.from:type_name -> windshift.events.v1alpha1.StreamPointer
+	67,  // 88: windshift.events.v1alpha1.EnsureStreamRequest.StreamSources.sources:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StreamSource
+	3,   // 89: windshift.events.v1alpha1.EnsureStreamRequest.Storage.type:type_name -> windshift.events.v1alpha1.EnsureStreamRequest.StorageType
+	92,  // 90: windshift.events.v1alpha1.StreamInfo.State.first_timestamp:type_name -> google.protobuf.Timestamp
+	92,  // 91: windshift.events.v1alpha1.StreamInfo.State.last_timestamp:type_name -> google.protobuf.Timestamp
+	72,  // 92: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.explicit:type_name -> windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Delays
+	73,  // 93: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.exponential:type_name -> windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Exponential
+	91,  // 94: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Delays.delays:type_name -> google.protobuf.Duration
+	91,  // 95: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Exponential.initial_delay:type_name -> google.protobuf.Duration
+	91,  // 96: windshift.events.v1alpha1.EnsureConsumerRequest.RedeliveryBackoff.Exponential.max_delay:type_name -> google.protobuf.Duration
+	92,  // 97: windshift.events.v1alpha1.ConsumerInfo.State.last_delivered_timestamp:type_name -> google.protobuf.Timestamp
+	1,   // 98: windshift.events.v1alpha1.EventsRequest.Subscribe.encoding:type_name -> windshift.events.v1alpha1.Encoding
+	0,   // 99: windshift.events.v1alpha1.EventsRequest.Subscribe.cloud_events:type_name -> windshift.events.v1alpha1.CloudEventsMode
+	91,  // 100: windshift.events.v1alpha1.EventsRequest.Reject.delay:type_name -> google.protobuf.Duration
+	81,  // 101: windshift.events.v1alpha1.EventsRequest.Reject.details:type_name -> windshift.events.v1alpha1.EventsRequest.Reject.DetailsEntry
+	91,  // 102: windshift.events.v1alpha1.EventsResponse.Subscribed.processing_timeout:type_name -> google.protobuf.Duration
+	88,  // 103: windshift.events.v1alpha1.CloudEvent.AttributesEntry.value:type_name -> windshift.events.v1alpha1.CloudEvent.CloudEventAttributeValue
+	92,  // 104: windshift.events.v1alpha1.CloudEvent.CloudEventAttributeValue.ce_timestamp:type_name -> google.protobuf.Timestamp
+	6,   // 105: windshift.events.v1alpha1.EventsService.EnsureStream:input_type -> windshift.events.v1alpha1.EnsureStreamRequest
+	8,   // 106: windshift.events.v1alpha1.EventsService.GetStream:input_type -> windshift.events.v1alpha1.GetStreamRequest
+	10,  // 107: windshift.events.v1alpha1.EventsService.ListStreams:input_type -> windshift.events.v1alpha1.ListStreamsRequest
+	12,  // 108: windshift.events.v1alpha1.EventsService.DeleteStream:input_type -> windshift.events.v1alpha1.DeleteStreamRequest
+	15,  // 109: windshift.events.v1alpha1.EventsService.EnsureConsumer:input_type -> windshift.events.v1alpha1.EnsureConsumerRequest
+	17,  // 110: windshift.events.v1alpha1.EventsService.GetConsumer:input_type -> windshift.events.v1alpha1.GetConsumerRequest
+	19,  // 111: windshift.events.v1alpha1.EventsService.ListConsumers:input_type -> windshift.events.v1alpha1.ListConsumersRequest
+	22,  // 112: windshift.events.v1alpha1.EventsService.DeleteConsumer:input_type -> windshift.events.v1alpha1.DeleteConsumerRequest
+	24,  // 113: windshift.events.v1alpha1.EventsService.ListDeadLetters:input_type -> windshift.events.v1alpha1.ListDeadLettersRequest
+	26,  // 114: windshift.events.v1alpha1.EventsService.RequeueDeadLetters:input_type -> windshift.events.v1alpha1.RequeueDeadLettersRequest
+	29,  // 115: windshift.events.v1alpha1.EventsService.ListFailures:input_type -> windshift.events.v1alpha1.ListFailuresRequest
+	33,  // 116: windshift.events.v1alpha1.EventsService.PublishEvent:input_type -> windshift.events.v1alpha1.PublishEventRequest
+	42,  // 117: windshift.events.v1alpha1.EventsService.PublishEvents:input_type -> windshift.events.v1alpha1.PublishEventsRequest
+	33,  // 118: windshift.events.v1alpha1.EventsService.PublishStream:input_type -> windshift.events.v1alpha1.PublishEventRequest
+	35,  // 119: windshift.events.v1alpha1.EventsService.PublishCloudEvent:input_type -> windshift.events.v1alpha1.PublishCloudEventRequest
+	37,  // 120: windshift.events.v1alpha1.EventsService.ListScheduledEvents:input_type -> windshift.events.v1alpha1.ListScheduledEventsRequest
+	39,  // 121: windshift.events.v1alpha1.EventsService.CancelScheduledEvent:input_type -> windshift.events.v1alpha1.CancelScheduledEventRequest
+	46,  // 122: windshift.events.v1alpha1.EventsService.Events:input_type -> windshift.events.v1alpha1.EventsRequest
+	49,  // 123: windshift.events.v1alpha1.EventsService.FetchEvents:input_type -> windshift.events.v1alpha1.FetchEventsRequest
+	55,  // 124: windshift.events.v1alpha1.EventsService.AckEvents:input_type -> windshift.events.v1alpha1.AckEventsRequest
+	57,  // 125: windshift.events.v1alpha1.EventsService.RejectEvents:input_type -> windshift.events.v1alpha1.RejectEventsRequest
+	59,  // 126: windshift.events.v1alpha1.EventsService.PingEvents:input_type -> windshift.events.v1alpha1.PingEventsRequest
+	51,  // 127: windshift.events.v1alpha1.EventsService.GetEvent:input_type -> windshift.events.v1alpha1.GetEventRequest
+	53,  // 128: windshift.events.v1alpha1.EventsService.ReadEvents:input_type -> windshift.events.v1alpha1.ReadEventsRequest
+	7,   // 129: windshift.events.v1alpha1.EventsService.EnsureStream:output_type -> windshift.events.v1alpha1.EnsureStreamResponse
+	9,   // 130: windshift.events.v1alpha1.EventsService.GetStream:output_type -> windshift.events.v1alpha1.GetStreamResponse
+	11,  // 131: windshift.events.v1alpha1.EventsService.ListStreams:output_type -> windshift.events.v1alpha1.ListStreamsResponse
+	13,  // 132: windshift.events.v1alpha1.EventsService.DeleteStream:output_type -> windshift.events.v1alpha1.DeleteStreamResponse
+	16,  // 133: windshift.events.v1alpha1.EventsService.EnsureConsumer:output_type -> windshift.events.v1alpha1.EnsureConsumerResponse
+	18,  // 134: windshift.events.v1alpha1.EventsService.GetConsumer:output_type -> windshift.events.v1alpha1.GetConsumerResponse
+	20,  // 135: windshift.events.v1alpha1.EventsService.ListConsumers:output_type -> windshift.events.v1alpha1.ListConsumersResponse
+	23,  // 136: windshift.events.v1alpha1.EventsService.DeleteConsumer:output_type -> windshift.events.v1alpha1.DeleteConsumerResponse
+	25,  // 137: windshift.events.v1alpha1.EventsService.ListDeadLetters:output_type -> windshift.events.v1alpha1.ListDeadLettersResponse
+	27,  // 138: windshift.events.v1alpha1.EventsService.RequeueDeadLetters:output_type -> windshift.events.v1alpha1.RequeueDeadLettersResponse
+	30,  // 139: windshift.events.v1alpha1.EventsService.ListFailures:output_type -> windshift.events.v1alpha1.ListFailuresResponse
+	34,  // 140: windshift.events.v1alpha1.EventsService.PublishEvent:output_type -> windshift.events.v1alpha1.PublishEventResponse
+	43,  // 141: windshift.events.v1alpha1.EventsService.PublishEvents:output_type -> windshift.events.v1alpha1.PublishEventsResponse
+	44,  // 142: windshift.events.v1alpha1.EventsService.PublishStream:output_type -> windshift.events.v1alpha1.PublishResult
+	36,  // 143: windshift.events.v1alpha1.EventsService.PublishCloudEvent:output_type -> windshift.events.v1alpha1.PublishCloudEventResponse
+	38,  // 144: windshift.events.v1alpha1.EventsService.ListScheduledEvents:output_type -> windshift.events.v1alpha1.ListScheduledEventsResponse
+	40,  // 145: windshift.events.v1alpha1.EventsService.CancelScheduledEvent:output_type -> windshift.events.v1alpha1.CancelScheduledEventResponse
+	47,  // 146: windshift.events.v1alpha1.EventsService.Events:output_type -> windshift.events.v1alpha1.EventsResponse
+	50,  // 147: windshift.events.v1alpha1.EventsService.FetchEvents:output_type -> windshift.events.v1alpha1.FetchEventsResponse
+	56,  // 148: windshift.events.v1alpha1.EventsService.AckEvents:output_type -> windshift.events.v1alpha1.AckEventsResponse
+	58,  // 149: windshift.events.v1alpha1.EventsService.RejectEvents:output_type -> windshift.events.v1alpha1.RejectEventsResponse
+	60,  // 150: windshift.events.v1alpha1.EventsService.PingEvents:output_type -> windshift.events.v1alpha1.PingEventsResponse
+	52,  // 151: windshift.events.v1alpha1.EventsService.GetEvent:output_type -> windshift.events.v1alpha1.GetEventResponse
+	54,  // 152: windshift.events.v1alpha1.EventsService.ReadEvents:output_type -> windshift.events.v1alpha1.ReadEventsResponse
+	129, // [129:153] is the sub-list for method output_type
+	105, // [105:129] is the sub-list for method input_type
+	105, // [105:105] is the sub-list for extension type_name
+	105, // [105:105] is the sub-list for extension extendee
+	0,   // [0:105] is the sub-list for field type_name
+}
+
+func init() { file_windshift_events_v1alpha1_service_proto_init() }
+func file_windshift_events_v1alpha1_service_proto_init() {
+	if File_windshift_events_v1alpha1_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureConsumerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureConsumerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsumerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsumerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsumersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConsumersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConsumerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteConsumerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequeueDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFailuresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFailuresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Failure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailureRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishCloudEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishCloudEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2700,8 +8772,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnsureStreamResponse); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2712,8 +8784,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnsureConsumerRequest); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2724,8 +8796,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnsureConsumerResponse); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2736,8 +8808,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteConsumerRequest); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2748,8 +8820,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteConsumerResponse); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2760,8 +8832,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishEventRequest); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2772,8 +8844,8 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishEventResponse); i {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2784,7 +8856,19 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsRequest); i {
 			case 0:
 				return &v.state
@@ -2796,7 +8880,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsResponse); i {
 			case 0:
 				return &v.state
@@ -2808,7 +8892,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamPointer); i {
 			case 0:
 				return &v.state
@@ -2820,7 +8904,151 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
@@ -2832,7 +9060,31 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryCloudEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Headers); i {
 			case 0:
 				return &v.state
@@ -2844,7 +9096,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnsureStreamRequest_RetentionPolicy); i {
 			case 0:
 				return &v.state
@@ -2856,7 +9108,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnsureStreamRequest_Subjects); i {
 			case 0:
 				return &v.state
@@ -2868,7 +9120,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnsureStreamRequest_StreamSource); i {
 			case 0:
 				return &v.state
@@ -2880,7 +9132,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnsureStreamRequest_StreamSources); i {
 			case 0:
 				return &v.state
@@ -2892,7 +9144,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EnsureStreamRequest_Storage); i {
 			case 0:
 				return &v.state
@@ -2904,7 +9156,67 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInfo_State); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureConsumerRequest_RedeliveryBackoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureConsumerRequest_RedeliveryBackoff_Delays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnsureConsumerRequest_RedeliveryBackoff_Exponential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerInfo_State); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsRequest_Subscribe); i {
 			case 0:
 				return &v.state
@@ -2916,7 +9228,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsRequest_Ack); i {
 			case 0:
 				return &v.state
@@ -2928,7 +9240,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsRequest_Reject); i {
 			case 0:
 				return &v.state
@@ -2940,7 +9252,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsRequest_Ping); i {
 			case 0:
 				return &v.state
@@ -2952,7 +9264,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsResponse_Subscribed); i {
 			case 0:
 				return &v.state
@@ -2964,7 +9276,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsResponse_AckConfirmation); i {
 			case 0:
 				return &v.state
@@ -2976,7 +9288,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsResponse_RejectConfirmation); i {
 			case 0:
 				return &v.state
@@ -2988,7 +9300,7 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
-		file_windshift_events_v1alpha1_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_windshift_events_v1alpha1_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventsResponse_PingConfirmation); i {
 			case 0:
 				return &v.state
@@ -3000,46 +9312,123 @@ func file_windshift_events_v1alpha1_service_proto_init() {
 				return nil
 			}
 		}
+		file_windshift_events_v1alpha1_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudEvent_CloudEventAttributeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_windshift_events_v1alpha1_service_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*EnsureStreamRequest_Subjects_)(nil),
 		(*EnsureStreamRequest_Mirror)(nil),
 		(*EnsureStreamRequest_Aggregate)(nil),
 	}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_windshift_events_v1alpha1_service_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*StreamInfo_Subjects)(nil),
+		(*StreamInfo_Mirror)(nil),
+		(*StreamInfo_Aggregate)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*PublishCloudEventRequest_Structured)(nil),
+		(*PublishCloudEventRequest_StructuredJson)(nil),
+		(*PublishCloudEventRequest_Binary)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[38].OneofWrappers = []interface{}{
+		(*PublishResult_Id)(nil),
+		(*PublishResult_Error)(nil),
+		(*PublishResult_ScheduledId)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[40].OneofWrappers = []interface{}{
 		(*EventsRequest_Subscribe_)(nil),
 		(*EventsRequest_Ack_)(nil),
 		(*EventsRequest_Reject_)(nil),
 		(*EventsRequest_Ping_)(nil),
 	}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_windshift_events_v1alpha1_service_proto_msgTypes[41].OneofWrappers = []interface{}{
 		(*EventsResponse_Event)(nil),
 		(*EventsResponse_Subscribed_)(nil),
 		(*EventsResponse_AckConfirmation_)(nil),
 		(*EventsResponse_RejectConfirmation_)(nil),
 		(*EventsResponse_PingConfirmation_)(nil),
 	}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_windshift_events_v1alpha1_service_proto_msgTypes[42].OneofWrappers = []interface{}{
 		(*StreamPointer_Start)(nil),
 		(*StreamPointer_End)(nil),
 		(*StreamPointer_Time)(nil),
 		(*StreamPointer_Offset)(nil),
 	}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[18].OneofWrappers = []interface{}{}
-	file_windshift_events_v1alpha1_service_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*ReadEventsRequest_StartId)(nil),
+		(*ReadEventsRequest_StartTime)(nil),
+		(*ReadEventsRequest_EndId)(nil),
+		(*ReadEventsRequest_EndTime)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*Event_StructuredCloudEvent)(nil),
+		(*Event_StructuredJsonCloudEvent)(nil),
+		(*Event_BinaryCloudEvent)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[56].OneofWrappers = []interface{}{
+		(*CloudEvent_BinaryData)(nil),
+		(*CloudEvent_TextData)(nil),
+		(*CloudEvent_ProtoData)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[59].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[65].OneofWrappers = []interface{}{
+		(*EnsureConsumerRequest_RedeliveryBackoff_Explicit)(nil),
+		(*EnsureConsumerRequest_RedeliveryBackoff_Exponential_)(nil),
+	}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[71].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_windshift_events_v1alpha1_service_proto_msgTypes[82].OneofWrappers = []interface{}{
+		(*CloudEvent_CloudEventAttributeValue_CeBoolean)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeInteger)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeString)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeBytes)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeUri)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeUriRef)(nil),
+		(*CloudEvent_CloudEventAttributeValue_CeTimestamp)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_windshift_events_v1alpha1_service_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   26,
+			NumEnums:      6,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
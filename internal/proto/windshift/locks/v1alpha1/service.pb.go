@@ -447,6 +447,68 @@ func (x *LockReleasedEvent) GetOwner() string {
 	return ""
 }
 
+// *
+// Event for when a lock expired without being extended or released.
+type LockExpiredEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// *
+	// Name of the lock that expired.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// *
+	// Owner of the lock, intended to be human-readable to allow
+	// distinguishing between different clients.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *LockExpiredEvent) Reset() {
+	*x = LockExpiredEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockExpiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockExpiredEvent) ProtoMessage() {}
+
+func (x *LockExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockExpiredEvent.ProtoReflect.Descriptor instead.
+func (*LockExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LockExpiredEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LockExpiredEvent) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 // *
 // Request to monitor the state of a lock or many locks.
 type MonitorRequest struct {
@@ -462,7 +524,7 @@ type MonitorRequest struct {
 func (x *MonitorRequest) Reset() {
 	*x = MonitorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[5]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -475,7 +537,7 @@ func (x *MonitorRequest) String() string {
 func (*MonitorRequest) ProtoMessage() {}
 
 func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[5]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,7 +550,7 @@ func (x *MonitorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonitorRequest.ProtoReflect.Descriptor instead.
 func (*MonitorRequest) Descriptor() ([]byte, []int) {
-	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{5}
+	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MonitorRequest) GetName() []string {
@@ -503,6 +565,10 @@ type MonitorResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// *
+	// Identifier of the event, can be used as `after_id` to continue reading
+	// the history after this event.
+	Id uint64 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
 	// *
 	// Timestamp of the event.
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -511,13 +577,14 @@ type MonitorResponse struct {
 	//	*MonitorResponse_Acquired
 	//	*MonitorResponse_Extended
 	//	*MonitorResponse_Released
+	//	*MonitorResponse_Expired
 	Event isMonitorResponse_Event `protobuf_oneof:"event"`
 }
 
 func (x *MonitorResponse) Reset() {
 	*x = MonitorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[6]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -530,7 +597,7 @@ func (x *MonitorResponse) String() string {
 func (*MonitorResponse) ProtoMessage() {}
 
 func (x *MonitorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[6]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +610,14 @@ func (x *MonitorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonitorResponse.ProtoReflect.Descriptor instead.
 func (*MonitorResponse) Descriptor() ([]byte, []int) {
-	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{6}
+	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MonitorResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 func (x *MonitorResponse) GetTimestamp() *timestamppb.Timestamp {
@@ -581,6 +655,13 @@ func (x *MonitorResponse) GetReleased() *LockReleasedEvent {
 	return nil
 }
 
+func (x *MonitorResponse) GetExpired() *LockExpiredEvent {
+	if x, ok := x.GetEvent().(*MonitorResponse_Expired); ok {
+		return x.Expired
+	}
+	return nil
+}
+
 type isMonitorResponse_Event interface {
 	isMonitorResponse_Event()
 }
@@ -603,12 +684,20 @@ type MonitorResponse_Released struct {
 	Released *LockReleasedEvent `protobuf:"bytes,4,opt,name=released,proto3,oneof"`
 }
 
+type MonitorResponse_Expired struct {
+	// *
+	// A lock expired without being extended or released.
+	Expired *LockExpiredEvent `protobuf:"bytes,5,opt,name=expired,proto3,oneof"`
+}
+
 func (*MonitorResponse_Acquired) isMonitorResponse_Event() {}
 
 func (*MonitorResponse_Extended) isMonitorResponse_Event() {}
 
 func (*MonitorResponse_Released) isMonitorResponse_Event() {}
 
+func (*MonitorResponse_Expired) isMonitorResponse_Event() {}
+
 // *
 // Request to get the history of a lock or many locks.
 type HistoryRequest struct {
@@ -625,14 +714,19 @@ type HistoryRequest struct {
 	// returned.
 	Limit *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	// *
-	// If set, only events after this timestamp will be returned.
+	// If set, only events after this timestamp will be returned. Several
+	// events can share a timestamp, so use `after_id` to continue reading
+	// where a previous request stopped. Can not be combined with `after_id`.
 	After *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	// *
+	// If set, only events after the event with this id will be returned.
+	AfterId *uint64 `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
 }
 
 func (x *HistoryRequest) Reset() {
 	*x = HistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[7]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +739,7 @@ func (x *HistoryRequest) String() string {
 func (*HistoryRequest) ProtoMessage() {}
 
 func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[7]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +752,7 @@ func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
 func (*HistoryRequest) Descriptor() ([]byte, []int) {
-	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{7}
+	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HistoryRequest) GetName() []string {
@@ -682,11 +776,22 @@ func (x *HistoryRequest) GetAfter() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *HistoryRequest) GetAfterId() uint64 {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return 0
+}
+
 type HistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// *
+	// Identifier of the event, can be used as `after_id` to continue reading
+	// the history after this event.
+	Id uint64 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
 	// *
 	// Timestamp of the event.
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -695,13 +800,14 @@ type HistoryResponse struct {
 	//	*HistoryResponse_Acquired
 	//	*HistoryResponse_Extended
 	//	*HistoryResponse_Released
+	//	*HistoryResponse_Expired
 	Event isHistoryResponse_Event `protobuf_oneof:"event"`
 }
 
 func (x *HistoryResponse) Reset() {
 	*x = HistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[8]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -714,7 +820,7 @@ func (x *HistoryResponse) String() string {
 func (*HistoryResponse) ProtoMessage() {}
 
 func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[8]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +833,14 @@ func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
 func (*HistoryResponse) Descriptor() ([]byte, []int) {
-	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{8}
+	return file_windshift_locks_v1alpha1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *HistoryResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
 func (x *HistoryResponse) GetTimestamp() *timestamppb.Timestamp {
@@ -765,6 +878,13 @@ func (x *HistoryResponse) GetReleased() *LockReleasedEvent {
 	return nil
 }
 
+func (x *HistoryResponse) GetExpired() *LockExpiredEvent {
+	if x, ok := x.GetEvent().(*HistoryResponse_Expired); ok {
+		return x.Expired
+	}
+	return nil
+}
+
 type isHistoryResponse_Event interface {
 	isHistoryResponse_Event()
 }
@@ -787,12 +907,20 @@ type HistoryResponse_Released struct {
 	Released *LockReleasedEvent `protobuf:"bytes,4,opt,name=released,proto3,oneof"`
 }
 
+type HistoryResponse_Expired struct {
+	// *
+	// A lock expired without being extended or released.
+	Expired *LockExpiredEvent `protobuf:"bytes,5,opt,name=expired,proto3,oneof"`
+}
+
 func (*HistoryResponse_Acquired) isHistoryResponse_Event() {}
 
 func (*HistoryResponse_Extended) isHistoryResponse_Event() {}
 
 func (*HistoryResponse_Released) isHistoryResponse_Event() {}
 
+func (*HistoryResponse_Expired) isHistoryResponse_Event() {}
+
 // *
 // Acquire a new lock. If the lock is already held, this request will
 // fail with a `Rejected` response.
@@ -817,12 +945,16 @@ type LockRequest_Acquire struct {
 	// itself. If the lock is not acquired before this timeout, the
 	// request will fail with a `Rejected` response.
 	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,oneof" json:"timeout,omitempty"`
+	// *
+	// Owner of the lock, intended to be human-readable to allow
+	// distinguishing between different clients.
+	Owner *string `protobuf:"bytes,4,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
 }
 
 func (x *LockRequest_Acquire) Reset() {
 	*x = LockRequest_Acquire{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[9]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -835,7 +967,7 @@ func (x *LockRequest_Acquire) String() string {
 func (*LockRequest_Acquire) ProtoMessage() {}
 
 func (x *LockRequest_Acquire) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[9]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,6 +1004,13 @@ func (x *LockRequest_Acquire) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *LockRequest_Acquire) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
 // *
 // Extend the duration of a lock you already hold. If the lock is not
 // held, this request will fail with a `Rejected` response.
@@ -899,7 +1038,7 @@ type LockRequest_Extend struct {
 func (x *LockRequest_Extend) Reset() {
 	*x = LockRequest_Extend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[10]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -912,7 +1051,7 @@ func (x *LockRequest_Extend) String() string {
 func (*LockRequest_Extend) ProtoMessage() {}
 
 func (x *LockRequest_Extend) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[10]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1110,7 @@ type LockRequest_Release struct {
 func (x *LockRequest_Release) Reset() {
 	*x = LockRequest_Release{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[11]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -984,7 +1123,7 @@ func (x *LockRequest_Release) String() string {
 func (*LockRequest_Release) ProtoMessage() {}
 
 func (x *LockRequest_Release) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[11]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1174,7 @@ type LockResponse_Acquired struct {
 func (x *LockResponse_Acquired) Reset() {
 	*x = LockResponse_Acquired{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[12]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1048,7 +1187,7 @@ func (x *LockResponse_Acquired) String() string {
 func (*LockResponse_Acquired) ProtoMessage() {}
 
 func (x *LockResponse_Acquired) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[12]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,13 +1224,15 @@ type LockResponse_Extended struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// *
+	// Name of the lock that was extended.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (x *LockResponse_Extended) Reset() {
 	*x = LockResponse_Extended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[13]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1104,7 +1245,7 @@ func (x *LockResponse_Extended) String() string {
 func (*LockResponse_Extended) ProtoMessage() {}
 
 func (x *LockResponse_Extended) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[13]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,13 +1275,15 @@ type LockResponse_Released struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// *
+	// Name of the lock that was released.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (x *LockResponse_Released) Reset() {
 	*x = LockResponse_Released{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[14]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1153,7 +1296,7 @@ func (x *LockResponse_Released) String() string {
 func (*LockResponse_Released) ProtoMessage() {}
 
 func (x *LockResponse_Released) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[14]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1335,7 @@ type LockResponse_Rejected struct {
 func (x *LockResponse_Rejected) Reset() {
 	*x = LockResponse_Rejected{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[15]
+		mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1205,7 +1348,7 @@ func (x *LockResponse_Rejected) String() string {
 func (*LockResponse_Rejected) ProtoMessage() {}
 
 func (x *LockResponse_Rejected) ProtoReflect() protoreflect.Message {
-	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[15]
+	mi := &file_windshift_locks_v1alpha1_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1389,7 @@ var file_windshift_locks_v1alpha1_service_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xae, 0x04, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x04, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
 	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
@@ -1261,7 +1404,7 @@ var file_windshift_locks_v1alpha1_service_proto_rawDesc = []byte{
 	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52,
 	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x1a, 0x99, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x12, 0x0a,
+	0x65, 0x1a, 0xbe, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
@@ -1270,120 +1413,140 @@ var file_windshift_locks_v1alpha1_service_proto_rawDesc = []byte{
 	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x68, 0x0a,
-	0x06, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x12, 0x19, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x1a, 0x68, 0x0a, 0x06, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x1a, 0x33, 0x0a, 0x07,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
 	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x1a, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x6e, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9e, 0x04, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x61, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69,
+	0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x34, 0x0a, 0x08, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x06, 0x0a, 0x04,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9e, 0x04, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69,
-	0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66,
-	0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
-	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
-	0x64, 0x1a, 0x34, 0x0a, 0x08, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x1e, 0x0a, 0x08, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x54, 0x0a, 0x08, 0x52, 0x65, 0x6a, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x06, 0x0a,
-	0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x6f,
-	0x63, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x1e, 0x0a, 0x08,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x1e, 0x0a, 0x08,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x54, 0x0a, 0x08,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x6f,
+	0x63, 0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x78, 0x70,
 	0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22,
-	0x3d, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x45,
+	0x73, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x24,
-	0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x49, 0x0a,
-	0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08,
-	0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e,
-	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a,
-	0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35,
-	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xb5, 0x02, 0x0a, 0x0f, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64,
-	0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x49, 0x0a,
-	0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08,
-	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x24, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x03, 0x0a, 0x0f, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x49, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f,
+	0x63, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x02, 0x52, 0x07, 0x61, 0x66, 0x74, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0x8d, 0x03, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x49, 0x0a, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x08, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x12, 0x46, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
 	0x74, 0x32, 0xad, 0x02, 0x0a, 0x0c, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x12, 0x59, 0x0a, 0x04, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x77, 0x69, 0x6e,
 	0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61,
@@ -1403,23 +1566,24 @@ var file_windshift_locks_v1alpha1_service_proto_rawDesc = []byte{
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
 	0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
 	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
-	0x01, 0x42, 0xf7, 0x01, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x01, 0x42, 0x8d, 0x02, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68,
 	0x69, 0x66, 0x74, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
 	0x61, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x47, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2f, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x57, 0x4c,
-	0x58, 0xaa, 0x02, 0x18, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x2e, 0x4c, 0x6f,
-	0x63, 0x6b, 0x73, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x18, 0x57,
-	0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x5c, 0x56,
-	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x24, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1a, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b,
-	0x73, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x50, 0x01, 0x5a, 0x5d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x66, 0x6f, 0x75, 0x72, 0x61, 0x62, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0xa2, 0x02, 0x03, 0x57, 0x4c, 0x58, 0xaa, 0x02, 0x18, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xca, 0x02, 0x18, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x4c,
+	0x6f, 0x63, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x24,
+	0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5c, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x5c,
+	0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x3a, 0x3a, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1434,61 +1598,64 @@ func file_windshift_locks_v1alpha1_service_proto_rawDescGZIP() []byte {
 	return file_windshift_locks_v1alpha1_service_proto_rawDescData
 }
 
-var file_windshift_locks_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_windshift_locks_v1alpha1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_windshift_locks_v1alpha1_service_proto_goTypes = []interface{}{
 	(*LockRequest)(nil),           // 0: windshift.locks.v1alpha1.LockRequest
 	(*LockResponse)(nil),          // 1: windshift.locks.v1alpha1.LockResponse
 	(*LockAcquiredEvent)(nil),     // 2: windshift.locks.v1alpha1.LockAcquiredEvent
 	(*LockExtendedEvent)(nil),     // 3: windshift.locks.v1alpha1.LockExtendedEvent
 	(*LockReleasedEvent)(nil),     // 4: windshift.locks.v1alpha1.LockReleasedEvent
-	(*MonitorRequest)(nil),        // 5: windshift.locks.v1alpha1.MonitorRequest
-	(*MonitorResponse)(nil),       // 6: windshift.locks.v1alpha1.MonitorResponse
-	(*HistoryRequest)(nil),        // 7: windshift.locks.v1alpha1.HistoryRequest
-	(*HistoryResponse)(nil),       // 8: windshift.locks.v1alpha1.HistoryResponse
-	(*LockRequest_Acquire)(nil),   // 9: windshift.locks.v1alpha1.LockRequest.Acquire
-	(*LockRequest_Extend)(nil),    // 10: windshift.locks.v1alpha1.LockRequest.Extend
-	(*LockRequest_Release)(nil),   // 11: windshift.locks.v1alpha1.LockRequest.Release
-	(*LockResponse_Acquired)(nil), // 12: windshift.locks.v1alpha1.LockResponse.Acquired
-	(*LockResponse_Extended)(nil), // 13: windshift.locks.v1alpha1.LockResponse.Extended
-	(*LockResponse_Released)(nil), // 14: windshift.locks.v1alpha1.LockResponse.Released
-	(*LockResponse_Rejected)(nil), // 15: windshift.locks.v1alpha1.LockResponse.Rejected
-	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 17: google.protobuf.Duration
+	(*LockExpiredEvent)(nil),      // 5: windshift.locks.v1alpha1.LockExpiredEvent
+	(*MonitorRequest)(nil),        // 6: windshift.locks.v1alpha1.MonitorRequest
+	(*MonitorResponse)(nil),       // 7: windshift.locks.v1alpha1.MonitorResponse
+	(*HistoryRequest)(nil),        // 8: windshift.locks.v1alpha1.HistoryRequest
+	(*HistoryResponse)(nil),       // 9: windshift.locks.v1alpha1.HistoryResponse
+	(*LockRequest_Acquire)(nil),   // 10: windshift.locks.v1alpha1.LockRequest.Acquire
+	(*LockRequest_Extend)(nil),    // 11: windshift.locks.v1alpha1.LockRequest.Extend
+	(*LockRequest_Release)(nil),   // 12: windshift.locks.v1alpha1.LockRequest.Release
+	(*LockResponse_Acquired)(nil), // 13: windshift.locks.v1alpha1.LockResponse.Acquired
+	(*LockResponse_Extended)(nil), // 14: windshift.locks.v1alpha1.LockResponse.Extended
+	(*LockResponse_Released)(nil), // 15: windshift.locks.v1alpha1.LockResponse.Released
+	(*LockResponse_Rejected)(nil), // 16: windshift.locks.v1alpha1.LockResponse.Rejected
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 18: google.protobuf.Duration
 }
 var file_windshift_locks_v1alpha1_service_proto_depIdxs = []int32{
-	9,  // 0: windshift.locks.v1alpha1.LockRequest.acquire:type_name -> windshift.locks.v1alpha1.LockRequest.Acquire
-	10, // 1: windshift.locks.v1alpha1.LockRequest.extend:type_name -> windshift.locks.v1alpha1.LockRequest.Extend
-	11, // 2: windshift.locks.v1alpha1.LockRequest.release:type_name -> windshift.locks.v1alpha1.LockRequest.Release
-	12, // 3: windshift.locks.v1alpha1.LockResponse.acquired:type_name -> windshift.locks.v1alpha1.LockResponse.Acquired
-	13, // 4: windshift.locks.v1alpha1.LockResponse.extended:type_name -> windshift.locks.v1alpha1.LockResponse.Extended
-	14, // 5: windshift.locks.v1alpha1.LockResponse.released:type_name -> windshift.locks.v1alpha1.LockResponse.Released
-	15, // 6: windshift.locks.v1alpha1.LockResponse.rejected:type_name -> windshift.locks.v1alpha1.LockResponse.Rejected
-	16, // 7: windshift.locks.v1alpha1.LockAcquiredEvent.expires:type_name -> google.protobuf.Timestamp
-	16, // 8: windshift.locks.v1alpha1.LockExtendedEvent.expires:type_name -> google.protobuf.Timestamp
-	16, // 9: windshift.locks.v1alpha1.MonitorResponse.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 0: windshift.locks.v1alpha1.LockRequest.acquire:type_name -> windshift.locks.v1alpha1.LockRequest.Acquire
+	11, // 1: windshift.locks.v1alpha1.LockRequest.extend:type_name -> windshift.locks.v1alpha1.LockRequest.Extend
+	12, // 2: windshift.locks.v1alpha1.LockRequest.release:type_name -> windshift.locks.v1alpha1.LockRequest.Release
+	13, // 3: windshift.locks.v1alpha1.LockResponse.acquired:type_name -> windshift.locks.v1alpha1.LockResponse.Acquired
+	14, // 4: windshift.locks.v1alpha1.LockResponse.extended:type_name -> windshift.locks.v1alpha1.LockResponse.Extended
+	15, // 5: windshift.locks.v1alpha1.LockResponse.released:type_name -> windshift.locks.v1alpha1.LockResponse.Released
+	16, // 6: windshift.locks.v1alpha1.LockResponse.rejected:type_name -> windshift.locks.v1alpha1.LockResponse.Rejected
+	17, // 7: windshift.locks.v1alpha1.LockAcquiredEvent.expires:type_name -> google.protobuf.Timestamp
+	17, // 8: windshift.locks.v1alpha1.LockExtendedEvent.expires:type_name -> google.protobuf.Timestamp
+	17, // 9: windshift.locks.v1alpha1.MonitorResponse.timestamp:type_name -> google.protobuf.Timestamp
 	2,  // 10: windshift.locks.v1alpha1.MonitorResponse.acquired:type_name -> windshift.locks.v1alpha1.LockAcquiredEvent
 	3,  // 11: windshift.locks.v1alpha1.MonitorResponse.extended:type_name -> windshift.locks.v1alpha1.LockExtendedEvent
 	4,  // 12: windshift.locks.v1alpha1.MonitorResponse.released:type_name -> windshift.locks.v1alpha1.LockReleasedEvent
-	16, // 13: windshift.locks.v1alpha1.HistoryRequest.after:type_name -> google.protobuf.Timestamp
-	16, // 14: windshift.locks.v1alpha1.HistoryResponse.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 15: windshift.locks.v1alpha1.HistoryResponse.acquired:type_name -> windshift.locks.v1alpha1.LockAcquiredEvent
-	3,  // 16: windshift.locks.v1alpha1.HistoryResponse.extended:type_name -> windshift.locks.v1alpha1.LockExtendedEvent
-	4,  // 17: windshift.locks.v1alpha1.HistoryResponse.released:type_name -> windshift.locks.v1alpha1.LockReleasedEvent
-	16, // 18: windshift.locks.v1alpha1.LockRequest.Acquire.expires:type_name -> google.protobuf.Timestamp
-	17, // 19: windshift.locks.v1alpha1.LockRequest.Acquire.timeout:type_name -> google.protobuf.Duration
-	16, // 20: windshift.locks.v1alpha1.LockRequest.Extend.expires:type_name -> google.protobuf.Timestamp
-	16, // 21: windshift.locks.v1alpha1.LockResponse.Rejected.expires:type_name -> google.protobuf.Timestamp
-	0,  // 22: windshift.locks.v1alpha1.LocksService.Lock:input_type -> windshift.locks.v1alpha1.LockRequest
-	5,  // 23: windshift.locks.v1alpha1.LocksService.Monitor:input_type -> windshift.locks.v1alpha1.MonitorRequest
-	7,  // 24: windshift.locks.v1alpha1.LocksService.History:input_type -> windshift.locks.v1alpha1.HistoryRequest
-	1,  // 25: windshift.locks.v1alpha1.LocksService.Lock:output_type -> windshift.locks.v1alpha1.LockResponse
-	6,  // 26: windshift.locks.v1alpha1.LocksService.Monitor:output_type -> windshift.locks.v1alpha1.MonitorResponse
-	8,  // 27: windshift.locks.v1alpha1.LocksService.History:output_type -> windshift.locks.v1alpha1.HistoryResponse
-	25, // [25:28] is the sub-list for method output_type
-	22, // [22:25] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	5,  // 13: windshift.locks.v1alpha1.MonitorResponse.expired:type_name -> windshift.locks.v1alpha1.LockExpiredEvent
+	17, // 14: windshift.locks.v1alpha1.HistoryRequest.after:type_name -> google.protobuf.Timestamp
+	17, // 15: windshift.locks.v1alpha1.HistoryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	2,  // 16: windshift.locks.v1alpha1.HistoryResponse.acquired:type_name -> windshift.locks.v1alpha1.LockAcquiredEvent
+	3,  // 17: windshift.locks.v1alpha1.HistoryResponse.extended:type_name -> windshift.locks.v1alpha1.LockExtendedEvent
+	4,  // 18: windshift.locks.v1alpha1.HistoryResponse.released:type_name -> windshift.locks.v1alpha1.LockReleasedEvent
+	5,  // 19: windshift.locks.v1alpha1.HistoryResponse.expired:type_name -> windshift.locks.v1alpha1.LockExpiredEvent
+	17, // 20: windshift.locks.v1alpha1.LockRequest.Acquire.expires:type_name -> google.protobuf.Timestamp
+	18, // 21: windshift.locks.v1alpha1.LockRequest.Acquire.timeout:type_name -> google.protobuf.Duration
+	17, // 22: windshift.locks.v1alpha1.LockRequest.Extend.expires:type_name -> google.protobuf.Timestamp
+	17, // 23: windshift.locks.v1alpha1.LockResponse.Rejected.expires:type_name -> google.protobuf.Timestamp
+	0,  // 24: windshift.locks.v1alpha1.LocksService.Lock:input_type -> windshift.locks.v1alpha1.LockRequest
+	6,  // 25: windshift.locks.v1alpha1.LocksService.Monitor:input_type -> windshift.locks.v1alpha1.MonitorRequest
+	8,  // 26: windshift.locks.v1alpha1.LocksService.History:input_type -> windshift.locks.v1alpha1.HistoryRequest
+	1,  // 27: windshift.locks.v1alpha1.LocksService.Lock:output_type -> windshift.locks.v1alpha1.LockResponse
+	7,  // 28: windshift.locks.v1alpha1.LocksService.Monitor:output_type -> windshift.locks.v1alpha1.MonitorResponse
+	9,  // 29: windshift.locks.v1alpha1.LocksService.History:output_type -> windshift.locks.v1alpha1.HistoryResponse
+	27, // [27:30] is the sub-list for method output_type
+	24, // [24:27] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_windshift_locks_v1alpha1_service_proto_init() }
@@ -1558,7 +1725,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorRequest); i {
+			switch v := v.(*LockExpiredEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1570,7 +1737,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonitorResponse); i {
+			switch v := v.(*MonitorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1582,7 +1749,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoryRequest); i {
+			switch v := v.(*MonitorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1594,7 +1761,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HistoryResponse); i {
+			switch v := v.(*HistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1606,7 +1773,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockRequest_Acquire); i {
+			switch v := v.(*HistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1618,7 +1785,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockRequest_Extend); i {
+			switch v := v.(*LockRequest_Acquire); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1630,7 +1797,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockRequest_Release); i {
+			switch v := v.(*LockRequest_Extend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1642,7 +1809,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockResponse_Acquired); i {
+			switch v := v.(*LockRequest_Release); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1654,7 +1821,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockResponse_Extended); i {
+			switch v := v.(*LockResponse_Acquired); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1666,7 +1833,7 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LockResponse_Released); i {
+			switch v := v.(*LockResponse_Extended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1678,6 +1845,18 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 			}
 		}
 		file_windshift_locks_v1alpha1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockResponse_Released); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windshift_locks_v1alpha1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LockResponse_Rejected); i {
 			case 0:
 				return &v.state
@@ -1701,25 +1880,27 @@ func file_windshift_locks_v1alpha1_service_proto_init() {
 		(*LockResponse_Released_)(nil),
 		(*LockResponse_Rejected_)(nil),
 	}
-	file_windshift_locks_v1alpha1_service_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_windshift_locks_v1alpha1_service_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*MonitorResponse_Acquired)(nil),
 		(*MonitorResponse_Extended)(nil),
 		(*MonitorResponse_Released)(nil),
+		(*MonitorResponse_Expired)(nil),
 	}
-	file_windshift_locks_v1alpha1_service_proto_msgTypes[7].OneofWrappers = []interface{}{}
-	file_windshift_locks_v1alpha1_service_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_windshift_locks_v1alpha1_service_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_windshift_locks_v1alpha1_service_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*HistoryResponse_Acquired)(nil),
 		(*HistoryResponse_Extended)(nil),
 		(*HistoryResponse_Released)(nil),
+		(*HistoryResponse_Expired)(nil),
 	}
-	file_windshift_locks_v1alpha1_service_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_windshift_locks_v1alpha1_service_proto_msgTypes[10].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_windshift_locks_v1alpha1_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,230 @@
+package schedules
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// maxNextIterations limits how long Cron.Next searches for a matching time,
+// which is enough to cover several years.
+const maxNextIterations = 100_000
+
+// Cron is a parsed cron expression in the standard five field format,
+// `minute hour day-of-month month day-of-week`, evaluated in a time zone.
+//
+// Fields support `*`, single values, ranges such as `1-5`, steps such as
+// `*/15` or `0-30/10`, and lists of these separated by commas. Months and
+// days of the week can also be given by their three letter English names,
+// and both 0 and 7 are Sunday. The descriptors `@yearly`, `@annually`,
+// `@monthly`, `@weekly`, `@daily`, `@midnight` and `@hourly` are supported as
+// well.
+//
+// As in most cron implementations, if both the day of the month and the day
+// of the week are restricted a time matches if either of them matches.
+type Cron struct {
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// daysAny and weekdaysAny are set if the field was `*` or `?`.
+	daysAny     bool
+	weekdaysAny bool
+
+	location *time.Location
+}
+
+// cronField describes the allowed values of a field.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	dayField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	weekdayField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCron parses a cron expression, evaluating it in the given location.
+func ParseCron(expr string, location *time.Location) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.Newf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	c := &Cron{
+		location:    location,
+		daysAny:     fields[2] == "*" || fields[2] == "?",
+		weekdaysAny: fields[4] == "*" || fields[4] == "?",
+	}
+
+	var err error
+	if c.minutes, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+
+	if c.hours, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+
+	if c.days, err = dayField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+
+	if c.months, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+
+	if c.weekdays, err = weekdayField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+
+	// Sunday can be written as both 0 and 7
+	if c.weekdays&(1<<7) != 0 {
+		c.weekdays |= 1
+	}
+
+	return c, nil
+}
+
+// parse parses a field into a bit set of the values it matches.
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		start, end, step := f.min, f.max, 1
+
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, errors.Newf("invalid step in %s field: %s", f.name, part)
+			}
+		}
+
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			// Full range
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = f.value(from); err != nil {
+				return 0, err
+			}
+
+			if end, err = f.value(to); err != nil {
+				return 0, err
+			}
+		default:
+			value, err := f.value(rangePart)
+			if err != nil {
+				return 0, err
+			}
+
+			start = value
+			if !hasStep {
+				// A single value, with a step it is the start of a range
+				end = value
+			}
+		}
+
+		if start > end {
+			return 0, errors.Newf("invalid range in %s field: %s", f.name, part)
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+// value parses a single value of a field, either a number or a name.
+func (f cronField) value(s string) (int, error) {
+	if value, ok := f.names[strings.ToLower(s)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(s)
+	if err != nil || value < f.min || value > f.max {
+		return 0, errors.Newf("invalid value in %s field: %s", f.name, s)
+	}
+
+	return value, nil
+}
+
+// Next returns the first time after t that matches the expression. Returns
+// the zero time if no time matches, such as for `0 0 30 2 *`.
+func (c *Cron) Next(t time.Time) time.Time {
+	// Cron has a resolution of minutes, so start at the next whole minute
+	t = t.In(c.location).Truncate(time.Minute).Add(time.Minute)
+
+	for i := 0; i < maxNextIterations; i++ {
+		if c.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location)
+			continue
+		}
+
+		if !c.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+			continue
+		}
+
+		if c.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, c.location)
+			continue
+		}
+
+		if c.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchesDay checks if the day of t matches the day of the month and the
+// day of the week.
+func (c *Cron) matchesDay(t time.Time) bool {
+	day := c.days&(1<<uint(t.Day())) != 0
+	weekday := c.weekdays&(1<<uint(t.Weekday())) != 0
+
+	if c.daysAny || c.weekdaysAny {
+		return day && weekday
+	}
+
+	return day || weekday
+}
@@ -0,0 +1,63 @@
+package schedules_test
+
+import (
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/schedules"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron", func() {
+	start := time.Date(2024, time.January, 1, 10, 30, 15, 0, time.UTC) // A Monday
+
+	DescribeTable("next time",
+		func(expr string, expected time.Time) {
+			cron, err := schedules.ParseCron(expr, time.UTC)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cron.Next(start)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2024, time.January, 1, 10, 31, 0, 0, time.UTC)),
+		Entry("every 15 minutes", "*/15 * * * *", time.Date(2024, time.January, 1, 10, 45, 0, 0, time.UTC)),
+		Entry("hourly", "@hourly", time.Date(2024, time.January, 1, 11, 0, 0, 0, time.UTC)),
+		Entry("daily", "@daily", time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)),
+		Entry("range of hours", "0 9-17 * * *", time.Date(2024, time.January, 1, 11, 0, 0, 0, time.UTC)),
+		Entry("list of minutes", "10,20 * * * *", time.Date(2024, time.January, 1, 11, 10, 0, 0, time.UTC)),
+		Entry("day of week by name", "0 9 * * fri", time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 0 * * 7", time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)),
+		Entry("month by name", "0 0 1 mar *", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("leap day", "0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("day of month or day of week", "0 0 15 * sat", time.Date(2024, time.January, 6, 0, 0, 0, 0, time.UTC)),
+	)
+
+	It("evaluates in the time zone", func() {
+		location, err := time.LoadLocation("Europe/Stockholm")
+		Expect(err).ToNot(HaveOccurred())
+
+		cron, err := schedules.ParseCron("0 9 * * *", location)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cron.Next(start).Equal(time.Date(2024, time.January, 2, 8, 0, 0, 0, time.UTC))).To(BeTrue())
+	})
+
+	It("returns the zero time if never matching", func() {
+		cron, err := schedules.ParseCron("0 0 30 2 *", time.UTC)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cron.Next(start)).To(BeZero())
+	})
+
+	DescribeTable("invalid expressions",
+		func(expr string) {
+			_, err := schedules.ParseCron(expr, time.UTC)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("minute out of range", "60 * * * *"),
+		Entry("invalid step", "*/0 * * * *"),
+		Entry("reversed range", "30-10 * * * *"),
+		Entry("unknown name", "0 0 * * someday"),
+		Entry("unknown descriptor", "@sometimes"),
+	)
+})
@@ -0,0 +1,23 @@
+package schedules
+
+import "github.com/cockroachdb/errors"
+
+// ErrScheduleNotFound is used when a schedule does not exist.
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+type validationError struct {
+	err string
+}
+
+func (e *validationError) Error() string {
+	return e.err
+}
+
+func newValidationError(err string) error {
+	return &validationError{err: err}
+}
+
+func IsValidationError(err error) bool {
+	_, ok := err.(*validationError)
+	return ok
+}
@@ -0,0 +1,580 @@
+package schedules
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// BucketName is the name of the JetStream KeyValue bucket used to store
+// schedules.
+const BucketName = "windshift-schedules"
+
+// CatchUpPolicy controls what happens to ticks that were missed, such as
+// when no Windshift instance was running when they were due.
+type CatchUpPolicy string
+
+const (
+	// CatchUpSkip skips missed ticks, only ticks that are due from now on
+	// are published. This is the default.
+	CatchUpSkip CatchUpPolicy = "skip"
+	// CatchUpLatest publishes the most recent missed tick, and skips the
+	// earlier ones.
+	CatchUpLatest CatchUpPolicy = "latest"
+	// CatchUpAll publishes every missed tick, oldest first.
+	CatchUpAll CatchUpPolicy = "all"
+)
+
+// IsValid checks if the policy is one of the known policies.
+func (p CatchUpPolicy) IsValid() bool {
+	switch p {
+	case CatchUpSkip, CatchUpLatest, CatchUpAll:
+		return true
+	}
+
+	return false
+}
+
+// Manager runs schedules that publish events using cron expressions. The
+// schedules are stored in a JetStream KeyValue bucket, which is watched so
+// that every replica keeps a copy in memory. Every replica runs the
+// schedules, with the progress of each schedule updated using
+// compare-and-set and the events published with an idempotency key per
+// tick, so every tick is only published once.
+type Manager struct {
+	logger *zap.Logger
+	tracer trace.Tracer
+
+	js     jetstream.JetStream
+	events *events.Manager
+
+	// bucketMu protects bucket and watcher.
+	bucketMu sync.Mutex
+	bucket   jetstream.KeyValue
+	watcher  jetstream.KeyWatcher
+
+	// mu protects the fields below, which are kept up to date by the
+	// watcher.
+	mu sync.RWMutex
+	// revision is the latest revision of the bucket that has been applied.
+	revision uint64
+	// changed is closed and replaced every time revision changes.
+	changed   chan struct{}
+	schedules map[string]*Schedule
+}
+
+// ScheduleConfig is the configuration of a schedule.
+type ScheduleConfig struct {
+	// Name of the schedule.
+	Name string
+	// Cron is the cron expression controlling when events are published.
+	Cron string
+	// TimeZone is the IANA time zone the cron expression is evaluated in.
+	// Defaults to UTC if empty.
+	TimeZone string
+	// Subject to publish events to.
+	Subject string
+	// Data of every published event.
+	Data *anypb.Any
+	// Headers are custom headers to publish every event with. Optional.
+	Headers map[string]string
+	// CatchUp is the policy for missed ticks. Defaults to CatchUpSkip if
+	// empty.
+	CatchUp CatchUpPolicy
+}
+
+// Schedule is a schedule and its progress.
+type Schedule struct {
+	ScheduleConfig
+
+	// LastTick is the last tick that has been handled, or when the schedule
+	// was created or its timing was changed.
+	LastTick time.Time
+	// NextTick is when the next event will be published. Zero if the cron
+	// expression never matches.
+	NextTick time.Time
+	// Revision is the revision of the schedule in the bucket.
+	Revision uint64
+
+	cron *Cron
+}
+
+// scheduleData is what is stored in the bucket for every schedule.
+type scheduleData struct {
+	Cron     string            `json:"cron"`
+	TimeZone string            `json:"time_zone,omitempty"`
+	Subject  string            `json:"subject"`
+	DataType string            `json:"data_type"`
+	Data     []byte            `json:"data"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	CatchUp  CatchUpPolicy     `json:"catch_up"`
+	LastTick time.Time         `json:"last_tick"`
+}
+
+// NewManager creates a new schedule manager.
+func NewManager(
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	js jetstream.JetStream,
+	events *events.Manager,
+) (*Manager, error) {
+	return &Manager{
+		logger: logger,
+		tracer: tracer,
+
+		js:     js,
+		events: events,
+
+		changed:   make(chan struct{}),
+		schedules: make(map[string]*Schedule),
+	}, nil
+}
+
+// Destroy stops watching the bucket for changes.
+func (m *Manager) Destroy() {
+	m.bucketMu.Lock()
+	defer m.bucketMu.Unlock()
+
+	if m.watcher != nil {
+		_ = m.watcher.Stop()
+		m.watcher = nil
+	}
+}
+
+// getBucket returns the bucket used for schedules, creating it if needed.
+// The first call loads the contents of the bucket and starts watching it for
+// changes.
+func (m *Manager) getBucket(ctx context.Context) (jetstream.KeyValue, error) {
+	m.bucketMu.Lock()
+	defer m.bucketMu.Unlock()
+
+	if m.bucket != nil {
+		return m.bucket, nil
+	}
+
+	bucket, err := m.js.KeyValue(ctx, BucketName)
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		bucket, err = m.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+			Bucket:      BucketName,
+			Description: "Schedules managed by Windshift",
+		})
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			// Another replica created the bucket at the same time
+			bucket, err = m.js.KeyValue(ctx, BucketName)
+		}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get schedule bucket")
+	}
+
+	// The watcher outlives the request that created it, so it can not use
+	// its context
+	watcher, err := bucket.WatchAll(context.Background()) //nolint:contextcheck
+	if err != nil {
+		return nil, errors.Wrap(err, "could not watch schedule bucket")
+	}
+
+	// Apply everything currently in the bucket, a nil entry marks the end
+	// of the initial values
+	for {
+		var entry jetstream.KeyValueEntry
+		select {
+		case <-ctx.Done():
+			_ = watcher.Stop()
+			return nil, ctx.Err()
+		case entry = <-watcher.Updates():
+		}
+
+		if entry == nil {
+			break
+		}
+
+		m.apply(entry)
+	}
+
+	go func() {
+		for entry := range watcher.Updates() {
+			if entry != nil {
+				m.apply(entry)
+			}
+		}
+	}()
+
+	m.bucket = bucket
+	m.watcher = watcher
+	return bucket, nil
+}
+
+// apply updates the in-memory copy of the bucket with an entry.
+func (m *Manager) apply(entry jetstream.KeyValueEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := entry.Key()
+	if entry.Operation() != jetstream.KeyValuePut {
+		delete(m.schedules, name)
+	} else {
+		schedule, err := loadSchedule(name, entry.Value())
+		if err != nil {
+			m.logger.Error("Could not load schedule", zap.String("name", name), zap.Error(err))
+		} else {
+			schedule.Revision = entry.Revision()
+			m.schedules[name] = schedule
+		}
+	}
+
+	if entry.Revision() > m.revision {
+		m.revision = entry.Revision()
+		close(m.changed)
+		m.changed = make(chan struct{})
+	}
+}
+
+// waitForRevision waits until the watcher has applied a revision, so that
+// changes made by this replica are visible as soon as they are written.
+func (m *Manager) waitForRevision(ctx context.Context, revision uint64) error {
+	for {
+		m.mu.RLock()
+		done := m.revision >= revision
+		changed := m.changed
+		m.mu.RUnlock()
+
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// EnsureSchedule creates or updates a schedule. Changing the cron expression
+// or time zone of an existing schedule resets its progress, so ticks that
+// were due under the old timing are not caught up.
+func (m *Manager) EnsureSchedule(ctx context.Context, config *ScheduleConfig) (*Schedule, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schedules.EnsureSchedule",
+		trace.WithAttributes(
+			attribute.String("windshift.schedule.name", config.Name),
+		),
+	)
+	defer span.End()
+
+	err := validateConfig(config)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid schedule")
+		return nil, err
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	data := &scheduleData{
+		Cron:     config.Cron,
+		TimeZone: config.TimeZone,
+		Subject:  config.Subject,
+		DataType: config.Data.TypeUrl,
+		Data:     config.Data.Value,
+		Headers:  config.Headers,
+		CatchUp:  config.CatchUp,
+	}
+	if data.CatchUp == "" {
+		data.CatchUp = CatchUpSkip
+	}
+
+	for {
+		var revision uint64
+		data.LastTick = time.Now()
+		entry, err2 := bucket.Get(ctx, config.Name)
+		if err2 == nil {
+			var existing scheduleData
+			if json.Unmarshal(entry.Value(), &existing) == nil &&
+				existing.Cron == data.Cron &&
+				existing.TimeZone == data.TimeZone {
+				// Keep the progress when the timing has not changed
+				data.LastTick = existing.LastTick
+			}
+
+			revision, err2 = m.put(ctx, bucket, config.Name, data, entry.Revision())
+		} else if errors.Is(err2, jetstream.ErrKeyNotFound) {
+			revision, err2 = m.put(ctx, bucket, config.Name, data, 0)
+		}
+
+		if errors.Is(err2, jetstream.ErrKeyExists) || isRevisionMismatch(err2) {
+			// Changed by someone else, such as a tick being handled
+			continue
+		} else if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to store schedule")
+			return nil, errors.Wrap(err2, "could not store schedule")
+		}
+
+		err2 = m.waitForRevision(ctx, revision)
+		if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to wait for schedule")
+			return nil, err2
+		}
+
+		m.logger.Info("Schedule ensured", zap.String("name", config.Name), zap.String("cron", config.Cron))
+		span.SetStatus(codes.Ok, "")
+		return m.GetSchedule(ctx, config.Name)
+	}
+}
+
+// put stores a schedule, using compare-and-set on the revision. A revision of
+// zero creates the schedule. Fails with a revision mismatch if the schedule
+// has been changed since it was read.
+func (m *Manager) put(ctx context.Context, bucket jetstream.KeyValue, name string, data *scheduleData, revision uint64) (uint64, error) {
+	value, err := json.Marshal(data)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not encode schedule")
+	}
+
+	if revision == 0 {
+		return bucket.Create(ctx, name, value)
+	}
+
+	return bucket.Update(ctx, name, value, revision)
+}
+
+// GetSchedule returns a schedule, or ErrScheduleNotFound if it does not
+// exist.
+func (m *Manager) GetSchedule(ctx context.Context, name string) (*Schedule, error) {
+	if !IsValidScheduleName(name) {
+		return nil, newValidationError("invalid schedule name: " + name)
+	}
+
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	schedule, ok := m.schedules[name]
+	if !ok {
+		return nil, errors.WithStack(ErrScheduleNotFound)
+	}
+
+	return schedule, nil
+}
+
+// ListSchedules returns all schedules, sorted by name.
+func (m *Manager) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*Schedule, 0, len(m.schedules))
+	for _, schedule := range m.schedules {
+		result = append(result, schedule)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// DeleteSchedule removes a schedule, or returns ErrScheduleNotFound if it
+// does not exist.
+func (m *Manager) DeleteSchedule(ctx context.Context, name string) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schedules.DeleteSchedule",
+		trace.WithAttributes(
+			attribute.String("windshift.schedule.name", name),
+		),
+	)
+	defer span.End()
+
+	_, err := m.GetSchedule(ctx, name)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to get schedule")
+		return err
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return err
+	}
+
+	err = bucket.Delete(ctx, name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to delete schedule")
+		return errors.Wrap(err, "could not delete schedule")
+	}
+
+	// Wait for the deletion to be applied so it is visible directly
+	err = m.waitFor(ctx, name)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to wait for deletion")
+		return err
+	}
+
+	m.logger.Info("Schedule deleted", zap.String("name", name))
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// waitFor waits until a deleted schedule has been removed from the
+// in-memory copy.
+func (m *Manager) waitFor(ctx context.Context, name string) error {
+	for {
+		m.mu.RLock()
+		_, exists := m.schedules[name]
+		changed := m.changed
+		m.mu.RUnlock()
+
+		if !exists {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// validateConfig checks that a schedule can be stored.
+func validateConfig(config *ScheduleConfig) error {
+	if !IsValidScheduleName(config.Name) {
+		return newValidationError("invalid schedule name: " + config.Name)
+	}
+
+	if !events.IsValidSubject(config.Subject, false) {
+		return newValidationError("invalid subject: " + config.Subject)
+	}
+
+	if config.Data == nil {
+		return newValidationError("no data specified")
+	}
+
+	for name := range config.Headers {
+		if !events.IsValidHeaderName(name) {
+			return newValidationError("invalid header name: " + name)
+		}
+	}
+
+	if config.CatchUp != "" && !config.CatchUp.IsValid() {
+		return newValidationError("invalid catch-up policy: " + string(config.CatchUp))
+	}
+
+	location, err := loadLocation(config.TimeZone)
+	if err != nil {
+		return newValidationError("invalid time zone: " + config.TimeZone)
+	}
+
+	cron, err := ParseCron(config.Cron, location)
+	if err != nil {
+		return newValidationError("invalid cron expression: " + err.Error())
+	}
+
+	if cron.Next(time.Now()).IsZero() {
+		return newValidationError("cron expression never matches: " + config.Cron)
+	}
+
+	return nil
+}
+
+// loadLocation loads a time zone, defaulting to UTC.
+func loadLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+
+	return time.LoadLocation(timeZone)
+}
+
+// loadSchedule decodes a schedule stored in the bucket.
+func loadSchedule(name string, value []byte) (*Schedule, error) {
+	var data scheduleData
+	err := json.Unmarshal(value, &data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode schedule")
+	}
+
+	location, err := loadLocation(data.TimeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load time zone")
+	}
+
+	cron, err := ParseCron(data.Cron, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schedule{
+		ScheduleConfig: ScheduleConfig{
+			Name:     name,
+			Cron:     data.Cron,
+			TimeZone: data.TimeZone,
+			Subject:  data.Subject,
+			Data: &anypb.Any{
+				TypeUrl: data.DataType,
+				Value:   data.Data,
+			},
+			Headers: data.Headers,
+			CatchUp: data.CatchUp,
+		},
+		LastTick: data.LastTick,
+		NextTick: cron.Next(data.LastTick),
+		cron:     cron,
+	}, nil
+}
+
+// toData converts a schedule back into what is stored in the bucket.
+func (s *Schedule) toData() *scheduleData {
+	return &scheduleData{
+		Cron:     s.Cron,
+		TimeZone: s.TimeZone,
+		Subject:  s.Subject,
+		DataType: s.Data.TypeUrl,
+		Data:     s.Data.Value,
+		Headers:  s.Headers,
+		CatchUp:  s.CatchUp,
+		LastTick: s.LastTick,
+	}
+}
+
+func isRevisionMismatch(err error) bool {
+	var apiError *jetstream.APIError
+	if errors.As(err, &apiError) {
+		return apiError.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence
+	}
+
+	return false
+}
@@ -0,0 +1,312 @@
+package schedules_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/schedules"
+
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Schedules", func() {
+	var manager *schedules.Manager
+	var eventsManager *events.Manager
+	var js jetstream.JetStream
+
+	BeforeEach(func(ctx context.Context) {
+		manager, eventsManager, js = createManagers()
+
+		_, err := eventsManager.EnsureStream(ctx, &events.StreamConfig{
+			Name:     "events",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	ensure := func(ctx context.Context, name string, cron string, catchUp schedules.CatchUpPolicy) *schedules.Schedule {
+		GinkgoHelper()
+
+		schedule, err := manager.EnsureSchedule(ctx, &schedules.ScheduleConfig{
+			Name:    name,
+			Cron:    cron,
+			Subject: "events.test",
+			Data:    Data(&emptypb.Empty{}),
+			CatchUp: catchUp,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule
+	}
+
+	// rewind moves the progress of a schedule back in time, as if no
+	// replica had been running since then.
+	rewind := func(ctx context.Context, name string, lastTick time.Time) {
+		GinkgoHelper()
+
+		bucket, err := js.KeyValue(ctx, schedules.BucketName)
+		Expect(err).ToNot(HaveOccurred())
+
+		entry, err := bucket.Get(ctx, name)
+		Expect(err).ToNot(HaveOccurred())
+
+		var data map[string]any
+		Expect(json.Unmarshal(entry.Value(), &data)).To(Succeed())
+		data["last_tick"] = lastTick
+
+		value, err := json.Marshal(data)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = bucket.Update(ctx, name, value, entry.Revision())
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	consume := func(ctx context.Context) *events.Events {
+		GinkgoHelper()
+
+		_, err := eventsManager.EnsureConsumer(ctx, &events.ConsumerConfig{
+			Stream:   "events",
+			Name:     "test",
+			Subjects: []string{"events.>"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		ec, err := eventsManager.Events(ctx, &events.EventConsumeConfig{
+			Stream: "events",
+			Name:   "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(ec.Close)
+		return ec
+	}
+
+	start := func(ctx context.Context, m *schedules.Manager) {
+		GinkgoHelper()
+
+		stop, err := m.Start(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(stop)
+	}
+
+	// cronMinutesAgo returns a cron expression that matches once an hour,
+	// with the latest match the given number of minutes ago.
+	cronMinutesAgo := func(minutes int) string {
+		return fmt.Sprintf("%d * * * *", time.Now().Add(-time.Duration(minutes)*time.Minute).Minute())
+	}
+
+	Describe("EnsureSchedule", func() {
+		It("can create a schedule", func(ctx context.Context) {
+			schedule, err := manager.EnsureSchedule(ctx, &schedules.ScheduleConfig{
+				Name:     "test",
+				Cron:     "0 9 * * mon-fri",
+				TimeZone: "Europe/Stockholm",
+				Subject:  "events.test",
+				Data:     Data(&emptypb.Empty{}),
+				Headers:  map[string]string{"team": "billing"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Name).To(Equal("test"))
+			Expect(schedule.Cron).To(Equal("0 9 * * mon-fri"))
+			Expect(schedule.TimeZone).To(Equal("Europe/Stockholm"))
+			Expect(schedule.CatchUp).To(Equal(schedules.CatchUpSkip))
+			Expect(schedule.Headers).To(HaveKeyWithValue("team", "billing"))
+			Expect(schedule.NextTick).To(BeTemporally(">", time.Now()))
+		})
+
+		It("keeps progress if the timing is unchanged", func(ctx context.Context) {
+			ensure(ctx, "test", "@hourly", schedules.CatchUpSkip)
+			lastTick := time.Now().Add(-time.Hour).Truncate(time.Second)
+			rewind(ctx, "test", lastTick)
+
+			schedule := ensure(ctx, "test", "@hourly", schedules.CatchUpAll)
+			Expect(schedule.CatchUp).To(Equal(schedules.CatchUpAll))
+			Expect(schedule.LastTick).To(BeTemporally("==", lastTick))
+		})
+
+		It("resets progress if the cron expression changes", func(ctx context.Context) {
+			ensure(ctx, "test", "@hourly", schedules.CatchUpSkip)
+			rewind(ctx, "test", time.Now().Add(-time.Hour))
+
+			schedule := ensure(ctx, "test", "@daily", schedules.CatchUpSkip)
+			Expect(schedule.LastTick).To(BeTemporally("~", time.Now(), time.Second))
+		})
+
+		DescribeTable("invalid schedules fail",
+			func(ctx context.Context, config *schedules.ScheduleConfig) {
+				_, err := manager.EnsureSchedule(ctx, config)
+				Expect(schedules.IsValidationError(err)).To(BeTrue())
+			},
+			Entry("invalid name", &schedules.ScheduleConfig{
+				Name:    "invalid name",
+				Cron:    "@hourly",
+				Subject: "events.test",
+				Data:    emptyData,
+			}),
+			Entry("invalid cron", &schedules.ScheduleConfig{
+				Name:    "test",
+				Cron:    "every hour",
+				Subject: "events.test",
+				Data:    emptyData,
+			}),
+			Entry("cron that never matches", &schedules.ScheduleConfig{
+				Name:    "test",
+				Cron:    "0 0 31 2 *",
+				Subject: "events.test",
+				Data:    emptyData,
+			}),
+			Entry("invalid time zone", &schedules.ScheduleConfig{
+				Name:     "test",
+				Cron:     "@hourly",
+				TimeZone: "Mars/Olympus",
+				Subject:  "events.test",
+				Data:     emptyData,
+			}),
+			Entry("wildcard subject", &schedules.ScheduleConfig{
+				Name:    "test",
+				Cron:    "@hourly",
+				Subject: "events.*",
+				Data:    emptyData,
+			}),
+			Entry("no data", &schedules.ScheduleConfig{
+				Name:    "test",
+				Cron:    "@hourly",
+				Subject: "events.test",
+			}),
+			Entry("invalid catch-up policy", &schedules.ScheduleConfig{
+				Name:    "test",
+				Cron:    "@hourly",
+				Subject: "events.test",
+				Data:    emptyData,
+				CatchUp: "sometimes",
+			}),
+		)
+	})
+
+	Describe("GetSchedule and ListSchedules", func() {
+		It("can get a schedule", func(ctx context.Context) {
+			ensure(ctx, "test", "@hourly", schedules.CatchUpSkip)
+
+			schedule, err := manager.GetSchedule(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Cron).To(Equal("@hourly"))
+		})
+
+		It("getting a missing schedule fails", func(ctx context.Context) {
+			_, err := manager.GetSchedule(ctx, "missing")
+			Expect(err).To(MatchError(schedules.ErrScheduleNotFound))
+		})
+
+		It("lists schedules sorted by name", func(ctx context.Context) {
+			ensure(ctx, "b", "@hourly", schedules.CatchUpSkip)
+			ensure(ctx, "a", "@daily", schedules.CatchUpSkip)
+
+			list, err := manager.ListSchedules(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].Name).To(Equal("a"))
+			Expect(list[1].Name).To(Equal("b"))
+		})
+
+		It("schedules are visible to other replicas", func(ctx context.Context) {
+			ensure(ctx, "test", "@hourly", schedules.CatchUpSkip)
+
+			other := createScheduleManager(js, eventsManager)
+			schedule, err := other.GetSchedule(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Cron).To(Equal("@hourly"))
+		})
+	})
+
+	Describe("DeleteSchedule", func() {
+		It("can delete a schedule", func(ctx context.Context) {
+			ensure(ctx, "test", "@hourly", schedules.CatchUpSkip)
+
+			err := manager.DeleteSchedule(ctx, "test")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.GetSchedule(ctx, "test")
+			Expect(err).To(MatchError(schedules.ErrScheduleNotFound))
+		})
+
+		It("deleting a missing schedule fails", func(ctx context.Context) {
+			err := manager.DeleteSchedule(ctx, "missing")
+			Expect(err).To(MatchError(schedules.ErrScheduleNotFound))
+		})
+	})
+
+	Describe("Catching up", func() {
+		It("skips missed ticks by default", func(ctx context.Context) {
+			ec := consume(ctx)
+			ensure(ctx, "test", cronMinutesAgo(30), "")
+			rewind(ctx, "test", time.Now().Add(-3*time.Hour))
+			start(ctx, manager)
+
+			Eventually(func(g Gomega, ctx context.Context) {
+				schedule, err := manager.GetSchedule(ctx, "test")
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(schedule.NextTick).To(BeTemporally(">", time.Now()))
+			}).WithContext(ctx).Should(Succeed())
+
+			Consistently(ec.Incoming(), 200*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("publishes the latest missed tick", func(ctx context.Context) {
+			ec := consume(ctx)
+			ensure(ctx, "test", cronMinutesAgo(30), schedules.CatchUpLatest)
+			rewind(ctx, "test", time.Now().Add(-3*time.Hour))
+			start(ctx, manager)
+
+			var event *events.Event
+			Eventually(ec.Incoming()).WithContext(ctx).Should(Receive(&event))
+			Expect(event.Subject).To(Equal("events.test"))
+			Expect(event.Headers.PublishedAt).To(BeTemporally("~", time.Now().Add(-30*time.Minute), time.Minute))
+			Expect(event.Headers.Custom).To(HaveKeyWithValue("schedule", "test"))
+			Expect(event.Ack()).To(Succeed())
+
+			Consistently(ec.Incoming(), 200*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("publishes all missed ticks", func(ctx context.Context) {
+			ec := consume(ctx)
+			ensure(ctx, "test", cronMinutesAgo(30), schedules.CatchUpAll)
+			rewind(ctx, "test", time.Now().Add(-3*time.Hour))
+			start(ctx, manager)
+
+			var published []time.Time
+			for len(published) < 3 {
+				var event *events.Event
+				Eventually(ec.Incoming()).WithContext(ctx).Should(Receive(&event))
+				published = append(published, event.Headers.PublishedAt)
+				Expect(event.Ack()).To(Succeed())
+			}
+
+			Expect(published[1].Sub(published[0])).To(Equal(time.Hour))
+			Expect(published[2].Sub(published[1])).To(Equal(time.Hour))
+			Consistently(ec.Incoming(), 200*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("publishes every tick once with several replicas", func(ctx context.Context) {
+			ec := consume(ctx)
+			ensure(ctx, "test", cronMinutesAgo(30), schedules.CatchUpAll)
+			rewind(ctx, "test", time.Now().Add(-3*time.Hour))
+
+			start(ctx, manager)
+			start(ctx, createScheduleManager(js, eventsManager))
+
+			for i := 0; i < 3; i++ {
+				var event *events.Event
+				Eventually(ec.Incoming()).WithContext(ctx).Should(Receive(&event))
+				Expect(event.Ack()).To(Succeed())
+			}
+
+			Consistently(ec.Incoming(), 500*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
+
+var emptyData, _ = anypb.New(&emptypb.Empty{})
@@ -0,0 +1,65 @@
+package schedules
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/levelfourab/sprout-go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Module for FX that runs schedules, publishing events to subjects on a
+// recurring basis.
+var Module = fx.Module(
+	"schedules",
+	fx.Provide(sprout.Logger("schedules"), fx.Private),
+	fx.Provide(sprout.ServiceTracer(), fx.Private),
+	fx.Provide(newManager),
+	fx.Invoke(startRunner),
+)
+
+// newManager creates the manager and stops watching for changes when the
+// application stops.
+func newManager(
+	lifecycle fx.Lifecycle,
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	js jetstream.JetStream,
+	events *events.Manager,
+) (*Manager, error) {
+	manager, err := NewManager(logger, tracer, js, events)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			manager.Destroy()
+			return nil
+		},
+	})
+	return manager, nil
+}
+
+// startRunner publishes events for schedules while the application is
+// running.
+func startRunner(lifecycle fx.Lifecycle, manager *Manager) {
+	var stop func()
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var err error
+			stop, err = manager.Start(ctx)
+			return err
+		},
+		OnStop: func(context.Context) error {
+			if stop != nil {
+				stop()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,10 @@
+package schedules
+
+import "github.com/levelfourab/windshift-server/internal/events"
+
+// IsValidScheduleName checks if the schedule name is valid. Schedule names
+// follow the same rules as stream names and allow only the characters
+// `a`-`z`, `A`-`Z`, `0`-`9`, `_`, and `-`.
+func IsValidScheduleName(name string) bool {
+	return events.IsValidStreamName(name)
+}
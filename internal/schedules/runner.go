@@ -0,0 +1,230 @@
+package schedules
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	// runInterval is how often schedules are checked for due ticks.
+	runInterval = time.Second
+	// missedThreshold is how late a tick can be handled before it is
+	// considered missed and handled according to the catch-up policy.
+	missedThreshold = time.Minute
+	// maxTicksPerRun limits how many ticks of a single schedule are
+	// published every run, so catching up does not block other schedules.
+	maxTicksPerRun = 1000
+	// scheduleHeader is the custom header used to record the schedule an
+	// event was published by.
+	scheduleHeader = "schedule"
+)
+
+// Start starts publishing events for schedules as they become due. Returns a
+// function that stops publishing.
+func (m *Manager) Start(ctx context.Context) (func(), error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Running outlives the context used to start it
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(runInterval)
+		defer ticker.Stop()
+
+		for {
+			m.run(runCtx, time.Now())
+
+			select {
+			case <-runCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
+
+// run publishes the due ticks of all schedules.
+func (m *Manager) run(ctx context.Context, now time.Time) {
+	m.mu.RLock()
+	schedules := make([]*Schedule, 0, len(m.schedules))
+	for _, schedule := range m.schedules {
+		if !schedule.NextTick.IsZero() && !schedule.NextTick.After(now) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, schedule := range schedules {
+		if ctx.Err() != nil {
+			return
+		}
+
+		err := m.runSchedule(ctx, schedule, now)
+		if err != nil {
+			m.logger.Warn("Could not run schedule", zap.String("name", schedule.Name), zap.Error(err))
+		}
+	}
+}
+
+// runSchedule publishes the due ticks of a schedule. Every replica may run
+// the same schedule at the same time, so the ticks are first claimed by
+// advancing the progress of the schedule using compare-and-set, and only the
+// replica that claimed them publishes them.
+func (m *Manager) runSchedule(ctx context.Context, schedule *Schedule, now time.Time) error {
+	ticks := dueTicks(schedule, now)
+	if len(ticks) == 0 {
+		return nil
+	}
+
+	// Ticks that are skipped still move the schedule forward
+	toPublish := ticks
+	if schedule.CatchUp != CatchUpAll {
+		toPublish = nil
+		latest := ticks[len(ticks)-1]
+		if now.Sub(latest) <= missedThreshold || schedule.CatchUp == CatchUpLatest {
+			toPublish = ticks[len(ticks)-1:]
+		}
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	data := schedule.toData()
+	data.LastTick = ticks[len(ticks)-1]
+	revision, err := m.put(ctx, bucket, schedule.Name, data, schedule.Revision)
+	if errors.Is(err, jetstream.ErrKeyExists) || isRevisionMismatch(err) {
+		// Another replica has claimed these ticks, or the schedule was
+		// changed
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "could not claim ticks of schedule")
+	}
+
+	// The ticks are claimed, stopping should not drop them halfway
+	ctx = context.WithoutCancel(ctx)
+	for i, tick := range toPublish {
+		err := m.publishTick(ctx, schedule, tick)
+		if err == nil {
+			continue
+		}
+
+		if isPermanentPublishError(err) {
+			m.logger.Warn(
+				"Could not publish event for schedule, skipping tick",
+				zap.String("name", schedule.Name),
+				zap.Time("tick", tick),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Give the ticks back so they are tried again on the next run
+		data.LastTick = schedule.LastTick
+		if i > 0 {
+			data.LastTick = toPublish[i-1]
+		}
+
+		_, err2 := m.put(ctx, bucket, schedule.Name, data, revision)
+		if err2 != nil {
+			m.logger.Warn(
+				"Could not release ticks of schedule, ticks will be skipped",
+				zap.String("name", schedule.Name),
+				zap.Time("tick", tick),
+				zap.Error(err2),
+			)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// dueTicks returns the ticks of a schedule that are due, oldest first. The
+// number of ticks is limited to maxTicksPerRun. Only the latest ticks are
+// needed unless every missed tick is caught up, so for other policies the
+// ticks are dropped as they are found.
+func dueTicks(schedule *Schedule, now time.Time) []time.Time {
+	var ticks []time.Time
+	for tick := schedule.NextTick; !tick.IsZero() && !tick.After(now); tick = schedule.cron.Next(tick) {
+		if schedule.CatchUp != CatchUpAll && len(ticks) > 0 {
+			ticks = ticks[:0]
+		}
+
+		ticks = append(ticks, tick)
+		if len(ticks) >= maxTicksPerRun {
+			break
+		}
+	}
+
+	return ticks
+}
+
+// publishTick publishes the event for a single tick.
+func (m *Manager) publishTick(ctx context.Context, schedule *Schedule, tick time.Time) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schedules.Tick",
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("windshift.schedule.name", schedule.Name),
+			attribute.String("windshift.schedule.tick", tick.Format(time.RFC3339)),
+		),
+	)
+	defer span.End()
+
+	headers := make(map[string]string, len(schedule.Headers)+1)
+	for name, value := range schedule.Headers {
+		headers[name] = value
+	}
+
+	if _, ok := headers[scheduleHeader]; !ok {
+		headers[scheduleHeader] = schedule.Name
+	}
+
+	_, err := m.events.Publish(ctx, &events.PublishConfig{
+		Subject:        schedule.Subject,
+		Data:           schedule.Data,
+		PublishedTime:  &tick,
+		IdempotencyKey: fmt.Sprintf("windshift-schedule:%s:%d", schedule.Name, tick.Unix()),
+		Headers:        headers,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to publish event")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// isPermanentPublishError checks if publishing failed in a way that will not
+// succeed if tried again.
+func isPermanentPublishError(err error) bool {
+	return errors.Is(err, events.ErrUnboundSubject) ||
+		errors.Is(err, events.ErrInvalidData) ||
+		events.IsValidationError(err)
+}
@@ -0,0 +1,232 @@
+package schemas
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Bindings contains all subject and store bindings.
+type Bindings struct {
+	// Subjects are the subject bindings sorted by pattern.
+	Subjects []*SubjectBinding
+	// Stores are the store bindings sorted by store.
+	Stores []*StoreBinding
+}
+
+// EnsureSubjectBinding binds message types to a subject pattern, replacing
+// any existing binding of the pattern. All types must be registered.
+func (m *Manager) EnsureSubjectBinding(ctx context.Context, binding *SubjectBinding) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.EnsureSubjectBinding",
+		trace.WithAttributes(
+			attribute.String("pattern", binding.Pattern),
+			attribute.StringSlice("types", binding.Types),
+		),
+	)
+	defer span.End()
+
+	if !events.IsValidSubject(binding.Pattern, true) {
+		span.SetStatus(codes.Error, "invalid subject pattern")
+		return newValidationError("invalid subject pattern: " + binding.Pattern)
+	}
+
+	err := m.putBinding(ctx, subjectKey(binding.Pattern), binding.Types, binding)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// DeleteSubjectBinding removes the binding of a subject pattern. Returns
+// ErrBindingNotFound if the pattern is not bound.
+func (m *Manager) DeleteSubjectBinding(ctx context.Context, pattern string) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.DeleteSubjectBinding",
+		trace.WithAttributes(
+			attribute.String("pattern", pattern),
+		),
+	)
+	defer span.End()
+
+	if !events.IsValidSubject(pattern, true) {
+		span.SetStatus(codes.Error, "invalid subject pattern")
+		return newValidationError("invalid subject pattern: " + pattern)
+	}
+
+	err := m.deleteBinding(ctx, subjectKey(pattern), func() bool {
+		_, ok := m.subjects[pattern]
+		return !ok
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// EnsureStoreBinding binds message types to a store, replacing any existing
+// binding of the store. All types must be registered.
+func (m *Manager) EnsureStoreBinding(ctx context.Context, binding *StoreBinding) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.EnsureStoreBinding",
+		trace.WithAttributes(
+			attribute.String("store", binding.Store),
+			attribute.StringSlice("types", binding.Types),
+		),
+	)
+	defer span.End()
+
+	if !state.IsValidStoreName(binding.Store) {
+		span.SetStatus(codes.Error, "invalid store name")
+		return newValidationError("invalid store name: " + binding.Store)
+	}
+
+	err := m.putBinding(ctx, storeKeyPrefix+binding.Store, binding.Types, binding)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// DeleteStoreBinding removes the binding of a store. Returns
+// ErrBindingNotFound if the store is not bound.
+func (m *Manager) DeleteStoreBinding(ctx context.Context, store string) error {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.DeleteStoreBinding",
+		trace.WithAttributes(
+			attribute.String("store", store),
+		),
+	)
+	defer span.End()
+
+	if !state.IsValidStoreName(store) {
+		span.SetStatus(codes.Error, "invalid store name")
+		return newValidationError("invalid store name: " + store)
+	}
+
+	err := m.deleteBinding(ctx, storeKeyPrefix+store, func() bool {
+		_, ok := m.stores[store]
+		return !ok
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return nil
+}
+
+// ListBindings returns all subject and store bindings.
+func (m *Manager) ListBindings(ctx context.Context) (*Bindings, error) {
+	ctx, span := m.tracer.Start(ctx, "windshift.schemas.ListBindings")
+	defer span.End()
+
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	m.mu.RLock()
+	bindings := &Bindings{
+		Subjects: make([]*SubjectBinding, 0, len(m.subjects)),
+		Stores:   make([]*StoreBinding, 0, len(m.stores)),
+	}
+	for _, binding := range m.subjects {
+		bindings.Subjects = append(bindings.Subjects, binding)
+	}
+	for _, binding := range m.stores {
+		bindings.Stores = append(bindings.Stores, binding)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(bindings.Subjects, func(i, j int) bool {
+		return bindings.Subjects[i].Pattern < bindings.Subjects[j].Pattern
+	})
+	sort.Slice(bindings.Stores, func(i, j int) bool {
+		return bindings.Stores[i].Store < bindings.Stores[j].Store
+	})
+
+	span.SetStatus(codes.Ok, "")
+	return bindings, nil
+}
+
+// putBinding stores a binding after checking that all of its types are registered.
+func (m *Manager) putBinding(ctx context.Context, key string, types []string, binding any) error {
+	if len(types) == 0 {
+		return newValidationError("at least one type must be bound")
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	for _, name := range types {
+		if _, ok := m.types[name]; !ok {
+			m.mu.RUnlock()
+			return errors.Wrapf(ErrSchemaNotFound, "type %s", name)
+		}
+	}
+	m.mu.RUnlock()
+
+	data, err := json.Marshal(binding)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal binding")
+	}
+
+	revision, err := bucket.Put(ctx, key, data)
+	if err != nil {
+		return errors.Wrap(err, "could not store binding")
+	}
+
+	return m.waitForRevision(ctx, revision)
+}
+
+// deleteBinding removes a binding, and waits until removed reports that the
+// deletion has been applied.
+func (m *Manager) deleteBinding(ctx context.Context, key string, removed func() bool) error {
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	entry, err := bucket.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return errors.WithStack(ErrBindingNotFound)
+	} else if err != nil {
+		return errors.Wrap(err, "could not get binding")
+	}
+
+	err = bucket.Delete(ctx, key, jetstream.LastRevision(entry.Revision()))
+	if err != nil {
+		return errors.Wrap(err, "could not delete binding")
+	}
+
+	return m.waitFor(ctx, removed)
+}
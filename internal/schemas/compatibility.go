@@ -0,0 +1,252 @@
+package schemas
+
+import (
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Compatibility is the rule used to check a new version of a message type
+// against earlier versions of it.
+type Compatibility string
+
+const (
+	// CompatibilityDefault uses the compatibility configured for the manager.
+	CompatibilityDefault Compatibility = ""
+	// CompatibilityBackward requires that data written with earlier versions
+	// can be read with the new version.
+	CompatibilityBackward Compatibility = "backward"
+	// CompatibilityForward requires that data written with the new version
+	// can be read with earlier versions.
+	CompatibilityForward Compatibility = "forward"
+	// CompatibilityFull requires both backward and forward compatibility.
+	CompatibilityFull Compatibility = "full"
+	// CompatibilityNone disables the checks.
+	CompatibilityNone Compatibility = "none"
+)
+
+// IsValid checks if the compatibility is one of the known rules.
+func (c Compatibility) IsValid() bool {
+	switch c {
+	case CompatibilityBackward, CompatibilityForward, CompatibilityFull, CompatibilityNone:
+		return true
+	}
+
+	return false
+}
+
+// breaks describes in which direction a change breaks compatibility.
+type breaks int
+
+const (
+	// breaksBackward means that data written with the earlier version can
+	// not be read with the new version.
+	breaksBackward breaks = 1 << iota
+	// breaksForward means that data written with the new version can not be
+	// read with the earlier version.
+	breaksForward
+	// breaksBoth is used for changes that break both directions.
+	breaksBoth = breaksBackward | breaksForward
+)
+
+// change is a difference between two versions of a message type.
+type change struct {
+	description string
+	breaks      breaks
+}
+
+// compatibilityChanges returns the changes between two versions of a message
+// type that break the given compatibility.
+func compatibilityChanges(compatibility Compatibility, previous protoreflect.MessageDescriptor, current protoreflect.MessageDescriptor) []string {
+	var mask breaks
+	switch compatibility {
+	case CompatibilityBackward:
+		mask = breaksBackward
+	case CompatibilityForward:
+		mask = breaksForward
+	case CompatibilityFull:
+		mask = breaksBoth
+	default:
+		return nil
+	}
+
+	c := &comparison{
+		seen: make(map[protoreflect.FullName]bool),
+	}
+	c.compareMessages(previous, current)
+
+	var res []string
+	for _, change := range c.changes {
+		if change.breaks&mask != 0 {
+			res = append(res, change.description)
+		}
+	}
+
+	return res
+}
+
+// comparison collects the changes between two versions of a message type,
+// including the message and enum types used by its fields.
+type comparison struct {
+	changes []change
+	seen    map[protoreflect.FullName]bool
+}
+
+func (c *comparison) add(b breaks, format string, args ...any) {
+	c.changes = append(c.changes, change{
+		description: fmt.Sprintf(format, args...),
+		breaks:      b,
+	})
+}
+
+func (c *comparison) compareMessages(previous protoreflect.MessageDescriptor, current protoreflect.MessageDescriptor) {
+	name := current.FullName()
+	if c.seen[name] {
+		return
+	}
+	c.seen[name] = true
+
+	previousFields := previous.Fields()
+	currentFields := current.Fields()
+
+	for i := 0; i < previousFields.Len(); i++ {
+		previousField := previousFields.Get(i)
+		currentField := currentFields.ByNumber(previousField.Number())
+		if currentField == nil {
+			if !current.ReservedRanges().Has(previousField.Number()) {
+				c.add(breaksBoth, "%s: field %d %q removed without reserving its number", name, previousField.Number(), previousField.Name())
+			}
+
+			if previousField.Cardinality() == protoreflect.Required {
+				c.add(breaksForward, "%s: required field %d %q removed", name, previousField.Number(), previousField.Name())
+			}
+			continue
+		}
+
+		c.compareFields(name, previousField, currentField)
+	}
+
+	for i := 0; i < currentFields.Len(); i++ {
+		currentField := currentFields.Get(i)
+		if previousFields.ByNumber(currentField.Number()) != nil {
+			continue
+		}
+
+		if previous.ReservedRanges().Has(currentField.Number()) {
+			c.add(breaksBoth, "%s: field %d %q reuses a reserved number", name, currentField.Number(), currentField.Name())
+		} else if previous.ReservedNames().Has(currentField.Name()) {
+			c.add(breaksBoth, "%s: field %d %q reuses a reserved name", name, currentField.Number(), currentField.Name())
+		}
+
+		if currentField.Cardinality() == protoreflect.Required {
+			c.add(breaksBackward, "%s: required field %d %q added", name, currentField.Number(), currentField.Name())
+		}
+	}
+}
+
+func (c *comparison) compareFields(message protoreflect.FullName, previous protoreflect.FieldDescriptor, current protoreflect.FieldDescriptor) {
+	number := current.Number()
+	if previous.Name() != current.Name() {
+		c.add(breaksBoth, "%s: field number %d reused, was %q and is now %q", message, number, previous.Name(), current.Name())
+	}
+
+	previousType := fieldType(previous)
+	currentType := fieldType(current)
+	if previousType != currentType {
+		c.add(breaksBoth, "%s: field %d %q changed type from %s to %s", message, number, current.Name(), previousType, currentType)
+		return
+	}
+
+	if previous.Cardinality() == protoreflect.Required && current.Cardinality() != protoreflect.Required {
+		c.add(breaksForward, "%s: field %d %q is no longer required", message, number, current.Name())
+	} else if previous.Cardinality() != protoreflect.Required && current.Cardinality() == protoreflect.Required {
+		c.add(breaksBackward, "%s: field %d %q is now required", message, number, current.Name())
+	}
+
+	if current.IsMap() {
+		previous = previous.MapValue()
+		current = current.MapValue()
+	}
+
+	switch current.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		c.compareMessages(previous.Message(), current.Message())
+	case protoreflect.EnumKind:
+		c.compareEnums(previous.Enum(), current.Enum())
+	}
+}
+
+func (c *comparison) compareEnums(previous protoreflect.EnumDescriptor, current protoreflect.EnumDescriptor) {
+	name := current.FullName()
+	if c.seen[name] {
+		return
+	}
+	c.seen[name] = true
+
+	previousValues := previous.Values()
+	currentValues := current.Values()
+
+	for i := 0; i < previousValues.Len(); i++ {
+		previousValue := previousValues.Get(i)
+		currentValue := currentValues.ByNumber(previousValue.Number())
+		if currentValue == nil {
+			if !current.ReservedRanges().Has(previousValue.Number()) {
+				c.add(breaksBoth, "%s: enum value %d %q removed without reserving its number", name, previousValue.Number(), previousValue.Name())
+			}
+			continue
+		}
+
+		if previousValue.Name() != currentValue.Name() {
+			c.add(breaksBoth, "%s: enum value %d renamed from %q to %q", name, currentValue.Number(), previousValue.Name(), currentValue.Name())
+		}
+	}
+
+	for i := 0; i < currentValues.Len(); i++ {
+		currentValue := currentValues.Get(i)
+		if previousValues.ByNumber(currentValue.Number()) != nil {
+			continue
+		}
+
+		if previous.ReservedRanges().Has(currentValue.Number()) {
+			c.add(breaksBoth, "%s: enum value %d %q reuses a reserved number", name, currentValue.Number(), currentValue.Name())
+		} else if previous.ReservedNames().Has(currentValue.Name()) {
+			c.add(breaksBoth, "%s: enum value %d %q reuses a reserved name", name, currentValue.Number(), currentValue.Name())
+		}
+	}
+}
+
+// fieldType returns a description of the type of a field, such as
+// `repeated string` or `map<string, orders.v1.Order>`.
+func fieldType(field protoreflect.FieldDescriptor) string {
+	if field.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", fieldType(field.MapKey()), fieldType(field.MapValue()))
+	}
+
+	var name string
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		name = string(field.Message().FullName())
+	case protoreflect.EnumKind:
+		name = string(field.Enum().FullName())
+	default:
+		name = field.Kind().String()
+	}
+
+	if field.IsList() {
+		return "repeated " + name
+	}
+
+	return name
+}
+
+// appendChanges appends changes that have not been seen before.
+func appendChanges(res []string, changes []string) []string {
+	for _, change := range changes {
+		if !slices.Contains(res, change) {
+			res = append(res, change)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,285 @@
+package schemas_test
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/cockroachdb/errors"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// OrderStatusFile returns a file with a message that uses an enum.
+func OrderStatusFile() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("orders/v1/status.proto"),
+		Package: proto.String("orders.v1"),
+		Syntax:  proto.String("proto3"),
+		EnumType: []*descriptorpb.EnumDescriptorProto{
+			{
+				Name: proto.String("Status"),
+				Value: []*descriptorpb.EnumValueDescriptorProto{
+					{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+					{Name: proto.String("STATUS_OPEN"), Number: proto.Int32(1)},
+					{Name: proto.String("STATUS_CLOSED"), Number: proto.Int32(2)},
+				},
+			},
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("StatusChanged"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("status"),
+						JsonName: proto.String("status"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+						TypeName: proto.String(".orders.v1.Status"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Proto2File returns a proto2 version of the orders file, where the id is
+// required.
+func Proto2File() *descriptorpb.FileDescriptorProto {
+	file := OrdersFile()
+	file.Syntax = proto.String("proto2")
+	file.MessageType[0].Field[0].Label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	return file
+}
+
+// Reserve reserves a field number in the first message of a file.
+func Reserve(file *descriptorpb.FileDescriptorProto, number int32) {
+	file.MessageType[0].ReservedRange = append(file.MessageType[0].ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{
+		Start: proto.Int32(number),
+		End:   proto.Int32(number + 1),
+	})
+}
+
+var _ = Describe("Compatibility", func() {
+	var manager *schemas.Manager
+
+	BeforeEach(func() {
+		manager, _, _ = createManagerAndJetStream()
+	})
+
+	ExpectIncompatible := func(err error, changes ...string) {
+		GinkgoHelper()
+
+		Expect(err).To(MatchError(schemas.ErrIncompatibleSchema))
+
+		var incompatible *schemas.IncompatibleError
+		Expect(errors.As(err, &incompatible)).To(BeTrue())
+		Expect(incompatible.Changes).To(Equal(changes))
+	}
+
+	It("adding a field is compatible", func(ctx context.Context) {
+		first, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field = append(file.MessageType[0].Field, &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String("note"),
+			JsonName: proto.String("note"),
+			Number:   proto.Int32(3),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+		})
+
+		second, err := manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityFull)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second[0].Revision).To(BeNumerically(">", first[0].Revision))
+	})
+
+	It("removing a field without reserving its number is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field = file.MessageType[0].Field[:1]
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 2 "created_at" removed without reserving its number`)
+	})
+
+	It("removing a field and reserving its number is compatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field = file.MessageType[0].Field[:1]
+		Reserve(file, 2)
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityFull)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("changing the type of a field is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field[1].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		file.MessageType[0].Field[1].TypeName = nil
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 2 "created_at" changed type from google.protobuf.Timestamp to int64`)
+	})
+
+	It("making a field repeated is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field[0].Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 1 "id" changed type from string to repeated string`)
+	})
+
+	It("reusing a field number is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field[1].Name = proto.String("total")
+		file.MessageType[0].Field[1].JsonName = proto.String("total")
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field number 2 reused, was "created_at" and is now "total"`)
+	})
+
+	It("reusing a reserved field number is incompatible", func(ctx context.Context) {
+		file := OrdersFile()
+		file.MessageType[0].Field = file.MessageType[0].Field[:1]
+		Reserve(file, 2)
+
+		_, err := manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file = OrdersFile()
+		file.MessageType[0].Field[1].Name = proto.String("total")
+		file.MessageType[0].Field[1].JsonName = proto.String("total")
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 2 "total" reuses a reserved number`)
+	})
+
+	It("new versions are checked against all earlier versions", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Remove the field without reserving its number, forcing the change
+		file := OrdersFile()
+		file.MessageType[0].Field = file.MessageType[0].Field[:1]
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityNone)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Adding the number back with another type is fine compared to the
+		// latest version, but not compared to the first one
+		file = OrdersFile()
+		file.MessageType[0].Field[1].Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		file.MessageType[0].Field[1].TypeName = nil
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 2 "created_at" changed type from google.protobuf.Timestamp to int64`)
+	})
+
+	It("renaming an enum value is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrderStatusFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrderStatusFile()
+		file.EnumType[0].Value[2].Name = proto.String("STATUS_DONE")
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.Status: enum value 2 renamed from "STATUS_CLOSED" to "STATUS_DONE"`)
+	})
+
+	It("removing an enum value without reserving its number is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrderStatusFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrderStatusFile()
+		file.EnumType[0].Value = file.EnumType[0].Value[:2]
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		ExpectIncompatible(err, `orders.v1.Status: enum value 2 "STATUS_CLOSED" removed without reserving its number`)
+	})
+
+	It("adding a required field is not backward compatible", func(ctx context.Context) {
+		file := Proto2File()
+		file.MessageType[0].Field[0].Label = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+
+		_, err := manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.RegisterSchemas(ctx, Files(Proto2File()), schemas.CompatibilityBackward)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: field 1 "id" is now required`)
+
+		_, err = manager.RegisterSchemas(ctx, Files(Proto2File()), schemas.CompatibilityForward)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("removing a required field is not forward compatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(Proto2File()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := Proto2File()
+		file.MessageType[0].Field = file.MessageType[0].Field[1:]
+		Reserve(file, 1)
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityForward)
+		ExpectIncompatible(err, `orders.v1.OrderCreated: required field 1 "id" removed`)
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityBackward)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("checks can be disabled", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrdersFile()
+		file.MessageType[0].Field = file.MessageType[0].Field[:1]
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityNone)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("nothing is registered if a type is incompatible", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrderStatusFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		first, err := manager.GetSchema(ctx, "orders.v1.StatusChanged")
+		Expect(err).ToNot(HaveOccurred())
+
+		file := OrderStatusFile()
+		file.EnumType[0].Value[2].Name = proto.String("STATUS_DONE")
+		file.MessageType = append(file.MessageType, OrdersFile().MessageType[0])
+		file.MessageType[1].Field = file.MessageType[1].Field[:1]
+
+		_, err = manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		Expect(err).To(MatchError(schemas.ErrIncompatibleSchema))
+
+		second, err := manager.GetSchema(ctx, "orders.v1.StatusChanged")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.Revision).To(Equal(first.Revision))
+
+		_, err = manager.GetSchema(ctx, "orders.v1.OrderCreated")
+		Expect(err).To(MatchError(schemas.ErrSchemaNotFound))
+	})
+
+	It("registering with unknown compatibility fails", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.Compatibility("sideways"))
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+})
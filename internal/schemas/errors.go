@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrSchemaNotFound is returned when a message type has not been registered.
+var ErrSchemaNotFound = errors.New("schema not found")
+
+// ErrBindingNotFound is returned when a subject pattern or store has no
+// binding.
+var ErrBindingNotFound = errors.New("binding not found")
+
+type validationError struct {
+	err string
+}
+
+func (e *validationError) Error() string {
+	return e.err
+}
+
+func newValidationError(err string) error {
+	return &validationError{err: err}
+}
+
+func IsValidationError(err error) bool {
+	_, ok := err.(*validationError)
+	return ok
+}
+
+// ErrIncompatibleSchema is returned when a new version of a message type is
+// not compatible with earlier versions of it. Use errors.As with
+// *IncompatibleError to get the changes that break compatibility.
+var ErrIncompatibleSchema = errors.New("incompatible schema")
+
+// IncompatibleError lists the changes that make a new version of a message
+// type incompatible with earlier versions of it.
+type IncompatibleError struct {
+	// Type is the full name of the message type.
+	Type string
+	// Compatibility is the rule that was broken.
+	Compatibility Compatibility
+	// Changes describes every change that breaks compatibility.
+	Changes []string
+}
+
+func (e *IncompatibleError) Error() string {
+	return e.Type + " is not " + string(e.Compatibility) + " compatible with earlier versions: " + strings.Join(e.Changes, "; ")
+}
+
+func (e *IncompatibleError) Is(target error) bool {
+	return target == ErrIncompatibleSchema
+}
@@ -0,0 +1,141 @@
+package schemas
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// EncodeJSON encodes data as JSON, in the format used by protojson for Any
+// with the type in the `@type` field. The type of the data, and any types
+// it contains, must be registered or known to the server.
+func (m *Manager) EncodeJSON(ctx context.Context, data *anypb.Any) (string, error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	bytes, err := protojson.MarshalOptions{Resolver: &resolver{m: m}}.Marshal(data)
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode data as JSON")
+	}
+
+	return string(bytes), nil
+}
+
+// DecodeJSON decodes JSON in the format used by protojson for Any into
+// data. The JSON must contain the type in the `@type` field, and the type
+// must be registered or known to the server. Returns a validation error if
+// the JSON can not be decoded.
+func (m *Manager) DecodeJSON(ctx context.Context, data string) (*anypb.Any, error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &anypb.Any{}
+	err = protojson.UnmarshalOptions{Resolver: &resolver{m: m}}.Unmarshal([]byte(data), res)
+	if err != nil {
+		return nil, newValidationError("invalid JSON data: " + err.Error())
+	}
+
+	return res, nil
+}
+
+// resolver finds message types among the registered types, falling back to
+// the types known to the server.
+type resolver struct {
+	m *Manager
+}
+
+func (r *resolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	r.m.mu.RLock()
+	schema, ok := r.m.types[string(name)]
+	r.m.mu.RUnlock()
+	if ok {
+		return schema.messageType, nil
+	}
+
+	return protoregistry.GlobalTypes.FindMessageByName(name)
+}
+
+func (r *resolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+func (r *resolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (r *resolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
+
+// EncodeMessageJSON encodes the message in data as JSON, without the type of
+// the message. The type must be registered or known to the server.
+func (m *Manager) EncodeMessageJSON(ctx context.Context, data *anypb.Any) ([]byte, error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{m: m}
+	messageType, err := r.FindMessageByURL(data.TypeUrl)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find message type")
+	}
+
+	msg := messageType.New().Interface()
+	err = proto.Unmarshal(data.Value, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode data")
+	}
+
+	res, err := protojson.MarshalOptions{Resolver: r}.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode data as JSON")
+	}
+
+	return res, nil
+}
+
+// DecodeMessageJSON decodes JSON containing a message of the type with the
+// given full name. The type must be registered or known to the server.
+// Returns a validation error if the JSON can not be decoded.
+func (m *Manager) DecodeMessageJSON(ctx context.Context, name string, data []byte) (*anypb.Any, error) {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resolver{m: m}
+	messageType, err := r.FindMessageByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, newValidationError("unknown message type: " + name)
+	}
+
+	msg := messageType.New().Interface()
+	err = protojson.UnmarshalOptions{Resolver: r}.Unmarshal(data, msg)
+	if err != nil {
+		return nil, newValidationError("invalid JSON data: " + err.Error())
+	}
+
+	res := &anypb.Any{}
+	err = anypb.MarshalFrom(res, msg, proto.MarshalOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode data")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package schemas_test
+
+import (
+	"context"
+
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("JSON", func() {
+	var manager *schemas.Manager
+
+	orderJSON := `{"@type": "type.googleapis.com/orders.v1.OrderCreated", "id": "123", "createdAt": "2024-01-02T03:04:05Z"}`
+
+	BeforeEach(func() {
+		manager, _, _ = createManagerAndJetStream()
+	})
+
+	It("can decode and encode registered types", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := manager.DecodeJSON(ctx, orderJSON)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data.TypeUrl).To(Equal("type.googleapis.com/orders.v1.OrderCreated"))
+
+		encoded, err := manager.EncodeJSON(ctx, data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encoded).To(MatchJSON(orderJSON))
+	})
+
+	It("can decode and encode types known to the server", func(ctx context.Context) {
+		data, err := manager.DecodeJSON(ctx, `{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "value": "test"}`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proto.Equal(data, Data(&testv1.StringValue{Value: "test"}))).To(BeTrue())
+
+		encoded, err := manager.EncodeJSON(ctx, data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encoded).To(MatchJSON(`{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "value": "test"}`))
+	})
+
+	It("decoding an unknown type fails", func(ctx context.Context) {
+		_, err := manager.DecodeJSON(ctx, orderJSON)
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("decoding invalid JSON fails", func(ctx context.Context) {
+		_, err := manager.DecodeJSON(ctx, `{"@type": "type.googleapis.com/windshift.test.v1.StringValue", "value": 1}`)
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("encoding an unknown type fails", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := manager.DecodeJSON(ctx, orderJSON)
+		Expect(err).ToNot(HaveOccurred())
+
+		data.TypeUrl = "type.googleapis.com/orders.v1.Unknown"
+		_, err = manager.EncodeJSON(ctx, data)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,281 @@
+package schemas
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// BucketName is the name of the JetStream KeyValue bucket used to store
+// schemas and bindings.
+const BucketName = "windshift-schemas"
+
+// bucketHistory is the number of versions kept for every key in the bucket.
+const bucketHistory = 64
+
+const (
+	// typeKeyPrefix is the prefix of the keys storing message types, the
+	// full name of the type is appended to it.
+	typeKeyPrefix = "types."
+	// subjectKeyPrefix is the prefix of the keys storing subject bindings,
+	// the base64 encoded pattern is appended to it as patterns may contain
+	// wildcards.
+	subjectKeyPrefix = "subjects."
+	// storeKeyPrefix is the prefix of the keys storing store bindings, the
+	// name of the store is appended to it.
+	storeKeyPrefix = "stores."
+)
+
+// Manager is a registry of Protobuf message types and the subjects and
+// stores they are bound to. Everything is stored in a JetStream KeyValue
+// bucket, which is watched so that every replica keeps a copy in memory and
+// validating data does not require a round trip to NATS.
+type Manager struct {
+	logger *zap.Logger
+	tracer trace.Tracer
+
+	js     jetstream.JetStream
+	config *Config
+
+	// bucketMu protects bucket and watcher.
+	bucketMu sync.Mutex
+	bucket   jetstream.KeyValue
+	watcher  jetstream.KeyWatcher
+
+	// mu protects the fields below, which are kept up to date by the
+	// watcher.
+	mu sync.RWMutex
+	// revision is the latest revision of the bucket that has been applied.
+	revision uint64
+	// changed is closed and replaced every time revision changes.
+	changed  chan struct{}
+	types    map[string]*Schema
+	subjects map[string]*SubjectBinding
+	stores   map[string]*StoreBinding
+}
+
+// SubjectBinding limits the message types that can be published to subjects
+// matching a pattern.
+type SubjectBinding struct {
+	// Pattern is the subject pattern, which may contain wildcards.
+	Pattern string `json:"pattern"`
+	// Types are the full names of the allowed message types.
+	Types []string `json:"types"`
+}
+
+// StoreBinding limits the message types that can be stored in a store.
+type StoreBinding struct {
+	// Store is the name of the store.
+	Store string `json:"store"`
+	// Types are the full names of the allowed message types.
+	Types []string `json:"types"`
+}
+
+// NewManager creates a new schema manager.
+func NewManager(
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	js jetstream.JetStream,
+	config *Config,
+) (*Manager, error) {
+	if !config.Compatibility.IsValid() {
+		return nil, errors.Newf("unknown schema compatibility: %s", config.Compatibility)
+	}
+
+	return &Manager{
+		logger: logger,
+		tracer: tracer,
+
+		js:     js,
+		config: config,
+
+		changed:  make(chan struct{}),
+		types:    make(map[string]*Schema),
+		subjects: make(map[string]*SubjectBinding),
+		stores:   make(map[string]*StoreBinding),
+	}, nil
+}
+
+// Destroy stops watching the bucket for changes.
+func (m *Manager) Destroy() {
+	m.bucketMu.Lock()
+	defer m.bucketMu.Unlock()
+
+	if m.watcher != nil {
+		_ = m.watcher.Stop()
+		m.watcher = nil
+	}
+}
+
+// getBucket returns the bucket used for schemas, creating it if needed. The
+// first call loads the contents of the bucket and starts watching it for
+// changes.
+func (m *Manager) getBucket(ctx context.Context) (jetstream.KeyValue, error) {
+	m.bucketMu.Lock()
+	defer m.bucketMu.Unlock()
+
+	if m.bucket != nil {
+		return m.bucket, nil
+	}
+
+	bucket, err := m.js.KeyValue(ctx, BucketName)
+	if errors.Is(err, jetstream.ErrBucketNotFound) {
+		bucket, err = m.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
+			Bucket:      BucketName,
+			Description: "Schemas and bindings managed by Windshift",
+			History:     bucketHistory,
+		})
+		if errors.Is(err, jetstream.ErrBucketExists) {
+			// Another replica created the bucket at the same time
+			bucket, err = m.js.KeyValue(ctx, BucketName)
+		}
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get schema bucket")
+	}
+
+	// The watcher outlives the request that created it, so it can not use
+	// its context
+	watcher, err := bucket.WatchAll(context.Background()) //nolint:contextcheck
+	if err != nil {
+		return nil, errors.Wrap(err, "could not watch schema bucket")
+	}
+
+	// Apply everything currently in the bucket, a nil entry marks the end
+	// of the initial values
+	for {
+		var entry jetstream.KeyValueEntry
+		select {
+		case <-ctx.Done():
+			_ = watcher.Stop()
+			return nil, ctx.Err()
+		case entry = <-watcher.Updates():
+		}
+
+		if entry == nil {
+			break
+		}
+
+		m.apply(entry)
+	}
+
+	go func() {
+		for entry := range watcher.Updates() {
+			if entry != nil {
+				m.apply(entry)
+			}
+		}
+	}()
+
+	m.bucket = bucket
+	m.watcher = watcher
+	return bucket, nil
+}
+
+// apply updates the in-memory copy of the bucket with an entry.
+func (m *Manager) apply(entry jetstream.KeyValueEntry) {
+	deleted := entry.Operation() != jetstream.KeyValuePut
+	key := entry.Key()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(key, typeKeyPrefix):
+		name := key[len(typeKeyPrefix):]
+		if deleted {
+			delete(m.types, name)
+			break
+		}
+
+		schema, err := loadSchema(name, entry.Value())
+		if err != nil {
+			m.logger.Error("Could not load schema", zap.String("type", name), zap.Error(err))
+			break
+		}
+
+		schema.Revision = entry.Revision()
+		schema.Timestamp = entry.Created()
+		m.types[name] = schema
+	case strings.HasPrefix(key, subjectKeyPrefix):
+		if deleted {
+			pattern, err := base64.RawURLEncoding.DecodeString(key[len(subjectKeyPrefix):])
+			if err == nil {
+				delete(m.subjects, string(pattern))
+			}
+			break
+		}
+
+		var binding SubjectBinding
+		err := json.Unmarshal(entry.Value(), &binding)
+		if err != nil {
+			m.logger.Error("Could not load subject binding", zap.String("key", key), zap.Error(err))
+			break
+		}
+
+		m.subjects[binding.Pattern] = &binding
+	case strings.HasPrefix(key, storeKeyPrefix):
+		store := key[len(storeKeyPrefix):]
+		if deleted {
+			delete(m.stores, store)
+			break
+		}
+
+		var binding StoreBinding
+		err := json.Unmarshal(entry.Value(), &binding)
+		if err != nil {
+			m.logger.Error("Could not load store binding", zap.String("store", store), zap.Error(err))
+			break
+		}
+
+		m.stores[store] = &binding
+	}
+
+	if entry.Revision() > m.revision {
+		m.revision = entry.Revision()
+		close(m.changed)
+		m.changed = make(chan struct{})
+	}
+}
+
+// waitForRevision waits until the watcher has applied a revision, so that
+// changes made by this replica are visible as soon as they are written.
+func (m *Manager) waitForRevision(ctx context.Context, revision uint64) error {
+	return m.waitFor(ctx, func() bool {
+		return m.revision >= revision
+	})
+}
+
+// waitFor waits until the watcher has applied changes so that done returns
+// true. done is called with mu held.
+func (m *Manager) waitFor(ctx context.Context, done func() bool) error {
+	for {
+		m.mu.RLock()
+		isDone := done()
+		changed := m.changed
+		m.mu.RUnlock()
+
+		if isDone {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// subjectKey returns the key used to store the binding of a subject pattern.
+func subjectKey(pattern string) string {
+	return subjectKeyPrefix + base64.RawURLEncoding.EncodeToString([]byte(pattern))
+}
@@ -0,0 +1,55 @@
+package schemas
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/levelfourab/sprout-go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// Module for FX that enables the schema registry. Provides the Manager as
+// the schema validator of events and state.
+var Module = fx.Module(
+	"schemas",
+	fx.Provide(sprout.Logger("schemas"), fx.Private),
+	fx.Provide(sprout.ServiceTracer(), fx.Private),
+	fx.Provide(sprout.Config("SCHEMAS", &Config{}), fx.Private),
+	fx.Provide(newManager),
+	fx.Provide(func(m *Manager) events.SchemaValidator { return m }),
+	fx.Provide(func(m *Manager) state.SchemaValidator { return m }),
+)
+
+type Config struct {
+	// Compatibility is the rule used to check new versions of message types
+	// when a request does not specify one.
+	Compatibility Compatibility `env:"COMPATIBILITY" envDefault:"backward"`
+}
+
+// newManager creates the manager and stops watching for changes when the
+// application stops.
+func newManager(
+	lifecycle fx.Lifecycle,
+	logger *zap.Logger,
+	tracer trace.Tracer,
+	js jetstream.JetStream,
+	config *Config,
+) (*Manager, error) {
+	manager, err := NewManager(logger, tracer, js, config)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			manager.Destroy()
+			return nil
+		},
+	})
+	return manager, nil
+}
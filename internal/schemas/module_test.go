@@ -0,0 +1,94 @@
+package schemas_test
+
+import (
+	"os"
+	"time"
+
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func GetNATS() *nats.Conn {
+	tempDir, err := os.MkdirTemp("", "nats")
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	ns, err := server.NewServer(&server.Options{
+		Port:       -1,
+		JetStream:  true,
+		StoreDir:   tempDir,
+		DontListen: true,
+	})
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		ns.Shutdown()
+		ns.WaitForShutdown()
+	})
+
+	go ns.Start()
+	if !ns.ReadyForConnections(4 * time.Second) {
+		Fail("unable to start nats server")
+	}
+
+	natsConn, err := nats.Connect(ns.ClientURL(), nats.InProcessServer(ns))
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(func() {
+		natsConn.Close()
+	})
+	return natsConn
+}
+
+func createManager(js jetstream.JetStream) *schemas.Manager {
+	manager, err := schemas.NewManager(
+		zaptest.NewLogger(GinkgoT()),
+		otel.Tracer("tests"),
+		js,
+		&schemas.Config{
+			Compatibility: schemas.CompatibilityBackward,
+		},
+	)
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(manager.Destroy)
+
+	return manager
+}
+
+func createManagerAndJetStream() (*schemas.Manager, *nats.Conn, jetstream.JetStream) {
+	natsConn := GetNATS()
+
+	js, err := jetstream.New(natsConn)
+	Expect(err).ToNot(HaveOccurred())
+
+	return createManager(js), natsConn, js
+}
+
+// StringValueFiles returns the descriptors of the test messages.
+func StringValueFiles() *descriptorpb.FileDescriptorSet {
+	return Files(protodesc.ToFileDescriptorProto(testv1.File_windshift_test_v1_events_proto))
+}
+
+func Files(files ...*descriptorpb.FileDescriptorProto) *descriptorpb.FileDescriptorSet {
+	return &descriptorpb.FileDescriptorSet{
+		File: files,
+	}
+}
+
+func Data(msg proto.Message) *anypb.Any {
+	data, err := anypb.New(msg)
+	Expect(err).ToNot(HaveOccurred())
+	return data
+}
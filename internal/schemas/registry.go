@@ -0,0 +1,441 @@
+package schemas
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Schema is a registered message type.
+type Schema struct {
+	// Name is the full name of the message type.
+	Name string
+	// Revision is the revision of the current version of the type.
+	Revision uint64
+	// Timestamp is when the current version of the type was registered.
+	Timestamp time.Time
+	// Files contains the file defining the type and all of its
+	// dependencies.
+	Files *descriptorpb.FileDescriptorSet
+
+	// descriptor is used to validate data of the type.
+	descriptor protoreflect.MessageDescriptor
+	// messageType is used to convert data of the type to and from JSON.
+	messageType protoreflect.MessageType
+}
+
+// RegisterSchemas registers all message types defined in a set of files. The
+// files must include all of their dependencies, except for files that are
+// known to the server such as the well-known types in google/protobuf.
+//
+// New versions of types that are already registered are checked against
+// the earlier versions kept in the history of the bucket, using the given
+// compatibility or the configured one if CompatibilityDefault is used. If a
+// type is not compatible nothing is registered and an error matching
+// ErrIncompatibleSchema is returned.
+//
+// Types that have not changed since they were last registered keep their
+// current revision.
+func (m *Manager) RegisterSchemas(
+	ctx context.Context,
+	files *descriptorpb.FileDescriptorSet,
+	compatibility Compatibility,
+) ([]*Schema, error) {
+	if compatibility == CompatibilityDefault {
+		compatibility = m.config.Compatibility
+	}
+
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.RegisterSchemas",
+		trace.WithAttributes(
+			attribute.Int("files", len(files.GetFile())),
+			attribute.String("compatibility", string(compatibility)),
+		),
+	)
+	defer span.End()
+
+	if !compatibility.IsValid() {
+		span.SetStatus(codes.Error, "invalid compatibility")
+		return nil, newValidationError("invalid compatibility: " + string(compatibility))
+	}
+
+	if len(files.GetFile()) == 0 {
+		span.SetStatus(codes.Error, "no files specified")
+		return nil, newValidationError("no files specified")
+	}
+
+	registry, err := buildFiles(files)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid files")
+		return nil, err
+	}
+
+	bucket, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	var descriptors []protoreflect.MessageDescriptor
+	for _, file := range files.File {
+		fd, err2 := registry.FindFileByPath(file.GetName())
+		if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to find file")
+			return nil, errors.Wrap(err2, "could not find file")
+		}
+
+		descriptors = appendMessages(descriptors, fd.Messages())
+	}
+
+	if len(descriptors) == 0 {
+		span.SetStatus(codes.Error, "no message types found")
+		return nil, newValidationError("no message types found in files")
+	}
+
+	// Check every type before storing anything, so that an incompatible
+	// type does not leave the others half registered
+	var lastRevision uint64
+	var incompatible []error
+	schemas := make([]*Schema, len(descriptors))
+	changed := make([][]byte, len(descriptors))
+	for i, descriptor := range descriptors {
+		name := string(descriptor.FullName())
+		data, err2 := proto.MarshalOptions{Deterministic: true}.Marshal(fileSet(descriptor.ParentFile()))
+		if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to marshal files")
+			return nil, errors.Wrap(err2, "could not marshal files")
+		}
+
+		entry, err2 := bucket.Get(ctx, typeKeyPrefix+name)
+		if err2 == nil && bytes.Equal(entry.Value(), data) {
+			// The type has not changed, no need to store a new version
+			if entry.Revision() > lastRevision {
+				lastRevision = entry.Revision()
+			}
+
+			schemas[i] = &Schema{
+				Name:      name,
+				Revision:  entry.Revision(),
+				Timestamp: entry.Created(),
+			}
+			continue
+		} else if err2 != nil && !errors.Is(err2, jetstream.ErrKeyNotFound) {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to get schema")
+			return nil, errors.Wrap(err2, "could not get schema")
+		}
+
+		if err2 == nil {
+			err2 = m.checkCompatibility(ctx, bucket, compatibility, descriptor)
+			if errors.Is(err2, ErrIncompatibleSchema) {
+				incompatible = append(incompatible, err2)
+			} else if err2 != nil {
+				span.RecordError(err2)
+				span.SetStatus(codes.Error, "failed to check compatibility")
+				return nil, err2
+			}
+		}
+
+		changed[i] = data
+	}
+
+	if len(incompatible) > 0 {
+		span.SetStatus(codes.Error, "incompatible schema")
+		return nil, errors.Join(incompatible...)
+	}
+
+	for i, descriptor := range descriptors {
+		if changed[i] == nil {
+			continue
+		}
+
+		name := string(descriptor.FullName())
+		revision, err2 := bucket.Put(ctx, typeKeyPrefix+name, changed[i])
+		if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to store schema")
+			return nil, errors.Wrap(err2, "could not store schema")
+		}
+
+		lastRevision = revision
+		schemas[i] = &Schema{
+			Name:      name,
+			Revision:  revision,
+			Timestamp: time.Now(),
+		}
+	}
+
+	err = m.waitForRevision(ctx, lastRevision)
+	if err != nil {
+		span.SetStatus(codes.Error, "failed to wait for changes")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return schemas, nil
+}
+
+// checkCompatibility checks a new version of a message type against all
+// earlier versions of it kept in the history of the bucket. Returns an
+// *IncompatibleError if any of them is not compatible.
+func (m *Manager) checkCompatibility(
+	ctx context.Context,
+	bucket jetstream.KeyValue,
+	compatibility Compatibility,
+	descriptor protoreflect.MessageDescriptor,
+) error {
+	if compatibility == CompatibilityNone {
+		return nil
+	}
+
+	name := string(descriptor.FullName())
+	history, err := bucket.History(ctx, typeKeyPrefix+name)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "could not get schema history")
+	}
+
+	var changes []string
+	for _, entry := range history {
+		if entry.Operation() != jetstream.KeyValuePut {
+			continue
+		}
+
+		previous, err := loadSchema(name, entry.Value())
+		if err != nil {
+			// Versions that can no longer be loaded can not be checked
+			m.logger.Warn(
+				"Could not load earlier version of schema",
+				zap.String("type", name),
+				zap.Uint64("revision", entry.Revision()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		changes = appendChanges(changes, compatibilityChanges(compatibility, previous.descriptor, descriptor))
+	}
+
+	if len(changes) > 0 {
+		return &IncompatibleError{
+			Type:          name,
+			Compatibility: compatibility,
+			Changes:       changes,
+		}
+	}
+
+	return nil
+}
+
+// GetSchema returns a registered message type. Returns ErrSchemaNotFound if
+// the type has not been registered.
+func (m *Manager) GetSchema(ctx context.Context, name string) (*Schema, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"windshift.schemas.GetSchema",
+		trace.WithAttributes(
+			attribute.String("type", name),
+		),
+	)
+	defer span.End()
+
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	m.mu.RLock()
+	schema, ok := m.types[name]
+	m.mu.RUnlock()
+	if !ok {
+		span.SetStatus(codes.Error, "schema not found")
+		return nil, errors.WithStack(ErrSchemaNotFound)
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return schema, nil
+}
+
+// ListSchemas returns all registered message types sorted by name.
+func (m *Manager) ListSchemas(ctx context.Context) ([]*Schema, error) {
+	ctx, span := m.tracer.Start(ctx, "windshift.schemas.ListSchemas")
+	defer span.End()
+
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get bucket")
+		return nil, err
+	}
+
+	m.mu.RLock()
+	schemas := make([]*Schema, 0, len(m.types))
+	for _, schema := range m.types {
+		schemas = append(schemas, schema)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].Name < schemas[j].Name
+	})
+
+	span.SetStatus(codes.Ok, "")
+	return schemas, nil
+}
+
+// loadSchema creates a schema from the stored files of a message type.
+func loadSchema(name string, data []byte) (*Schema, error) {
+	var files descriptorpb.FileDescriptorSet
+	err := proto.Unmarshal(data, &files)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal files")
+	}
+
+	registry, err := buildFiles(&files)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := registry.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find message type")
+	}
+
+	descriptor, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, errors.Newf("%s is not a message type", name)
+	}
+
+	return &Schema{
+		Name:        name,
+		Files:       &files,
+		descriptor:  descriptor,
+		messageType: dynamicpb.NewMessageType(descriptor),
+	}, nil
+}
+
+// buildFiles resolves the files in a descriptor set. Dependencies that are
+// not part of the set are looked up among the files known to the server.
+func buildFiles(set *descriptorpb.FileDescriptorSet) (*protoregistry.Files, error) {
+	byPath := make(map[string]*descriptorpb.FileDescriptorProto, len(set.GetFile()))
+	for _, file := range set.GetFile() {
+		if file.GetName() == "" {
+			return nil, newValidationError("file without name in descriptor set")
+		}
+
+		byPath[file.GetName()] = file
+	}
+
+	registry := new(protoregistry.Files)
+	resolving := make(map[string]bool)
+
+	var resolve func(path string) error
+	resolve = func(path string) error {
+		if _, err := registry.FindFileByPath(path); err == nil {
+			return nil
+		}
+
+		file, ok := byPath[path]
+		if !ok {
+			fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+			if err != nil {
+				return newValidationError("missing dependency: " + path)
+			}
+
+			return registry.RegisterFile(fd)
+		}
+
+		if resolving[path] {
+			return newValidationError("import cycle in " + path)
+		}
+		resolving[path] = true
+
+		for _, dependency := range file.GetDependency() {
+			if err := resolve(dependency); err != nil {
+				return err
+			}
+		}
+
+		fd, err := protodesc.NewFile(file, registry)
+		if err != nil {
+			return newValidationError("invalid file " + path + ": " + err.Error())
+		}
+
+		err = registry.RegisterFile(fd)
+		if err != nil {
+			return newValidationError("invalid file " + path + ": " + err.Error())
+		}
+
+		return nil
+	}
+
+	for _, file := range set.GetFile() {
+		if err := resolve(file.GetName()); err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
+}
+
+// fileSet returns a descriptor set containing a file and all of its
+// dependencies, with dependencies before the files that import them.
+func fileSet(file protoreflect.FileDescriptor) *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+
+	add(file)
+	return set
+}
+
+// appendMessages appends all messages, including nested ones, to a slice.
+// Map entries are skipped as they can not be used on their own.
+func appendMessages(res []protoreflect.MessageDescriptor, messages protoreflect.MessageDescriptors) []protoreflect.MessageDescriptor {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+
+		res = append(res, message)
+		res = appendMessages(res, message.Messages())
+	}
+
+	return res
+}
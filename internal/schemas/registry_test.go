@@ -0,0 +1,154 @@
+package schemas_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/schemas"
+
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// OrdersFile returns a file with a message that depends on a well-known type.
+func OrdersFile() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("orders/v1/orders.proto"),
+		Package:    proto.String("orders.v1"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("OrderCreated"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{
+						Name:     proto.String("id"),
+						JsonName: proto.String("id"),
+						Number:   proto.Int32(1),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					},
+					{
+						Name:     proto.String("created_at"),
+						JsonName: proto.String("createdAt"),
+						Number:   proto.Int32(2),
+						Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+						Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+						TypeName: proto.String(".google.protobuf.Timestamp"),
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Registry", func() {
+	var manager *schemas.Manager
+	var js jetstream.JetStream
+
+	BeforeEach(func() {
+		manager, _, js = createManagerAndJetStream()
+	})
+
+	It("can register message types", func(ctx context.Context) {
+		registered, err := manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registered).To(HaveLen(1))
+		Expect(registered[0].Name).To(Equal("windshift.test.v1.StringValue"))
+		Expect(registered[0].Revision).To(BeNumerically(">", 0))
+	})
+
+	It("registering the same files twice keeps the revision", func(ctx context.Context) {
+		first, err := manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		second, err := manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second[0].Revision).To(Equal(first[0].Revision))
+	})
+
+	It("can get a registered message type", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		schema, err := manager.GetSchema(ctx, "windshift.test.v1.StringValue")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schema.Name).To(Equal("windshift.test.v1.StringValue"))
+		Expect(schema.Files.File).To(HaveLen(1))
+		Expect(schema.Files.File[0].GetName()).To(Equal("windshift/test/v1/events.proto"))
+	})
+
+	It("getting an unknown message type fails", func(ctx context.Context) {
+		_, err := manager.GetSchema(ctx, "windshift.test.v1.Unknown")
+		Expect(err).To(MatchError(schemas.ErrSchemaNotFound))
+	})
+
+	It("can list message types", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		list, err := manager.ListSchemas(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].Name).To(Equal("orders.v1.OrderCreated"))
+		Expect(list[1].Name).To(Equal("windshift.test.v1.StringValue"))
+	})
+
+	It("well-known types are included with registered types", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		schema, err := manager.GetSchema(ctx, "orders.v1.OrderCreated")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(schema.Files.File).To(HaveLen(2))
+		Expect(schema.Files.File[0].GetName()).To(Equal("google/protobuf/timestamp.proto"))
+		Expect(schema.Files.File[1].GetName()).To(Equal("orders/v1/orders.proto"))
+	})
+
+	It("registering without files fails", func(ctx context.Context) {
+		_, err := manager.RegisterSchemas(ctx, &descriptorpb.FileDescriptorSet{}, schemas.CompatibilityDefault)
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("registering with missing dependency fails", func(ctx context.Context) {
+		file := OrdersFile()
+		file.Dependency = append(file.Dependency, "orders/v1/missing.proto")
+
+		_, err := manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("registering with unknown field type fails", func(ctx context.Context) {
+		file := OrdersFile()
+		file.MessageType[0].Field[1].TypeName = proto.String(".orders.v1.Missing")
+
+		_, err := manager.RegisterSchemas(ctx, Files(file), schemas.CompatibilityDefault)
+		Expect(err).To(HaveOccurred())
+		Expect(schemas.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("other managers see registered types", func(ctx context.Context) {
+		other := createManager(js)
+
+		// Load the bucket before the type is registered
+		list, err := other.ListSchemas(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(BeEmpty())
+
+		_, err = manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(func() error {
+			_, err := other.GetSchema(ctx, "windshift.test.v1.StringValue")
+			return err
+		}).WithTimeout(time.Second).ShouldNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,13 @@
+package schemas_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSchemas(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Schemas Suite")
+}
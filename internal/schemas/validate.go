@@ -0,0 +1,100 @@
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ValidateEvent checks that data is allowed to be published to a subject.
+// Subjects that do not match any binding accept any data. If the data is not
+// allowed the returned error is marked with events.ErrInvalidData.
+func (m *Manager) ValidateEvent(ctx context.Context, subject string, data *anypb.Any) error {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	bound := false
+	var allowed []string
+	for _, binding := range m.subjects {
+		if events.SubjectMatches(binding.Pattern, subject) {
+			bound = true
+			allowed = append(allowed, binding.Types...)
+		}
+	}
+
+	if !bound {
+		return nil
+	}
+
+	reason := m.validate(allowed, data)
+	if reason != "" {
+		return errors.Wrapf(events.ErrInvalidData, "%s for subject %s", reason, subject)
+	}
+
+	return nil
+}
+
+// ValidateValue checks that a value is allowed to be stored in a store.
+// Stores without a binding accept any value. If the value is not allowed the
+// returned error is marked with state.ErrInvalidValue.
+func (m *Manager) ValidateValue(ctx context.Context, store string, value *anypb.Any) error {
+	_, err := m.getBucket(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	binding, ok := m.stores[store]
+	if !ok {
+		return nil
+	}
+
+	reason := m.validate(binding.Types, value)
+	if reason != "" {
+		return errors.Wrapf(state.ErrInvalidValue, "%s for store %s", reason, store)
+	}
+
+	return nil
+}
+
+// validate checks that data is one of the allowed types and that it can be
+// decoded as that type. Returns the reason the data is invalid, or an empty
+// string if it is valid. Must be called with mu held.
+func (m *Manager) validate(allowed []string, data *anypb.Any) string {
+	if data == nil {
+		return "no data"
+	}
+
+	name := string(data.MessageName())
+	if !slices.Contains(allowed, name) {
+		return fmt.Sprintf("type %s is not allowed", name)
+	}
+
+	schema, ok := m.types[name]
+	if !ok {
+		return fmt.Sprintf("type %s is not registered", name)
+	}
+
+	msg := dynamicpb.NewMessage(schema.descriptor)
+	err := proto.Unmarshal(data.Value, msg)
+	if err != nil {
+		return fmt.Sprintf("data is not a valid %s (%s)", name, err)
+	}
+
+	return ""
+}
@@ -0,0 +1,299 @@
+package schemas_test
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+	testv1 "github.com/levelfourab/windshift-server/internal/proto/windshift/test/v1"
+	"github.com/levelfourab/windshift-server/internal/schemas"
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Validation", func() {
+	var manager *schemas.Manager
+	var natsConn *nats.Conn
+	var js jetstream.JetStream
+
+	// invalidStringValue claims to be a StringValue but can not be decoded
+	invalidStringValue := &anypb.Any{
+		TypeUrl: "type.googleapis.com/windshift.test.v1.StringValue",
+		Value:   []byte{0x0a, 0xff},
+	}
+
+	BeforeEach(func(ctx context.Context) {
+		manager, natsConn, js = createManagerAndJetStream()
+
+		_, err := manager.RegisterSchemas(ctx, StringValueFiles(), schemas.CompatibilityDefault)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Publishing events", func() {
+		var eventsManager *events.Manager
+
+		BeforeEach(func(ctx context.Context) {
+			var err error
+			eventsManager, err = events.NewManager(
+				zaptest.NewLogger(GinkgoT()),
+				otel.Tracer("tests"),
+				natsConn,
+				js,
+				manager,
+				&events.Config{
+					AckTokenKey: "test-key",
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = eventsManager.EnsureStream(ctx, &events.StreamConfig{
+				Name:     "orders",
+				Subjects: []string{"orders.>"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.>",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can publish bound type", func(ctx context.Context) {
+			_, err := eventsManager.Publish(ctx, &events.PublishConfig{
+				Subject: "orders.created",
+				Data:    Data(&testv1.StringValue{Value: "abc"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("publishing other type fails", func(ctx context.Context) {
+			_, err := eventsManager.Publish(ctx, &events.PublishConfig{
+				Subject: "orders.created",
+				Data:    Data(&emptypb.Empty{}),
+			})
+			Expect(err).To(MatchError(events.ErrInvalidData))
+		})
+
+		It("publishing data that can not be decoded fails", func(ctx context.Context) {
+			_, err := eventsManager.Publish(ctx, &events.PublishConfig{
+				Subject: "orders.created",
+				Data:    invalidStringValue,
+			})
+			Expect(err).To(MatchError(events.ErrInvalidData))
+		})
+	})
+
+	Describe("Setting state", func() {
+		var stateManager *state.Manager
+
+		BeforeEach(func(ctx context.Context) {
+			var err error
+			stateManager, err = state.NewManager(
+				zaptest.NewLogger(GinkgoT()),
+				otel.Tracer("tests"),
+				js,
+				manager,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(stateManager.Destroy)
+
+			err = stateManager.EnsureStore(ctx, &state.StoreConfig{
+				Name: "orders",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureStoreBinding(ctx, &schemas.StoreBinding{
+				Store: "orders",
+				Types: []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can set bound type", func(ctx context.Context) {
+			_, err := stateManager.Set(ctx, "orders", "test", Data(&testv1.StringValue{Value: "abc"}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("setting other type fails", func(ctx context.Context) {
+			_, err := stateManager.Set(ctx, "orders", "test", Data(&emptypb.Empty{}))
+			Expect(err).To(MatchError(state.ErrInvalidValue))
+		})
+
+		It("creating with other type fails", func(ctx context.Context) {
+			_, err := stateManager.Create(ctx, "orders", "test", Data(&emptypb.Empty{}))
+			Expect(err).To(MatchError(state.ErrInvalidValue))
+		})
+
+		It("updating with other type fails", func(ctx context.Context) {
+			revision, err := stateManager.Set(ctx, "orders", "test", Data(&testv1.StringValue{Value: "abc"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stateManager.Update(ctx, "orders", "test", Data(&emptypb.Empty{}), revision)
+			Expect(err).To(MatchError(state.ErrInvalidValue))
+		})
+
+		It("setting data that can not be decoded fails", func(ctx context.Context) {
+			_, err := stateManager.Set(ctx, "orders", "test", invalidStringValue)
+			Expect(err).To(MatchError(state.ErrInvalidValue))
+		})
+
+		It("other stores accept any value", func(ctx context.Context) {
+			err := stateManager.EnsureStore(ctx, &state.StoreConfig{
+				Name: "other",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stateManager.Set(ctx, "other", "test", Data(&emptypb.Empty{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Subject bindings", func() {
+		It("subjects without binding accept any data", func(ctx context.Context) {
+			err := manager.ValidateEvent(ctx, "orders.created", Data(&emptypb.Empty{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("bound subjects accept bound types", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.created", Data(&testv1.StringValue{Value: "abc"}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("bound subjects reject other types", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.created", Data(&emptypb.Empty{}))
+			Expect(err).To(MatchError(events.ErrInvalidData))
+		})
+
+		It("bound subjects reject data that can not be decoded", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.created", invalidStringValue)
+			Expect(err).To(MatchError(events.ErrInvalidData))
+		})
+
+		It("patterns with wildcards match subjects", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.*.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "invoices.>",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			data := Data(&emptypb.Empty{})
+			Expect(manager.ValidateEvent(ctx, "orders.eu.created", data)).To(MatchError(events.ErrInvalidData))
+			Expect(manager.ValidateEvent(ctx, "orders.eu.sweden.created", data)).ToNot(HaveOccurred())
+			Expect(manager.ValidateEvent(ctx, "orders.created", data)).ToNot(HaveOccurred())
+			Expect(manager.ValidateEvent(ctx, "invoices.sent", data)).To(MatchError(events.ErrInvalidData))
+			Expect(manager.ValidateEvent(ctx, "invoices.eu.sent", data)).To(MatchError(events.ErrInvalidData))
+			Expect(manager.ValidateEvent(ctx, "invoices", data)).ToNot(HaveOccurred())
+		})
+
+		It("types of all matching patterns are allowed", func(ctx context.Context) {
+			_, err := manager.RegisterSchemas(ctx, Files(OrdersFile()), schemas.CompatibilityDefault)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.>",
+				Types:   []string{"orders.v1.OrderCreated"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.legacy",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.legacy", Data(&testv1.StringValue{Value: "abc"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.created", Data(&testv1.StringValue{Value: "abc"}))
+			Expect(err).To(MatchError(events.ErrInvalidData))
+		})
+
+		It("deleting a binding accepts any data again", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.DeleteSubjectBinding(ctx, "orders.created")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.ValidateEvent(ctx, "orders.created", Data(&emptypb.Empty{}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deleting a missing binding fails", func(ctx context.Context) {
+			err := manager.DeleteSubjectBinding(ctx, "orders.created")
+			Expect(err).To(MatchError(schemas.ErrBindingNotFound))
+		})
+
+		It("binding an unregistered type fails", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.created",
+				Types:   []string{"orders.v1.Unknown"},
+			})
+			Expect(err).To(MatchError(schemas.ErrSchemaNotFound))
+		})
+
+		It("binding an invalid pattern fails", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.>.created",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(schemas.IsValidationError(err)).To(BeTrue())
+		})
+
+		It("can list bindings", func(ctx context.Context) {
+			err := manager.EnsureSubjectBinding(ctx, &schemas.SubjectBinding{
+				Pattern: "orders.>",
+				Types:   []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = manager.EnsureStoreBinding(ctx, &schemas.StoreBinding{
+				Store: "orders",
+				Types: []string{"windshift.test.v1.StringValue"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			bindings, err := manager.ListBindings(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bindings.Subjects).To(HaveLen(1))
+			Expect(bindings.Subjects[0].Pattern).To(Equal("orders.>"))
+			Expect(bindings.Stores).To(HaveLen(1))
+			Expect(bindings.Stores[0].Store).To(Equal("orders"))
+		})
+	})
+})
@@ -16,6 +16,17 @@ var ErrKeyAlreadyExists = errors.New("key already exists")
 // revision of a key.
 var ErrRevisionMismatch = errors.New("revision mismatch")
 
+// ErrRevisionUnavailable is returned when a revision is no longer in the
+// history of a store, such as when older revisions of a key have been
+// removed. A watch resuming from it must read the store again from its
+// current values. Watches report it conservatively, also when the changes
+// were only removed because of the history limit of the store.
+var ErrRevisionUnavailable = errors.New("revision not available")
+
+// ErrInvalidValue is returned when a value is not allowed by the schemas
+// bound to a store.
+var ErrInvalidValue = errors.New("invalid value")
+
 type validationError struct {
 	err string
 }
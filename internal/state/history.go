@@ -0,0 +1,218 @@
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// HistoryEntry is a past revision of a key, either a value or a delete
+// marker.
+type HistoryEntry struct {
+	Timestamp time.Time
+	Revision  uint64
+	// Deleted is set if the key was deleted in this revision, in which case
+	// Value is nil.
+	Deleted bool
+	Value   *anypb.Any
+}
+
+// GetHistory returns the revisions of a key kept by the store, oldest first.
+// How many revisions are kept depends on the history of the store. If the key
+// has no revisions, ErrKeyNotFound is returned.
+func (m *Manager) GetHistory(ctx context.Context, store string, key string) ([]*HistoryEntry, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"HISTORY "+store,
+		trace.WithAttributes(
+			semconv.DBSystemKey.String("windshift"),
+			semconv.DBName(store),
+			semconv.DBOperation("history"),
+			semconv.DBStatement("history "+key),
+		),
+	)
+	defer span.End()
+
+	err := validatePreconditions(store, key)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	bucket, err := m.stores.Get(ctx, store)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get store")
+		return nil, err
+	}
+
+	entries, err := bucket.History(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		span.SetStatus(codes.Error, "key not found")
+		return nil, errors.WithStack(ErrKeyNotFound)
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get history")
+		return nil, errors.Wrap(err, "failed to get history")
+	}
+
+	result := make([]*HistoryEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = &HistoryEntry{
+			Timestamp: entry.Created(),
+			Revision:  entry.Revision(),
+		}
+
+		if entry.Operation() != jetstream.KeyValuePut {
+			result[i].Deleted = true
+			continue
+		}
+
+		var value anypb.Any
+		err = proto.Unmarshal(entry.Value(), &value)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to unmarshal value")
+			return nil, errors.Wrap(err, "failed to unmarshal value")
+		}
+
+		result[i].Value = &value
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return result, nil
+}
+
+// GetAtRevision returns the value a key had at the given revision of the
+// store, which does not have to be a revision of the key itself. If the key
+// did not exist or was deleted at that revision, ErrKeyNotFound is returned.
+// If the revision is older than the history kept for the key,
+// ErrRevisionUnavailable is returned.
+func (m *Manager) GetAtRevision(ctx context.Context, store string, key string, revision uint64) (*Entry, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"GET "+store,
+		trace.WithAttributes(
+			semconv.DBSystemKey.String("windshift"),
+			semconv.DBName(store),
+			semconv.DBOperation("get"),
+			semconv.DBStatement("get "+key),
+			attribute.Int64("db.windshift.revision", int64(revision)),
+		),
+	)
+	defer span.End()
+
+	if revision == 0 {
+		span.SetStatus(codes.Error, "invalid revision")
+		return nil, newValidationError("revision must be greater than 0")
+	}
+
+	entry, err := m.getAt(ctx, store, key, func(entry jetstream.KeyValueEntry) bool {
+		return entry.Revision() <= revision
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return entry, nil
+}
+
+// GetAtTime returns the value a key had at the given time. If the key did not
+// exist or was deleted at that time, ErrKeyNotFound is returned. If the time is
+// older than the history kept for the key, ErrRevisionUnavailable is
+// returned.
+func (m *Manager) GetAtTime(ctx context.Context, store string, key string, at time.Time) (*Entry, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"GET "+store,
+		trace.WithAttributes(
+			semconv.DBSystemKey.String("windshift"),
+			semconv.DBName(store),
+			semconv.DBOperation("get"),
+			semconv.DBStatement("get "+key),
+			attribute.String("db.windshift.as_of", at.Format(time.RFC3339Nano)),
+		),
+	)
+	defer span.End()
+
+	entry, err := m.getAt(ctx, store, key, func(entry jetstream.KeyValueEntry) bool {
+		return !entry.Created().After(at)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return entry, nil
+}
+
+// getAt returns the latest revision of a key that was made at or before a
+// point in time, as checked by the before function.
+func (m *Manager) getAt(ctx context.Context, store string, key string, before func(jetstream.KeyValueEntry) bool) (*Entry, error) {
+	err := validatePreconditions(store, key)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := m.stores.Get(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := bucket.History(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, errors.WithStack(ErrKeyNotFound)
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to get history")
+	}
+
+	var found jetstream.KeyValueEntry
+	for _, entry := range entries {
+		if !before(entry) {
+			break
+		}
+
+		found = entry
+	}
+
+	if found == nil {
+		// Older revisions are removed when the history of the store is full
+		// or when the key is purged, in which case the value at the point
+		// in time is not known
+		status, err := bucket.Status(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get store status")
+		}
+
+		if int64(len(entries)) >= status.History() || entries[0].Operation() == jetstream.KeyValuePurge {
+			return nil, errors.WithStack(ErrRevisionUnavailable)
+		}
+
+		return nil, errors.WithStack(ErrKeyNotFound)
+	} else if found.Operation() != jetstream.KeyValuePut {
+		return nil, errors.WithStack(ErrKeyNotFound)
+	}
+
+	var value anypb.Any
+	err = proto.Unmarshal(found.Value(), &value)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal value")
+	}
+
+	return &Entry{
+		Timestamp: found.Created(),
+		Revision:  found.Revision(),
+		Value:     &value,
+	}, nil
+}
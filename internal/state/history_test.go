@@ -0,0 +1,156 @@
+package state_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("History", func() {
+	var manager *state.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:    "test",
+			History: 5,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	set := func(ctx context.Context, key string, value string) uint64 {
+		GinkgoHelper()
+
+		revision, err := manager.Set(ctx, "test", key, Data(wrapperspb.String(value)))
+		Expect(err).ToNot(HaveOccurred())
+		return revision
+	}
+
+	valueOf := func(value *anypb.Any) string {
+		GinkgoHelper()
+
+		var s wrapperspb.StringValue
+		Expect(value.UnmarshalTo(&s)).To(Succeed())
+		return s.Value
+	}
+
+	Describe("GetHistory", func() {
+		It("returns revisions and delete markers oldest first", func(ctx context.Context) {
+			first := set(ctx, "key", "v1")
+			set(ctx, "other", "v1")
+			second := set(ctx, "key", "v2")
+			Expect(manager.Delete(ctx, "test", "key")).To(Succeed())
+
+			history, err := manager.GetHistory(ctx, "test", "key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(3))
+			Expect(history[0].Revision).To(Equal(first))
+			Expect(valueOf(history[0].Value)).To(Equal("v1"))
+			Expect(history[1].Revision).To(Equal(second))
+			Expect(valueOf(history[1].Value)).To(Equal("v2"))
+			Expect(history[2].Deleted).To(BeTrue())
+			Expect(history[2].Value).To(BeNil())
+			Expect(history[2].Timestamp).ToNot(BeZero())
+		})
+
+		It("only keeps the history of the store", func(ctx context.Context) {
+			for i := 0; i < 7; i++ {
+				set(ctx, "key", "value")
+			}
+
+			history, err := manager.GetHistory(ctx, "test", "key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(5))
+		})
+
+		It("returns an error for keys without history", func(ctx context.Context) {
+			_, err := manager.GetHistory(ctx, "test", "key")
+			Expect(err).To(MatchError(state.ErrKeyNotFound))
+		})
+	})
+
+	Describe("GetAtRevision", func() {
+		It("returns the value at the revision", func(ctx context.Context) {
+			first := set(ctx, "key", "v1")
+			other := set(ctx, "other", "v1")
+			set(ctx, "key", "v2")
+
+			entry, err := manager.GetAtRevision(ctx, "test", "key", first)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entry.Revision).To(Equal(first))
+			Expect(valueOf(entry.Value)).To(Equal("v1"))
+
+			// Revisions of other keys can be used
+			entry, err = manager.GetAtRevision(ctx, "test", "key", other)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entry.Revision).To(Equal(first))
+		})
+
+		It("returns not found if the key did not exist yet", func(ctx context.Context) {
+			other := set(ctx, "other", "v1")
+			set(ctx, "key", "v1")
+
+			_, err := manager.GetAtRevision(ctx, "test", "key", other)
+			Expect(err).To(MatchError(state.ErrKeyNotFound))
+		})
+
+		It("returns not found if the key was deleted", func(ctx context.Context) {
+			set(ctx, "key", "v1")
+			Expect(manager.Delete(ctx, "test", "key")).To(Succeed())
+			last := set(ctx, "other", "v1")
+			set(ctx, "key", "v2")
+
+			_, err := manager.GetAtRevision(ctx, "test", "key", last)
+			Expect(err).To(MatchError(state.ErrKeyNotFound))
+		})
+
+		It("returns an error if the revision is no longer kept", func(ctx context.Context) {
+			first := set(ctx, "key", "v0")
+			for i := 0; i < 5; i++ {
+				set(ctx, "key", "value")
+			}
+
+			_, err := manager.GetAtRevision(ctx, "test", "key", first)
+			Expect(err).To(MatchError(state.ErrRevisionUnavailable))
+		})
+
+		It("requires a revision", func(ctx context.Context) {
+			_, err := manager.GetAtRevision(ctx, "test", "key", 0)
+			Expect(state.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	Describe("GetAtTime", func() {
+		It("returns the value at the time", func(ctx context.Context) {
+			set(ctx, "key", "v1")
+			time.Sleep(10 * time.Millisecond)
+			at := time.Now()
+			time.Sleep(10 * time.Millisecond)
+			set(ctx, "key", "v2")
+
+			entry, err := manager.GetAtTime(ctx, "test", "key", at)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valueOf(entry.Value)).To(Equal("v1"))
+
+			entry, err = manager.GetAtTime(ctx, "test", "key", time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(valueOf(entry.Value)).To(Equal("v2"))
+		})
+
+		It("returns not found before the key existed", func(ctx context.Context) {
+			at := time.Now()
+			time.Sleep(10 * time.Millisecond)
+			set(ctx, "key", "v1")
+
+			_, err := manager.GetAtTime(ctx, "test", "key", at)
+			Expect(err).To(MatchError(state.ErrKeyNotFound))
+		})
+	})
+})
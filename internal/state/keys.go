@@ -0,0 +1,160 @@
+package state
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListKeysConfig is the configuration for listing keys in a store.
+type ListKeysConfig struct {
+	// Store is the name of the store to list keys in.
+	Store string
+	// Key limits the keys listed to those matching it, may contain the
+	// wildcards `*` and `>`. Optional, all keys are listed if empty.
+	Key string
+	// Prefix limits the keys listed to those starting with it. Optional.
+	Prefix string
+	// After is the key to list keys after, used for paging.
+	After string
+	// Limit is the maximum number of keys to return.
+	Limit uint
+}
+
+// KeyInfo is a key in a store together with its current revision.
+type KeyInfo struct {
+	Key       string
+	Revision  uint64
+	Timestamp time.Time
+}
+
+// KeyList is a page of keys.
+type KeyList struct {
+	// Keys in the page, ordered by key.
+	Keys []*KeyInfo
+	// HasMore indicates if there are more keys after this page.
+	HasMore bool
+}
+
+// ListKeys returns a page of keys in a store ordered by key. Deleted keys are
+// not listed.
+//
+// NATS can only list keys in the order they were last updated, so every page
+// reads the metadata of all keys matching Key, or Prefix if it ends with a
+// `.`. Only the keys of the page are kept, so memory use is limited by Limit.
+func (m *Manager) ListKeys(ctx context.Context, config *ListKeysConfig) (*KeyList, error) {
+	ctx, span := m.tracer.Start(
+		ctx,
+		"LIST "+config.Store,
+		trace.WithAttributes(
+			semconv.DBSystemKey.String("windshift"),
+			semconv.DBName(config.Store),
+			semconv.DBOperation("list"),
+			semconv.DBStatement("list "+config.Key),
+		),
+	)
+	defer span.End()
+
+	if !IsValidStoreName(config.Store) {
+		span.SetStatus(codes.Error, "invalid store name")
+		return nil, newValidationError("invalid store name: " + config.Store)
+	}
+
+	if config.Key != "" && !events.IsValidSubject(config.Key, true) {
+		span.SetStatus(codes.Error, "invalid key")
+		return nil, newValidationError("invalid key: " + config.Key)
+	}
+
+	if config.Limit == 0 {
+		span.SetStatus(codes.Error, "limit must be greater than 0")
+		return nil, newValidationError("limit must be greater than 0")
+	}
+
+	bucket, err := m.stores.Get(ctx, config.Store)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get store")
+		return nil, err
+	}
+
+	key := config.Key
+	if key == "" {
+		key = ">"
+		if strings.HasSuffix(config.Prefix, ".") && events.IsValidSubject(config.Prefix+">", true) {
+			// Only read the keys below the prefix
+			key = config.Prefix + ">"
+		}
+	}
+
+	// Only the latest revision of each key is needed, and values are not
+	// fetched as only the metadata is returned
+	w, err := bucket.Watch(ctx, key, jetstream.MetaOnly(), jetstream.IgnoreDeletes())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list keys")
+		return nil, errors.Wrap(err, "failed to list keys")
+	}
+	defer w.Stop() //nolint:errcheck
+
+	// Keys are kept sorted, with one more than the limit to know if there
+	// are more keys after the page
+	keys := make([]*KeyInfo, 0, config.Limit+1)
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			span.SetStatus(codes.Error, "context done")
+			return nil, errors.WithStack(ctx.Err())
+		case entry, ok := <-w.Updates():
+			if !ok {
+				span.SetStatus(codes.Error, "watcher stopped")
+				return nil, errors.New("watcher stopped while listing keys")
+			} else if entry == nil {
+				// All current keys have been received
+				done = true
+				continue
+			}
+
+			if entry.Key() <= config.After || !strings.HasPrefix(entry.Key(), config.Prefix) {
+				continue
+			}
+
+			i, _ := slices.BinarySearchFunc(keys, entry.Key(), func(k *KeyInfo, key string) int {
+				return strings.Compare(k.Key, key)
+			})
+			if uint(i) > config.Limit {
+				// After the page
+				continue
+			}
+
+			keys = slices.Insert(keys, i, &KeyInfo{
+				Key:       entry.Key(),
+				Revision:  entry.Revision(),
+				Timestamp: entry.Created(),
+			})
+			if uint(len(keys)) > config.Limit+1 {
+				keys = keys[:config.Limit+1]
+			}
+		}
+	}
+
+	result := &KeyList{
+		Keys:    keys,
+		HasMore: uint(len(keys)) > config.Limit,
+	}
+
+	if result.HasMore {
+		result.Keys = keys[:config.Limit]
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return result, nil
+}
@@ -0,0 +1,165 @@
+package state_test
+
+import (
+	"context"
+
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("ListKeys", func() {
+	var manager *state.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, key := range []string{"config.b", "config.a", "cache.a", "cache.b.c"} {
+			_, err = manager.Set(ctx, "test", key, Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	list := func(ctx context.Context, config *state.ListKeysConfig) *state.KeyList {
+		GinkgoHelper()
+
+		config.Store = "test"
+		if config.Limit == 0 {
+			config.Limit = 100
+		}
+
+		list, err := manager.ListKeys(ctx, config)
+		Expect(err).ToNot(HaveOccurred())
+		return list
+	}
+
+	keys := func(list *state.KeyList) []string {
+		res := make([]string, len(list.Keys))
+		for i, key := range list.Keys {
+			res[i] = key.Key
+		}
+		return res
+	}
+
+	It("lists all keys ordered by key", func(ctx context.Context) {
+		res := list(ctx, &state.ListKeysConfig{})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "cache.b.c", "config.a", "config.b"}))
+		Expect(res.HasMore).To(BeFalse())
+	})
+
+	It("includes the revision and timestamp", func(ctx context.Context) {
+		revision, err := manager.Set(ctx, "test", "config.a", Data(wrapperspb.String("value2")))
+		Expect(err).ToNot(HaveOccurred())
+
+		res := list(ctx, &state.ListKeysConfig{
+			Key: "config.a",
+		})
+		Expect(res.Keys).To(HaveLen(1))
+		Expect(res.Keys[0].Revision).To(Equal(revision))
+		Expect(res.Keys[0].Timestamp).ToNot(BeZero())
+	})
+
+	It("does not list deleted keys", func(ctx context.Context) {
+		Expect(manager.Delete(ctx, "test", "config.a")).To(Succeed())
+
+		res := list(ctx, &state.ListKeysConfig{})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "cache.b.c", "config.b"}))
+	})
+
+	It("can filter keys with wildcards", func(ctx context.Context) {
+		res := list(ctx, &state.ListKeysConfig{
+			Key: "cache.>",
+		})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "cache.b.c"}))
+
+		res = list(ctx, &state.ListKeysConfig{
+			Key: "*.a",
+		})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "config.a"}))
+	})
+
+	It("can filter keys by prefix", func(ctx context.Context) {
+		res := list(ctx, &state.ListKeysConfig{
+			Prefix: "con",
+		})
+		Expect(keys(res)).To(Equal([]string{"config.a", "config.b"}))
+	})
+
+	It("can filter keys by a prefix ending with a token", func(ctx context.Context) {
+		res := list(ctx, &state.ListKeysConfig{
+			Prefix: "cache.",
+		})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "cache.b.c"}))
+	})
+
+	It("pages keys in order regardless of update order", func(ctx context.Context) {
+		_, err := manager.Set(ctx, "test", "cache.a", Data(wrapperspb.String("value2")))
+		Expect(err).ToNot(HaveOccurred())
+
+		res := list(ctx, &state.ListKeysConfig{
+			Limit: 1,
+		})
+		Expect(keys(res)).To(Equal([]string{"cache.a"}))
+		Expect(res.HasMore).To(BeTrue())
+
+		res = list(ctx, &state.ListKeysConfig{
+			After: "config.a",
+			Limit: 1,
+		})
+		Expect(keys(res)).To(Equal([]string{"config.b"}))
+		Expect(res.HasMore).To(BeFalse())
+	})
+
+	It("can page through keys", func(ctx context.Context) {
+		res := list(ctx, &state.ListKeysConfig{
+			Limit: 3,
+		})
+		Expect(keys(res)).To(Equal([]string{"cache.a", "cache.b.c", "config.a"}))
+		Expect(res.HasMore).To(BeTrue())
+
+		res = list(ctx, &state.ListKeysConfig{
+			After: "config.a",
+			Limit: 3,
+		})
+		Expect(keys(res)).To(Equal([]string{"config.b"}))
+		Expect(res.HasMore).To(BeFalse())
+	})
+
+	It("lists no keys in empty stores", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "empty",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		res, err := manager.ListKeys(ctx, &state.ListKeysConfig{
+			Store: "empty",
+			Limit: 10,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Keys).To(BeEmpty())
+	})
+
+	It("returns an error for invalid keys", func(ctx context.Context) {
+		_, err := manager.ListKeys(ctx, &state.ListKeysConfig{
+			Store: "test",
+			Key:   "config..a",
+			Limit: 10,
+		})
+		Expect(state.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("returns an error for missing stores", func(ctx context.Context) {
+		_, err := manager.ListKeys(ctx, &state.ListKeysConfig{
+			Store: "unknown",
+			Limit: 10,
+		})
+		Expect(err).To(MatchError(state.ErrStoreNotFound))
+	})
+})
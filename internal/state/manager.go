@@ -2,6 +2,8 @@ package state
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -19,13 +21,51 @@ type Manager struct {
 	logger *zap.Logger
 	tracer trace.Tracer
 
-	js jetstream.JetStream
+	js      jetstream.JetStream
+	schemas SchemaValidator
 
 	stores *keyValueStoreCache
 }
 
+// SchemaValidator checks values before they are stored.
+type SchemaValidator interface {
+	// ValidateValue returns an error marked with ErrInvalidValue if the value
+	// is not allowed in the store.
+	ValidateValue(ctx context.Context, store string, value *anypb.Any) error
+}
+
+// StorageType controls the type of storage to use for a store.
+type StorageType int
+
+const (
+	// StorageTypeFile uses file storage for the store.
+	StorageTypeFile StorageType = iota
+	// StorageTypeMemory uses memory storage for the store.
+	StorageTypeMemory
+)
+
+// StoreConfig is the configuration for creating or updating a store.
 type StoreConfig struct {
+	// Name of the store.
 	Name string
+	// Description of the store.
+	Description string
+
+	// History is the number of revisions to keep of each key, at most 64.
+	// Defaults to 1 if zero.
+	History uint
+	// TTL is how long a value is kept after it was last set. Values are kept
+	// forever if zero.
+	TTL time.Duration
+	// MaxValueSize is the maximum size of a value in bytes. Unlimited if zero.
+	MaxValueSize uint
+	// MaxBytes is the maximum size of the store in bytes. Unlimited if zero.
+	MaxBytes uint
+
+	// StorageType is the type of storage to use for the store.
+	StorageType StorageType
+	// Replicas is the number of replicas to keep of the store.
+	Replicas *uint
 }
 
 type Entry struct {
@@ -43,12 +83,14 @@ func NewManager(
 	logger *zap.Logger,
 	tracer trace.Tracer,
 	js jetstream.JetStream,
+	schemas SchemaValidator,
 ) (*Manager, error) {
 	manager := &Manager{
 		logger: logger,
 		tracer: tracer,
 
-		js: js,
+		js:      js,
+		schemas: schemas,
 
 		stores: newKeyValueStoreCache(10*time.Minute, func(ctx context.Context, name string) (jetstream.KeyValue, error) {
 			res, err := js.KeyValue(ctx, name)
@@ -86,11 +128,44 @@ func (m *Manager) EnsureStore(ctx context.Context, config *StoreConfig) error {
 		return newValidationError("invalid store name: " + config.Name)
 	}
 
+	if config.History > jetstream.KeyValueMaxHistory {
+		span.SetStatus(codes.Error, "history too large")
+		return newValidationError(fmt.Sprintf("history can be at most %d", jetstream.KeyValueMaxHistory))
+	}
+
+	if config.MaxValueSize > math.MaxInt32 {
+		span.SetStatus(codes.Error, "max value size too large")
+		return newValidationError(fmt.Sprintf("max value size can be at most %d", math.MaxInt32))
+	}
+
+	kvConfig := jetstream.KeyValueConfig{
+		Bucket:       config.Name,
+		Description:  config.Description,
+		History:      uint8(config.History),
+		TTL:          config.TTL,
+		MaxValueSize: int32(config.MaxValueSize),
+		MaxBytes:     int64(config.MaxBytes),
+		Storage:      jetstream.FileStorage,
+		Replicas:     1,
+	}
+
+	if config.StorageType == StorageTypeMemory {
+		kvConfig.Storage = jetstream.MemoryStorage
+	}
+
+	if config.Replicas != nil {
+		if *config.Replicas == 0 {
+			span.SetStatus(codes.Error, "invalid replicas")
+			return newValidationError("replicas must be greater than 0")
+		}
+
+		kvConfig.Replicas = int(*config.Replicas)
+	}
+
 	_, err := m.stores.Get(ctx, config.Name)
 	if errors.Is(err, ErrStoreNotFound) {
-		_, err = m.js.CreateKeyValue(ctx, jetstream.KeyValueConfig{
-			Bucket: config.Name,
-		})
+		m.logger.Info("Creating store", zap.String("name", config.Name))
+		_, err = m.js.CreateKeyValue(ctx, kvConfig)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, "failed to create store")
@@ -100,6 +175,27 @@ func (m *Manager) EnsureStore(ctx context.Context, config *StoreConfig) error {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to get store")
 		return errors.Wrap(err, "failed to get store")
+	} else {
+		stream, err2 := m.js.Stream(ctx, "KV_"+config.Name)
+		if err2 != nil {
+			span.RecordError(err2)
+			span.SetStatus(codes.Error, "failed to get store info")
+			return errors.Wrap(err2, "failed to get store info")
+		}
+
+		// NATS can not move a stream between storage types
+		if stream.CachedInfo().Config.Storage != kvConfig.Storage {
+			span.SetStatus(codes.Error, "storage type changed")
+			return newValidationError("storage type of store can not be changed: " + config.Name)
+		}
+
+		m.logger.Info("Updating store", zap.String("name", config.Name))
+		_, err = m.js.UpdateKeyValue(ctx, kvConfig)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to update store")
+			return errors.Wrap(err, "failed to update store")
+		}
 	}
 
 	span.SetStatus(codes.Ok, "")
@@ -190,6 +286,13 @@ func (m *Manager) Create(ctx context.Context, store string, key string, value *a
 		return 0, err
 	}
 
+	err = m.schemas.ValidateValue(ctx, store, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid value")
+		return 0, err
+	}
+
 	data, err := proto.Marshal(value)
 	if err != nil {
 		span.RecordError(err)
@@ -240,6 +343,13 @@ func (m *Manager) Set(ctx context.Context, store string, key string, value *anyp
 		return 0, err
 	}
 
+	err = m.schemas.ValidateValue(ctx, store, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid value")
+		return 0, err
+	}
+
 	data, err := proto.Marshal(value)
 	if err != nil {
 		span.RecordError(err)
@@ -288,6 +398,13 @@ func (m *Manager) Update(ctx context.Context, store string, key string, value *a
 		return 0, err
 	}
 
+	err = m.schemas.ValidateValue(ctx, store, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid value")
+		return 0, err
+	}
+
 	data, err := proto.Marshal(value)
 	if err != nil {
 		span.RecordError(err)
@@ -2,9 +2,11 @@ package state_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/levelfourab/windshift-server/internal/state"
 
+	"github.com/nats-io/nats.go/jetstream"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -109,3 +111,116 @@ var _ = Describe("State Store", func() {
 		Expect(err).To(MatchError(state.ErrRevisionMismatch))
 	})
 })
+
+var _ = Describe("EnsureStore", func() {
+	var manager *state.Manager
+	var js jetstream.JetStream
+
+	BeforeEach(func() {
+		manager, js = createManagerAndJetStream()
+	})
+
+	streamConfig := func(ctx context.Context, name string) jetstream.StreamConfig {
+		GinkgoHelper()
+
+		stream, err := js.Stream(ctx, "KV_"+name)
+		Expect(err).ToNot(HaveOccurred())
+		return stream.CachedInfo().Config
+	}
+
+	It("creates a store with defaults", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		config := streamConfig(ctx, "test")
+		Expect(config.MaxMsgsPerSubject).To(BeEquivalentTo(1))
+		Expect(config.MaxAge).To(BeZero())
+		Expect(config.Storage).To(Equal(jetstream.FileStorage))
+		Expect(config.Replicas).To(Equal(1))
+	})
+
+	It("creates a store with options", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:         "test",
+			Description:  "Test store",
+			History:      10,
+			TTL:          time.Hour,
+			MaxValueSize: 1024,
+			MaxBytes:     1024 * 1024,
+			StorageType:  state.StorageTypeMemory,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		config := streamConfig(ctx, "test")
+		Expect(config.Description).To(Equal("Test store"))
+		Expect(config.MaxMsgsPerSubject).To(BeEquivalentTo(10))
+		Expect(config.MaxAge).To(Equal(time.Hour))
+		Expect(config.MaxMsgSize).To(BeEquivalentTo(1024))
+		Expect(config.MaxBytes).To(BeEquivalentTo(1024 * 1024))
+		Expect(config.Storage).To(Equal(jetstream.MemoryStorage))
+	})
+
+	It("updates an existing store", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = manager.Set(ctx, "test", "key", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:    "test",
+			History: 5,
+			TTL:     time.Hour,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		config := streamConfig(ctx, "test")
+		Expect(config.MaxMsgsPerSubject).To(BeEquivalentTo(5))
+		Expect(config.MaxAge).To(Equal(time.Hour))
+
+		value, err := manager.Get(ctx, "test", "key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).ToNot(BeNil())
+	})
+
+	It("fails if the storage type is changed", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:        "test",
+			StorageType: state.StorageTypeMemory,
+		})
+		Expect(state.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("does not allow store names reserved for internal use", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "windshift-locks",
+		})
+		Expect(state.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("does not allow more than 64 revisions of history", func(ctx context.Context) {
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:    "test",
+			History: 65,
+		})
+		Expect(state.IsValidationError(err)).To(BeTrue())
+	})
+
+	It("requires at least one replica", func(ctx context.Context) {
+		replicas := uint(0)
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name:     "test",
+			Replicas: &replicas,
+		})
+		Expect(state.IsValidationError(err)).To(BeTrue())
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/levelfourab/windshift-server/internal/schemas"
 	"github.com/levelfourab/windshift-server/internal/state"
 
 	"github.com/nats-io/nats-server/v2/server"
@@ -55,10 +56,22 @@ func createManagerAndJetStream() (*state.Manager, jetstream.JetStream) {
 	js, err := jetstream.New(natsConn)
 	Expect(err).ToNot(HaveOccurred())
 
+	schemaManager, err := schemas.NewManager(
+		zaptest.NewLogger(GinkgoT()),
+		otel.Tracer("tests"),
+		js,
+		&schemas.Config{
+			Compatibility: schemas.CompatibilityBackward,
+		},
+	)
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(schemaManager.Destroy)
+
 	manager, err := state.NewManager(
 		zaptest.NewLogger(GinkgoT()),
 		otel.Tracer("tests"),
 		js,
+		schemaManager,
 	)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -1,10 +1,16 @@
 package state
 
-import "github.com/levelfourab/windshift-server/internal/events"
+import (
+	"strings"
 
-// IsValidStoreName checks if the store name is valid.
+	"github.com/levelfourab/windshift-server/internal/events"
+)
+
+// IsValidStoreName checks if the store name is valid. Names starting with
+// `windshift-` are reserved for the buckets Windshift uses internally, such
+// as for locks, schedules and schemas, and are not valid store names.
 func IsValidStoreName(name string) bool {
-	return events.IsValidStreamName(name)
+	return events.IsValidStreamName(name) && !strings.HasPrefix(name, "windshift-")
 }
 
 // IsValidKey checks if the key name is valid. The NATS documentation says
@@ -2,127 +2,268 @@ package state
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/levelfourab/windshift-server/internal/events"
+
+	"github.com/cockroachdb/errors"
 	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/codes"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
+// KeyEvent is an update sent by a Watcher, either a KeySetEvent, a
+// KeyDeleteEvent or a CaughtUpEvent.
 type KeyEvent interface {
 	isKeyEvent()
 }
 
+// KeySetEvent is sent when the value of a key is set.
 type KeySetEvent struct {
-	Key      string
-	Revision uint64
-	Value    []byte
+	Key       string
+	Revision  uint64
+	Timestamp time.Time
+	Value     *anypb.Any
 }
 
 func (KeySetEvent) isKeyEvent() {}
 
+// KeyDeleteEvent is sent when a key is deleted.
 type KeyDeleteEvent struct {
-	Key      string
-	Revision uint64
+	Key       string
+	Revision  uint64
+	Timestamp time.Time
 }
 
 func (KeyDeleteEvent) isKeyEvent() {}
 
+// CaughtUpEvent is sent once the current values of all matching keys have
+// been sent, if they were requested. Events after it are updates.
+type CaughtUpEvent struct{}
+
+func (CaughtUpEvent) isKeyEvent() {}
+
+// WatchConfig is the configuration for watching keys in a store.
+type WatchConfig struct {
+	// Store is the name of the store to watch.
+	Store string
+	// Key is the key to watch, may contain the wildcards `*` and `>`.
+	// Optional, all keys are watched if empty.
+	Key string
+	// IncludeCurrent sends the current values of matching keys before any
+	// updates, followed by a CaughtUpEvent. If not set only updates made
+	// after the watch started are sent.
+	IncludeCurrent bool
+	// ResumeFromRevision sends every change made after this revision, such
+	// as the last revision seen before a watcher disconnected, followed by a
+	// CaughtUpEvent. Can not be combined with IncludeCurrent. If the first
+	// change after the revision is no longer in the store, for any reason
+	// including the history limit of the store, ErrRevisionUnavailable is
+	// returned.
+	ResumeFromRevision uint64
+}
+
+// Watcher sends updates to keys in a store. The watcher stops when the
+// context used to create it is done, or when Stop is called.
 type Watcher struct {
-	ctx         context.Context
+	logger      *zap.Logger
 	natsWatcher jetstream.KeyWatcher
-	stopCh      chan struct{}
+	updates     chan KeyEvent
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	stopErr  error
 }
 
+// Updates returns the channel updates are sent to. The channel is closed
+// when the watcher stops.
 func (w *Watcher) Updates() <-chan KeyEvent {
-	ch := make(chan KeyEvent)
-	go func() {
-		for {
-			select {
-			case <-w.ctx.Done():
-				close(ch)
-				return
-			case <-w.stopCh:
-				close(ch)
+	return w.updates
+}
+
+// Stop stops the watcher.
+func (w *Watcher) Stop() error {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+		w.stopErr = w.natsWatcher.Stop()
+	})
+	return w.stopErr
+}
+
+func (w *Watcher) run() {
+	defer close(w.updates)
+
+	for {
+		var entry jetstream.KeyValueEntry
+		var ok bool
+		select {
+		case <-w.stopCh:
+			return
+		case entry, ok = <-w.natsWatcher.Updates():
+			if !ok {
 				return
-			case e := <-w.natsWatcher.Updates():
-				if e.Operation() == jetstream.KeyValueDelete || e.Operation() == jetstream.KeyValuePurge {
-					ch <- KeyDeleteEvent{
-						Key:      e.Key(),
-						Revision: e.Revision(),
-					}
-				} else if e.Operation() == jetstream.KeyValuePut {
-					ch <- KeySetEvent{
-						Key:      e.Key(),
-						Revision: e.Revision(),
-						Value:    e.Value(),
-					}
-				}
 			}
 		}
-	}()
-	return ch
+
+		event, err := toKeyEvent(entry)
+		if err != nil {
+			w.logger.Warn("Could not read update of key", zap.String("key", entry.Key()), zap.Error(err))
+			continue
+		} else if event == nil {
+			continue
+		}
+
+		select {
+		case <-w.stopCh:
+			return
+		case w.updates <- event:
+		}
+	}
 }
 
-func (w *Watcher) Stop() error {
-	close(w.stopCh)
-	return w.natsWatcher.Stop()
+// toKeyEvent converts an entry of a KeyValue watcher into an event. A nil
+// entry marks that all current values have been sent.
+func toKeyEvent(entry jetstream.KeyValueEntry) (KeyEvent, error) {
+	if entry == nil {
+		return CaughtUpEvent{}, nil
+	}
+
+	switch entry.Operation() {
+	case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+		return KeyDeleteEvent{
+			Key:       entry.Key(),
+			Revision:  entry.Revision(),
+			Timestamp: entry.Created(),
+		}, nil
+	case jetstream.KeyValuePut:
+		var value anypb.Any
+		err := proto.Unmarshal(entry.Value(), &value)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal value")
+		}
+
+		return KeySetEvent{
+			Key:       entry.Key(),
+			Revision:  entry.Revision(),
+			Timestamp: entry.Created(),
+			Value:     &value,
+		}, nil
+	}
+
+	return nil, nil
 }
 
-// Watch watches the given store for changes.
-func (m *Manager) Watch(ctx context.Context, store string, key string) (*Watcher, error) {
+// Watch watches a store for changes to keys.
+func (m *Manager) Watch(ctx context.Context, config *WatchConfig) (*Watcher, error) {
 	ctx, span := m.tracer.Start(
 		ctx,
-		"WATCH "+store,
+		"WATCH "+config.Store,
 		trace.WithAttributes(
 			semconv.DBSystemKey.String("windshift"),
-			semconv.DBName(store),
+			semconv.DBName(config.Store),
 			semconv.DBOperation("watch"),
-			semconv.DBStatement("watch "+key),
+			semconv.DBStatement("watch "+config.Key),
 		),
 	)
 	defer span.End()
 
-	bucket, err := m.stores.Get(ctx, store)
+	if !IsValidStoreName(config.Store) {
+		span.SetStatus(codes.Error, "invalid store name")
+		return nil, newValidationError("invalid store name: " + config.Store)
+	}
+
+	if config.Key != "" && !events.IsValidSubject(config.Key, true) {
+		span.SetStatus(codes.Error, "invalid key")
+		return nil, newValidationError("invalid key: " + config.Key)
+	}
+
+	if config.IncludeCurrent && config.ResumeFromRevision > 0 {
+		span.SetStatus(codes.Error, "invalid config")
+		return nil, newValidationError("include current and resume from revision can not both be set")
+	}
+
+	bucket, err := m.stores.Get(ctx, config.Store)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to get store")
 		return nil, err
 	}
 
-	w, err := bucket.Watch(ctx, key)
+	var opts []jetstream.WatchOpt
+	if config.ResumeFromRevision > 0 {
+		// The revision of a key is the sequence of its stream, so the
+		// watcher starts at the first sequence after the revision
+		opts = append(opts, jetstream.ResumeFromRevision(config.ResumeFromRevision+1))
+	} else if !config.IncludeCurrent {
+		opts = append(opts, jetstream.UpdatesOnly())
+	}
+
+	key := config.Key
+	if key == "" {
+		key = ">"
+	}
+
+	// The watcher is stopped through Stop, which is also called when the
+	// context is done
+	w, err := bucket.Watch(context.WithoutCancel(ctx), key, opts...)
 	if err != nil {
-		return nil, err
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to watch")
+		return nil, errors.Wrap(err, "failed to watch")
+	}
+
+	if config.ResumeFromRevision > 0 {
+		// Checked after the watcher has started, so changes removed while
+		// starting it are also detected
+		err = m.checkRevisionAvailable(ctx, config.Store, config.ResumeFromRevision)
+		if err != nil {
+			_ = w.Stop()
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "revision unavailable")
+			return nil, err
+		}
 	}
 
-	return &Watcher{
-		ctx:         ctx,
+	watcher := &Watcher{
+		logger:      m.logger,
 		natsWatcher: w,
+		updates:     make(chan KeyEvent),
 		stopCh:      make(chan struct{}),
-	}, nil
-}
+	}
+	go watcher.run()
 
-func (m *Manager) WatchAll(ctx context.Context, store string) (*Watcher, error) {
-	ctx, span := m.tracer.Start(
-		ctx,
-		"WATCH "+store,
-		trace.WithAttributes(
-			semconv.DBSystemKey.String("windshift"),
-			semconv.DBName(store),
-			semconv.DBOperation("watch"),
-			semconv.DBStatement("watch"),
-		),
-	)
-	defer span.End()
+	context.AfterFunc(ctx, func() {
+		_ = watcher.Stop()
+	})
 
-	bucket, err := m.stores.Get(ctx, store)
+	span.SetStatus(codes.Ok, "")
+	return watcher, nil
+}
+
+// checkRevisionAvailable checks that the first change after a revision is
+// still in the stream backing a store. The check is conservative: the stream
+// does not record why a change was removed, so changes removed because a key
+// went over the history of the store are also reported as unavailable, even
+// though later revisions of the key are still sent. Changes removed in the
+// middle of the stream are not detected, as a newer revision of the same key
+// replaces them.
+func (m *Manager) checkRevisionAvailable(ctx context.Context, store string, revision uint64) error {
+	stream, err := m.js.Stream(ctx, "KV_"+store)
 	if err != nil {
-		return nil, err
+		return errors.Wrap(err, "failed to get store info")
 	}
 
-	w, err := bucket.WatchAll(ctx)
-	if err != nil {
-		return nil, err
+	streamState := stream.CachedInfo().State
+	if revision > streamState.LastSeq {
+		return errors.Wrapf(ErrRevisionUnavailable, "revision %d is newer than the store", revision)
+	} else if revision+1 < streamState.FirstSeq {
+		return errors.Wrapf(ErrRevisionUnavailable, "changes after revision %d have been removed", revision)
 	}
 
-	return &Watcher{
-		natsWatcher: w,
-	}, nil
+	return nil
 }
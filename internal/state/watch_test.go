@@ -0,0 +1,208 @@
+package state_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/levelfourab/windshift-server/internal/state"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Watch", func() {
+	var manager *state.Manager
+
+	BeforeEach(func(ctx context.Context) {
+		manager, _ = createManagerAndJetStream()
+
+		err := manager.EnsureStore(ctx, &state.StoreConfig{
+			Name: "test",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	watch := func(ctx context.Context, config *state.WatchConfig) *state.Watcher {
+		GinkgoHelper()
+
+		config.Store = "test"
+		watcher, err := manager.Watch(ctx, config)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(watcher.Stop)
+		return watcher
+	}
+
+	next := func(watcher *state.Watcher) state.KeyEvent {
+		GinkgoHelper()
+
+		var event state.KeyEvent
+		Eventually(watcher.Updates()).WithTimeout(2 * time.Second).Should(Receive(&event))
+		return event
+	}
+
+	It("receives set and delete updates", func(ctx context.Context) {
+		watcher := watch(ctx, &state.WatchConfig{})
+
+		revision, err := manager.Set(ctx, "test", "key", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next(watcher)
+		Expect(event).To(BeAssignableToTypeOf(state.KeySetEvent{}))
+		set := event.(state.KeySetEvent)
+		Expect(set.Key).To(Equal("key"))
+		Expect(set.Revision).To(Equal(revision))
+
+		var value wrapperspb.StringValue
+		Expect(set.Value.UnmarshalTo(&value)).To(Succeed())
+		Expect(value.Value).To(Equal("value"))
+
+		Expect(manager.Delete(ctx, "test", "key")).To(Succeed())
+
+		event = next(watcher)
+		Expect(event).To(BeAssignableToTypeOf(state.KeyDeleteEvent{}))
+		Expect(event.(state.KeyDeleteEvent).Key).To(Equal("key"))
+		Expect(event.(state.KeyDeleteEvent).Revision).To(BeNumerically(">", revision))
+	})
+
+	It("only receives updates for matching keys", func(ctx context.Context) {
+		watcher := watch(ctx, &state.WatchConfig{
+			Key: "config.*",
+		})
+
+		_, err := manager.Set(ctx, "test", "other", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = manager.Set(ctx, "test", "config.a", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+
+		event := next(watcher)
+		Expect(event.(state.KeySetEvent).Key).To(Equal("config.a"))
+		Consistently(watcher.Updates()).WithTimeout(100 * time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("can receive current values first", func(ctx context.Context) {
+		_, err := manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = manager.Set(ctx, "test", "b", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+
+		watcher := watch(ctx, &state.WatchConfig{
+			IncludeCurrent: true,
+		})
+
+		Expect(next(watcher).(state.KeySetEvent).Key).To(Equal("a"))
+		Expect(next(watcher).(state.KeySetEvent).Key).To(Equal("b"))
+		Expect(next(watcher)).To(Equal(state.CaughtUpEvent{}))
+
+		_, err = manager.Set(ctx, "test", "c", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next(watcher).(state.KeySetEvent).Key).To(Equal("c"))
+	})
+
+	It("is caught up directly for empty stores", func(ctx context.Context) {
+		watcher := watch(ctx, &state.WatchConfig{
+			IncludeCurrent: true,
+		})
+
+		Expect(next(watcher)).To(Equal(state.CaughtUpEvent{}))
+	})
+
+	It("does not receive current values by default", func(ctx context.Context) {
+		_, err := manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+		Expect(err).ToNot(HaveOccurred())
+
+		watcher := watch(ctx, &state.WatchConfig{})
+		Consistently(watcher.Updates()).WithTimeout(100 * time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("closes updates when stopped", func(ctx context.Context) {
+		watcher := watch(ctx, &state.WatchConfig{})
+		Expect(watcher.Stop()).To(Succeed())
+		Eventually(watcher.Updates()).Should(BeClosed())
+	})
+
+	It("stops when the context is done", func(ctx context.Context) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		watcher := watch(watchCtx, &state.WatchConfig{})
+		cancel()
+		Eventually(watcher.Updates()).Should(BeClosed())
+	})
+
+	Describe("Resuming", func() {
+		It("receives changes after the revision", func(ctx context.Context) {
+			revision, err := manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = manager.Set(ctx, "test", "b", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.Delete(ctx, "test", "a")).To(Succeed())
+
+			watcher := watch(ctx, &state.WatchConfig{
+				ResumeFromRevision: revision,
+			})
+
+			Expect(next(watcher).(state.KeySetEvent).Key).To(Equal("b"))
+			Expect(next(watcher).(state.KeyDeleteEvent).Key).To(Equal("a"))
+			Expect(next(watcher)).To(Equal(state.CaughtUpEvent{}))
+
+			_, err = manager.Set(ctx, "test", "c", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next(watcher).(state.KeySetEvent).Key).To(Equal("c"))
+		})
+
+		It("is caught up directly if there are no changes", func(ctx context.Context) {
+			revision, err := manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+
+			watcher := watch(ctx, &state.WatchConfig{
+				ResumeFromRevision: revision,
+			})
+
+			Expect(next(watcher)).To(Equal(state.CaughtUpEvent{}))
+		})
+
+		It("fails if changes after the revision have been removed", func(ctx context.Context) {
+			revision, err := manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = manager.Set(ctx, "test", "b", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+
+			// Only the latest revision of a key is kept, the check is
+			// conservative and reports the change to b as removed even
+			// though its latest revision is still kept
+			_, err = manager.Set(ctx, "test", "a", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = manager.Set(ctx, "test", "b", Data(wrapperspb.String("value")))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = manager.Watch(ctx, &state.WatchConfig{
+				Store:              "test",
+				ResumeFromRevision: revision,
+			})
+			Expect(err).To(MatchError(state.ErrRevisionUnavailable))
+		})
+
+		It("fails if the revision is newer than the store", func(ctx context.Context) {
+			_, err := manager.Watch(ctx, &state.WatchConfig{
+				Store:              "test",
+				ResumeFromRevision: 100,
+			})
+			Expect(err).To(MatchError(state.ErrRevisionUnavailable))
+		})
+
+		It("can not be combined with current values", func(ctx context.Context) {
+			_, err := manager.Watch(ctx, &state.WatchConfig{
+				Store:              "test",
+				IncludeCurrent:     true,
+				ResumeFromRevision: 1,
+			})
+			Expect(state.IsValidationError(err)).To(BeTrue())
+		})
+	})
+
+	It("returns an error for missing stores", func(ctx context.Context) {
+		_, err := manager.Watch(ctx, &state.WatchConfig{
+			Store: "unknown",
+		})
+		Expect(err).To(MatchError(state.ErrStoreNotFound))
+	})
+})